@@ -0,0 +1,126 @@
+// harness-generate generates Terraform `import` blocks and resource configuration for the entities
+// of an existing Harness account, organization or project.
+//
+// The provider settings are read from the same environment variables as the provider, e.g.
+// HARNESS_ACCOUNT_ID, HARNESS_PLATFORM_API_KEY and HARNESS_ENDPOINT.
+//
+// Usage:
+//
+//	harness-generate [-org <org_id>] [-project <project_id>] [-kinds connector,service] [-out <dir>] [-import-only]
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/harness/terraform-provider-harness/internal/generator"
+	"github.com/harness/terraform-provider-harness/internal/provider"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var version string = "dev"
+
+func main() {
+	var (
+		orgId      string
+		projectId  string
+		kinds      string
+		outDir     string
+		importOnly bool
+	)
+
+	flag.StringVar(&orgId, "org", "", "only generate the configuration of this organization and its projects")
+	flag.StringVar(&projectId, "project", "", "only generate the configuration of this project, requires -org")
+	flag.StringVar(&kinds, "kinds", "", fmt.Sprintf("comma separated list of the kinds of entities to generate, one of %s. All kinds are generated by default", strings.Join(generator.AllKinds, ", ")))
+	flag.StringVar(&outDir, "out", ".", "directory the generated files are written to")
+	flag.BoolVar(&importOnly, "import-only", false, "only generate the import blocks, e.g. to use them with `terraform plan -generate-config-out`")
+	flag.Parse()
+
+	if err := run(context.Background(), orgId, projectId, kinds, outDir, importOnly); err != nil {
+		log.Fatalf("[ERROR] %s", err)
+	}
+}
+
+func run(ctx context.Context, orgId, projectId, kinds, outDir string, importOnly bool) error {
+	p := provider.Provider(version)()
+	if diags := p.Configure(ctx, terraform.NewResourceConfigRaw(map[string]interface{}{})); diags.HasError() {
+		return fmt.Errorf("failed to configure the provider: %s", diags[0].Summary)
+	}
+
+	opts := generator.Options{
+		OrgId:     orgId,
+		ProjectId: projectId,
+	}
+	if kinds != "" {
+		opts.Kinds = strings.Split(kinds, ",")
+	}
+
+	g, err := generator.New(p, opts)
+	if err != nil {
+		return err
+	}
+
+	entities, err := g.Discover(ctx)
+	if err != nil {
+		return err
+	}
+
+	imports := hclwrite.NewEmptyFile()
+	resources := map[string]*hclwrite.File{}
+	resourceTypes := []string{}
+
+	for _, e := range entities {
+		if !importOnly {
+			block, err := g.Config(ctx, e)
+			if err != nil {
+				log.Printf("[WARN] Only generating the import block of %s: %s", e.Address(), err)
+			} else if block == nil {
+				log.Printf("[WARN] Skipping %s, it no longer exists", e.Address())
+				continue
+			} else {
+				f, ok := resources[e.ResourceType]
+				if !ok {
+					f = hclwrite.NewEmptyFile()
+					resources[e.ResourceType] = f
+					resourceTypes = append(resourceTypes, e.ResourceType)
+				} else {
+					f.Body().AppendNewline()
+				}
+				f.Body().AppendBlock(block)
+			}
+		}
+
+		if len(imports.Body().Blocks()) > 0 {
+			imports.Body().AppendNewline()
+		}
+		imports.Body().AppendBlock(generator.ImportBlock(e))
+	}
+
+	if err := os.MkdirAll(outDir, 0755); err != nil {
+		return err
+	}
+
+	if err := writeFile(filepath.Join(outDir, "imports.tf"), imports); err != nil {
+		return err
+	}
+
+	for _, t := range resourceTypes {
+		if err := writeFile(filepath.Join(outDir, t+".tf"), resources[t]); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[INFO] Generated the configuration of %d entities in %s", len(imports.Body().Blocks()), outDir)
+
+	return nil
+}
+
+func writeFile(path string, f *hclwrite.File) error {
+	return os.WriteFile(path, hclwrite.Format(f.Bytes()), 0644)
+}
@@ -0,0 +1,4 @@
+data "harness_autostopping_rule_list" "example" {
+  cloud_connector_id = "cloud_connector_id"
+  kind               = "instance"
+}
@@ -1,3 +1,4 @@
 data "harness_autostopping_rule_rds" "example" {
-  identifier = "identifier"
+  name               = "rule"
+  cloud_connector_id = "cloud_connector_id"
 }
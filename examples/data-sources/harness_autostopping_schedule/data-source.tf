@@ -0,0 +1,3 @@
+data "harness_autostopping_schedule" "example" {
+  identifier = 123
+}
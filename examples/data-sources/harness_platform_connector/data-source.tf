@@ -0,0 +1,18 @@
+# Look up a single connector of any type
+data "harness_platform_connector" "example" {
+  identifier = "identifier"
+  org_id     = "org_id"
+  project_id = "project_id"
+}
+
+# Look up a connector defined at the account scope
+data "harness_platform_connector" "account" {
+  identifier = "account.identifier"
+}
+
+# List all connectors matching the filters
+data "harness_platform_connector" "git" {
+  org_id      = "org_id"
+  types       = ["Git", "Github"]
+  filter_tags = ["team:platform"]
+}
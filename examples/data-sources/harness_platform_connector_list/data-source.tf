@@ -0,0 +1,7 @@
+data "harness_platform_connector_list" "example" {
+  org_id                = "org_id"
+  project_id            = "project_id"
+  types                 = ["K8sCluster"]
+  connectivity_statuses = ["SUCCESS"]
+  filter_tags           = ["team:platform"]
+}
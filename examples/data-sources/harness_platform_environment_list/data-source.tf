@@ -0,0 +1,11 @@
+data "harness_platform_environment_list" "example" {
+  org_id     = "org_id"
+  project_id = "project_id"
+}
+
+# Only list the production environments.
+data "harness_platform_environment_list" "production" {
+  org_id     = "org_id"
+  project_id = "project_id"
+  type       = "Production"
+}
@@ -0,0 +1,20 @@
+data "harness_platform_feature_flag_evaluation" "example" {
+  flag_id        = "flag_id"
+  org_id         = "org_id"
+  project_id     = "project_id"
+  environment_id = "environment_id"
+
+  target_id   = "jane"
+  target_name = "Jane"
+  target_attributes = {
+    email = "jane@example.com"
+  }
+}
+
+# Fail the plan if the internal users are not served the new checkout
+check "internal_users_served_new_checkout" {
+  assert {
+    condition     = data.harness_platform_feature_flag_evaluation.example.variation == "Enabled"
+    error_message = "Internal users are served ${data.harness_platform_feature_flag_evaluation.example.variation} (${data.harness_platform_feature_flag_evaluation.example.reason})."
+  }
+}
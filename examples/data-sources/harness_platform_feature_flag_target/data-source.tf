@@ -0,0 +1,6 @@
+data "harness_platform_feature_flag_target" "example" {
+  identifier  = "identifier"
+  org_id      = "org_id"
+  project_id  = "project_id"
+  environment = "environment"
+}
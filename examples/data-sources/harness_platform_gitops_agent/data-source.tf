@@ -5,3 +5,17 @@ data "harness_platform_gitops_agent" "example" {
   org_id     = "org_id"
 }
 
+# Wait until the agent installed with the Helm chart has connected to Harness.
+data "harness_platform_gitops_agent" "connected" {
+  depends_on = [helm_release.gitops_agent]
+
+  identifier           = harness_platform_gitops_agent.example.identifier
+  account_id           = harness_platform_gitops_agent.example.account_id
+  project_id           = harness_platform_gitops_agent.example.project_id
+  org_id               = harness_platform_gitops_agent.example.org_id
+  wait_until_connected = true
+
+  timeouts {
+    read = "10m"
+  }
+}
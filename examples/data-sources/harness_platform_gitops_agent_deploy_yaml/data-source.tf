@@ -4,4 +4,26 @@ data "harness_platform_gitops_agent_deploy_yaml" "example" {
   project_id = "project_id"
   org_id     = "org_id"
   namespace  = "namespace"
-}
\ No newline at end of file
+}
+
+# Install the agent with the Harness GitOps agent Helm chart.
+data "harness_platform_gitops_agent_deploy_yaml" "helm" {
+  identifier        = harness_platform_gitops_agent.example.identifier
+  account_id        = harness_platform_gitops_agent.example.account_id
+  project_id        = harness_platform_gitops_agent.example.project_id
+  org_id            = harness_platform_gitops_agent.example.org_id
+  namespace         = "namespace"
+  high_availability = true
+  proxy {
+    https = "https://proxy.example.com"
+  }
+}
+
+resource "helm_release" "gitops_agent" {
+  name             = "gitops-agent"
+  repository       = "https://harness.github.io/gitops-helm/"
+  chart            = "gitops-helm"
+  namespace        = "namespace"
+  create_namespace = true
+  values           = [data.harness_platform_gitops_agent_deploy_yaml.helm.helm_values]
+}
@@ -0,0 +1,6 @@
+data "harness_platform_gitops_applicationset" "example" {
+  org_id     = "org_id"
+  project_id = "project_id"
+  agent_id   = "agent_id"
+  identifier = "identifier"
+}
@@ -0,0 +1,6 @@
+data "harness_platform_infrastructure_list" "example" {
+  org_id          = "org_id"
+  project_id      = "project_id"
+  env_id          = "env_id"
+  deployment_type = "Kubernetes"
+}
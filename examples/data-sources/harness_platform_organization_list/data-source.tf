@@ -0,0 +1,4 @@
+data "harness_platform_organization_list" "example" {
+  search_term = "platform"
+  filter_tags = ["team:platform"]
+}
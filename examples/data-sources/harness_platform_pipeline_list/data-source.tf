@@ -0,0 +1,6 @@
+data "harness_platform_pipeline_list" "example" {
+  org_id      = "org_id"
+  project_id  = "project_id"
+  module      = "cd"
+  filter_tags = ["team:platform"]
+}
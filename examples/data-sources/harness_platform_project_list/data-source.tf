@@ -0,0 +1,4 @@
+data "harness_platform_project_list" "example" {
+  org_id      = "org_id"
+  module_type = "CD"
+}
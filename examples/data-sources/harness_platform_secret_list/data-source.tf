@@ -0,0 +1,5 @@
+data "harness_platform_secret_list" "example" {
+  org_id     = "org_id"
+  project_id = "project_id"
+  types      = ["SecretText"]
+}
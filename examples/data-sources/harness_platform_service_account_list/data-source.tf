@@ -0,0 +1,4 @@
+data "harness_platform_service_account_list" "example" {
+  org_id     = "org_id"
+  project_id = "project_id"
+}
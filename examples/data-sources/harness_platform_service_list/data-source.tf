@@ -0,0 +1,23 @@
+data "harness_platform_service_list" "example" {
+  org_id     = "org_id"
+  project_id = "project_id"
+}
+
+# Filter the services by search term and tags.
+data "harness_platform_service_list" "tagged" {
+  org_id      = "org_id"
+  project_id  = "project_id"
+  search_term = "api"
+  filter_tags = ["team:platform"]
+}
+
+resource "harness_platform_service_overrides_v2" "example" {
+  for_each = { for s in data.harness_platform_service_list.example.services : s.identifier => s }
+
+  org_id     = "org_id"
+  project_id = "project_id"
+  env_id     = "env_id"
+  service_id = each.key
+  type       = "ENV_SERVICE_OVERRIDE"
+  yaml       = file("overrides/${each.key}.yaml")
+}
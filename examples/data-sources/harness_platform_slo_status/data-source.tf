@@ -0,0 +1,13 @@
+data "harness_platform_slo_status" "example" {
+  org_id     = "org_id"
+  project_id = "project_id"
+  identifier = "identifier"
+}
+
+# Fail the run when the error budget of the SLO is running out
+check "error_budget" {
+  assert {
+    condition     = data.harness_platform_slo_status.example.error_budget_remaining_percentage > 10
+    error_message = "Less than 10% of the error budget of the SLO remains."
+  }
+}
@@ -0,0 +1,4 @@
+data "harness_platform_template_list" "example" {
+  org_id = "org_id"
+  types  = ["Step", "StepGroup"]
+}
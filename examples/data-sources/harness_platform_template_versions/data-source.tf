@@ -0,0 +1,9 @@
+data "harness_platform_template_versions" "example" {
+  identifier = "identifier"
+  org_id     = "org_id"
+}
+
+# Reference the stable version of the template.
+output "stable_version" {
+  value = data.harness_platform_template_versions.example.stable_version
+}
@@ -0,0 +1,4 @@
+data "harness_platform_usergroup_list" "example" {
+  org_id      = "org_id"
+  search_term = "admins"
+}
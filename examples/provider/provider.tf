@@ -19,3 +19,38 @@ provider "harness" {
   account_id       = "...."
   platform_api_key = "......"
 }
+
+#Configure the Harness provider for Next Gen resources with a short-lived token
+provider "harness" {
+  endpoint   = "https://app.harness.io/gateway"
+  account_id = "...."
+
+  auth {
+    token_file = "/var/run/secrets/harness/token"
+  }
+}
+
+#Configure the Harness provider for Next Gen resources with a credential helper
+provider "harness" {
+  endpoint   = "https://app.harness.io/gateway"
+  account_id = "...."
+
+  auth {
+    credential_command = ["harness-credential-helper", "get-token"]
+    token_type         = "ApiKey"
+    refresh_interval   = "10m"
+  }
+}
+
+#Configure the Harness provider for a self-managed installation behind a proxy
+provider "harness" {
+  endpoint         = "https://harness.example.com/gateway"
+  account_id       = "...."
+  platform_api_key = "......"
+
+  https_proxy     = "http://proxy.example.com:3128"
+  ca_bundle_file  = "/etc/ssl/certs/corporate-ca.pem"
+  max_retries     = 5
+  request_timeout = "60s"
+  rate_limit      = 10
+}
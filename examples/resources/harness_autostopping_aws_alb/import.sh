@@ -0,0 +1,2 @@
+# Import using the identifier of the load balancer
+terraform import harness_autostopping_aws_alb.example <load_balancer_id>
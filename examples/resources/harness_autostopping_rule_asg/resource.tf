@@ -0,0 +1,35 @@
+resource "harness_autostopping_rule_asg" "test" {
+  name               = "name"
+  cloud_connector_id = "cloud_connector_id"
+  idle_time_mins     = 10
+  scale_group {
+    id      = "arn:aws:autoscaling:us-east-1:123456789012:autoScalingGroup:uuid:autoScalingGroupName/asg"
+    name    = "asg"
+    region  = "us-east-1"
+    desired = 2
+    min     = 1
+    max     = 3
+  }
+  http {
+    proxy_id = "proxy_id"
+    routing {
+      source_protocol = "http"
+      target_protocol = "http"
+      source_port     = 80
+      target_port     = 80
+      action          = "forward"
+    }
+    health {
+      protocol         = "http"
+      port             = 80
+      path             = "/"
+      timeout          = 30
+      status_code_from = 200
+      status_code_to   = 299
+    }
+  }
+  depends {
+    rule_id      = 24576
+    delay_in_sec = 5
+  }
+}
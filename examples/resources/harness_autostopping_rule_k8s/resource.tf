@@ -0,0 +1,27 @@
+resource "harness_autostopping_rule_k8s" "test" {
+  name               = "name"
+  cloud_connector_id = "cloud_connector_id"
+  k8s_connector_id   = "k8s_connector_id"
+  namespace          = "default"
+  idle_time_mins     = 10
+  workload {
+    name = "app"
+    type = "Deployment"
+  }
+  ingress {
+    name            = "ingress"
+    controller_name = "nginx"
+  }
+  health {
+    protocol         = "http"
+    port             = 80
+    path             = "/"
+    timeout          = 30
+    status_code_from = 200
+    status_code_to   = 299
+  }
+  depends {
+    rule_id      = 24576
+    delay_in_sec = 5
+  }
+}
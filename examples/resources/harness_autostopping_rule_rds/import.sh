@@ -0,0 +1,2 @@
+# Import using the identifier of the rule
+terraform import harness_autostopping_rule_rds.example <rule_id>
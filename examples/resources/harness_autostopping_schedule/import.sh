@@ -0,0 +1,2 @@
+# Import using the identifier of the schedule
+terraform import harness_autostopping_schedule.example <schedule_id>
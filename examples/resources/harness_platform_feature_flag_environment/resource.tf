@@ -0,0 +1,40 @@
+resource "harness_platform_feature_flag_environment" "prod" {
+  org_id         = "test"
+  project_id     = "testff"
+  flag_id        = harness_platform_feature_flag.mybooleanflag.identifier
+  environment_id = "prod"
+
+  state                 = "on"
+  default_off_variation = "Disabled"
+
+  # Roll out to 20% of the targets that match no rule
+  default_on_distribution {
+    variation {
+      variation = "Enabled"
+      weight    = 20
+    }
+    variation {
+      variation = "Disabled"
+      weight    = 80
+    }
+  }
+
+  # Serve the flag to the beta testers
+  rule {
+    clause {
+      op     = "segmentMatch"
+      values = ["beta_testers"]
+    }
+    serve_variation = "Enabled"
+  }
+
+  # Then to the internal users
+  rule {
+    clause {
+      attribute = "email"
+      op        = "ends_with"
+      values    = ["@example.com"]
+    }
+    serve_variation = "Enabled"
+  }
+}
@@ -12,4 +12,20 @@ resource "harness_platform_ff_api_key" "testserverapikey" {
 output "serversdkkey" {
   value     = harness_platform_ff_api_key.testserverapikey.api_key
   sensitive = true
-}
\ No newline at end of file
+}
+
+# Store the SDK key in a secret instead of the state
+resource "harness_platform_ff_api_key" "testserverapikey_secret" {
+  identifier = "testserver"
+  name       = "TestServer"
+  org_id     = "test"
+  project_id = "testff"
+  env_id     = "testenv"
+  type       = "Server"
+
+  value_secret {
+    identifier = "ff_server_sdk_key"
+    org_id     = "test"
+    project_id = "testff"
+  }
+}
@@ -9,4 +9,7 @@ resource "harness_platform_gitops_agent" "example" {
     namespace         = "namespace"
     high_availability = true
   }
+
+  # Change the value to regenerate the agent token, the agent then needs to be redeployed.
+  token_rotation_trigger = "2023-01-01"
 }
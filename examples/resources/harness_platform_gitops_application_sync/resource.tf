@@ -0,0 +1,29 @@
+resource "harness_platform_gitops_application_sync" "example" {
+  org_id         = harness_platform_gitops_applications.example.org_id
+  project_id     = harness_platform_gitops_applications.example.project_id
+  agent_id       = harness_platform_gitops_applications.example.agent_id
+  application_id = harness_platform_gitops_applications.example.identifier
+
+  prune        = true
+  sync_options = ["CreateNamespace=true"]
+
+  # Sync again whenever the target revision of the application changes.
+  triggers = {
+    target_revision = harness_platform_gitops_applications.example.application[0].spec[0].source[0].target_revision
+  }
+
+  timeouts {
+    create = "20m"
+  }
+}
+
+# Resources that depend on the application being healthy.
+resource "harness_platform_pipeline_execution" "smoke_tests" {
+  org_id      = "org_id"
+  project_id  = "project_id"
+  pipeline_id = "smoke_tests"
+
+  triggers = {
+    revision = harness_platform_gitops_application_sync.example.deployed_revision
+  }
+}
@@ -0,0 +1,2 @@
+# Import a Project level Gitops ApplicationSet
+terraform import harness_platform_gitops_applicationset.example <organization_id>/<project_id>/<agent_id>/<applicationset_name>
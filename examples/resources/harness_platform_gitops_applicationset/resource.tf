@@ -0,0 +1,111 @@
+resource "harness_platform_gitops_applicationset" "example" {
+  org_id     = "org_id"
+  project_id = "project_id"
+  agent_id   = "agent_id"
+  upsert     = true
+  applicationset {
+    metadata {
+      name = "guestbook"
+    }
+    spec {
+      go_template = false
+
+      # One application per cluster and per directory of the repository.
+      generator {
+        matrix {
+          generator {
+            cluster {
+              match_labels = {
+                env = "dev"
+              }
+            }
+          }
+          generator {
+            git {
+              repo_url = "https://github.com/argoproj/argocd-example-apps"
+              revision = "HEAD"
+              directory {
+                path = "apps/*"
+              }
+              directory {
+                path    = "apps/excluded"
+                exclude = true
+              }
+            }
+          }
+        }
+      }
+
+      template {
+        metadata {
+          name = "{{name}}-{{path.basename}}"
+          labels = {
+            "harness.io/serviceRef" = "service_id"
+            "harness.io/envRef"     = "env_id"
+          }
+        }
+        spec {
+          source {
+            repo_url        = "https://github.com/argoproj/argocd-example-apps"
+            path            = "{{path}}"
+            target_revision = "HEAD"
+          }
+          destination {
+            server    = "{{server}}"
+            namespace = "{{path.basename}}"
+          }
+          sync_policy {
+            sync_options = ["CreateNamespace=true"]
+          }
+        }
+      }
+
+      sync_policy {
+        preserve_resources_on_deletion = true
+      }
+    }
+  }
+}
+
+resource "harness_platform_gitops_applicationset" "list" {
+  org_id     = "org_id"
+  project_id = "project_id"
+  agent_id   = "agent_id"
+  applicationset {
+    metadata {
+      name = "guestbook-list"
+    }
+    spec {
+      generator {
+        list {
+          elements = [
+            {
+              cluster = "engineering-dev"
+              url     = "https://kubernetes.default.svc"
+            },
+            {
+              cluster = "engineering-prod"
+              url     = "https://kubernetes.default.svc"
+            }
+          ]
+        }
+      }
+      template {
+        metadata {
+          name = "{{cluster}}-guestbook"
+        }
+        spec {
+          source {
+            repo_url        = "https://github.com/argoproj/argocd-example-apps"
+            path            = "guestbook"
+            target_revision = "HEAD"
+          }
+          destination {
+            server    = "{{url}}"
+            namespace = "guestbook"
+          }
+        }
+      }
+    }
+  }
+}
@@ -60,19 +60,6 @@ resource "harness_platform_monitored_service" "example" {
       })
       category = "Alert"
     }
-    change_sources {
-      name       = "FH"
-      identifier = "FH"
-      type       = "CustomIncident"
-      enabled    = true
-      spec = jsonencode({
-        name       = "FH",
-        webhookUrl = "https://harness.io/cv/api/account/sampleAcc/org/sampleOrg/project/sampleProj/webhook/custom-change?monitoredServiceIdentifier=checkout_prod&changeSourceIdentifier=FH",
-        "webhookCurlCommand" : "curl -X POST -H 'content-type: application/json' -H 'X-Api-Key: sample_api_key' --url 'https://harness.io/cv/api/account/sampleAcc/org/sampleOrg/project/sampleProj/webhook/custom-change?monitoredServiceIdentifier=checkout_prod&changeSourceIdentifier=FH' -d '{ \"eventIdentifier\": \"<string>\" (optional), \"user\": \"user@harness.io\", \"startTime\": timeInMs, \"endTime\": timeInMs, \"eventDetail\": { \"description\": \"<String>\", \"changeEventDetailsLink\": \"urlString\" (optional), \"externalLinkToEntity\": \"urlString\" (optional), \"name\": \"changeEventName\" } }'",
-        "type" : "Alert"
-      })
-      category = "Alert"
-    }
     notification_rule_refs {
       notification_rule_ref = "notification_rule_ref"
       enabled               = true
@@ -795,4 +782,68 @@ resource "harness_platform_monitored_service" "example9" {
       })
     }
   }
-}
\ No newline at end of file
+}
+#Sample template for Prometheus Metrics Health Source and PagerDuty Change Source using typed blocks
+resource "harness_platform_monitored_service" "example10" {
+  org_id     = "org_id"
+  project_id = "project_id"
+  identifier = "identifier"
+  request {
+    name            = "name"
+    type            = "Application"
+    description     = "description"
+    service_ref     = "service_ref"
+    environment_ref = "environment_ref"
+    tags            = ["foo:bar", "bar:foo"]
+    health_sources {
+      name       = "prometheus"
+      identifier = "prometheus"
+      type       = "Prometheus"
+      prometheus {
+        connector_ref = "account.prometheus"
+        metric_definition {
+          identifier                      = "cpu_usage"
+          metric_name                     = "CPU usage"
+          group_name                      = "infra"
+          query                           = "avg(container_cpu_usage_seconds_total{namespace=\"prod\"})"
+          live_monitoring_enabled         = true
+          deployment_verification_enabled = true
+          service_instance_field_name     = "pod"
+          risk_profile {
+            risk_category   = "Infrastructure"
+            threshold_types = ["ACT_WHEN_HIGHER"]
+          }
+        }
+        metric_pack {
+          identifier = "Custom"
+          metric_threshold {
+            type          = "IgnoreThreshold"
+            metric_name   = "CPU usage"
+            action        = "Ignore"
+            criteria_type = "Absolute"
+            less_than     = 5
+          }
+          metric_threshold {
+            type          = "FailImmediately"
+            metric_name   = "CPU usage"
+            action        = "FailAfterOccurrence"
+            count         = 2
+            criteria_type = "Absolute"
+            greater_than  = 90
+          }
+        }
+      }
+    }
+    change_sources {
+      name       = "pagerduty"
+      identifier = "pagerduty"
+      type       = "PagerDuty"
+      enabled    = true
+      category   = "Alert"
+      pager_duty {
+        connector_ref         = "account.pd"
+        pager_duty_service_id = "P0N21OB"
+      }
+    }
+  }
+}
@@ -0,0 +1,2 @@
+# Import an execution
+terraform import harness_platform_pipeline_execution.example <org_id>/<project_id>/<execution_id>
@@ -0,0 +1,32 @@
+# Execute a pipeline once with runtime inputs and wait for it to finish.
+resource "harness_platform_pipeline_execution" "seed" {
+  org_id      = "orgIdentifier"
+  project_id  = "projectIdentifier"
+  pipeline_id = "seed"
+
+  runtime_input_yaml = <<-EOT
+    pipeline:
+      identifier: seed
+      variables:
+        - name: environment
+          type: String
+          value: dev
+  EOT
+
+  timeouts {
+    create = "30m"
+  }
+}
+
+# Execute a pipeline with input sets whenever the version changes, without waiting.
+resource "harness_platform_pipeline_execution" "deploy" {
+  org_id              = "orgIdentifier"
+  project_id          = "projectIdentifier"
+  pipeline_id         = "deploy"
+  input_set_ids       = ["defaults", "production"]
+  wait_for_completion = false
+
+  triggers = {
+    version = "1.2.3"
+  }
+}
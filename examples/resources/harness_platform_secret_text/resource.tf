@@ -35,4 +35,57 @@ resource "harness_platform_secret_text" "gcp_secret_manager_reference" {
       version = "1"
     }
   }
-}
\ No newline at end of file
+}
+
+# The value is sent to Harness but not stored in the Terraform state.
+# Increment value_wo_version to update the secret with a new value.
+resource "harness_platform_secret_text" "write_only" {
+  identifier  = "identifier"
+  name        = "name"
+  description = "example"
+  tags        = ["foo:bar"]
+
+  secret_manager_identifier = "harnessSecretManager"
+  value_type                = "Inline"
+  value_wo                  = var.secret_value
+  value_wo_version          = 1
+}
+
+# A random value is generated on creation and rotated on the first apply after 30 days.
+# The value is never stored in the Terraform state.
+resource "harness_platform_secret_text" "rotated" {
+  identifier  = "identifier"
+  name        = "name"
+  description = "example"
+  tags        = ["foo:bar"]
+
+  secret_manager_identifier = "harnessSecretManager"
+  value_type                = "Inline"
+
+  rotation {
+    rotation_days = 30
+
+    random {
+      length = 32
+    }
+  }
+}
+
+# The reference is moved to the next version of the external secret on each rotation.
+resource "harness_platform_secret_text" "rotated_reference" {
+  identifier  = "identifier"
+  name        = "name"
+  description = "example"
+  tags        = ["foo:bar"]
+
+  secret_manager_identifier = "azureSecretManager"
+  value_type                = "Reference"
+
+  rotation {
+    rotation_days = 90
+
+    reference {
+      path = "my-secret/{version}"
+    }
+  }
+}
@@ -51,3 +51,45 @@ resource "harness_platform_service" "example" {
                   gitOpsEnabled: false
               EOT
 }
+
+# Service stored in git
+resource "harness_platform_service" "remote" {
+  identifier  = "identifier"
+  name        = "name"
+  description = "test"
+  org_id      = "org_id"
+  project_id  = "project_id"
+
+  git_details {
+    store_type     = "REMOTE"
+    connector_ref  = "account.github_connector"
+    repo_name      = "harness-entities"
+    file_path      = ".harness/services/identifier.yaml"
+    branch_name    = "main"
+    commit_message = "Create service identifier"
+  }
+
+  yaml = <<-EOT
+                service:
+                  name: name
+                  identifier: identifier
+                  orgIdentifier: org_id
+                  projectIdentifier: project_id
+              EOT
+}
+
+# Import a service stored in git
+resource "harness_platform_service" "imported" {
+  identifier      = "imported"
+  name            = "imported"
+  org_id          = "org_id"
+  project_id      = "project_id"
+  import_from_git = true
+
+  git_import_info {
+    connector_ref = "account.github_connector"
+    repo_name     = "harness-entities"
+    file_path     = ".harness/services/imported.yaml"
+    branch_name   = "main"
+  }
+}
@@ -44,3 +44,37 @@ resource "harness_platform_slo" "example" {
     }
   }
 }
+
+# Composite SLO combining two SLOs
+resource "harness_platform_slo" "composite" {
+  org_id     = "org_id"
+  project_id = "project_id"
+  identifier = "composite"
+  request {
+    name              = "composite"
+    user_journey_refs = ["one"]
+    slo_target {
+      type                  = "Calender"
+      slo_target_percentage = 10
+      spec = jsonencode({
+        type = "Monthly"
+        spec = {
+          dayOfMonth = 5
+        }
+      })
+    }
+    type = "Composite"
+    composite_slo {
+      evaluation_type = "Window"
+      slo_reference {
+        slo_ref              = "checkout_availability"
+        weightage_percentage = 70
+      }
+      slo_reference {
+        slo_ref              = "payment_latency"
+        weightage_percentage = 30
+        project_id           = "payments"
+      }
+    }
+  }
+}
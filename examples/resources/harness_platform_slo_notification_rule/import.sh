@@ -0,0 +1,2 @@
+# Import project level SLO notification rule
+terraform import harness_platform_slo_notification_rule.example <org_id>/<project_id>/<notification_rule_id>
@@ -29,4 +29,18 @@ resource "harness_platform_token" "test" {
   project_id  = "project_id"
   apikey_type = "USER"
   apikey_id   = "apikey_id"
-}
\ No newline at end of file
+}
+
+# Store the token value in a secret instead of the state
+resource "harness_platform_token" "test" {
+  identifier  = "test_token"
+  name        = "test token"
+  parent_id   = "apikey_parent_id"
+  account_id  = "account_id"
+  apikey_type = "USER"
+  apikey_id   = "apikey_id"
+
+  value_secret {
+    identifier = "test_token_value"
+  }
+}
@@ -2,95 +2,230 @@ package helpers
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strings"
+	"unicode"
 
 	"github.com/harness/harness-go-sdk/harness/nextgen"
 	openapi_client_nextgen "github.com/harness/harness-openapi-go-client/nextgen"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-func HandleApiError(err error, d *schema.ResourceData, httpResp *http.Response) diag.Diagnostics {
-	erro, ok := err.(nextgen.GenericSwaggerError)
-	if ok {
-		if httpResp != nil && httpResp.StatusCode == 401 {
-			return diag.Errorf(httpResp.Status + "\n" + "Hint:\n" +
-				"1) Please check if token has expired or is wrong.\n" +
-				"2) Harness Provider is misconfigured. For firstgen resources please give the correct api_key and for nextgen resources please give the correct platform_api_key.")
-		}
-		if httpResp != nil && httpResp.StatusCode == 403 {
-			return diag.Errorf(httpResp.Status + "\n" + "Hint:\n" +
-				"1) Please check if the token has required permission for this operation.\n" +
-				"2) Please check if the token has expired or is wrong.")
-		}
-		return diag.Errorf(erro.Error())
-	}
+const unauthorizedHint = "Hint:\n" +
+	"1) Please check if token has expired or is wrong.\n" +
+	"2) Harness Provider is misconfigured. For firstgen resources please give the correct api_key and for nextgen resources please give the correct platform_api_key."
 
-	err_openapi_client, ok := err.(openapi_client_nextgen.GenericSwaggerError)
-	if ok {
-		if httpResp != nil && httpResp.StatusCode == 401 {
-			return diag.Errorf(httpResp.Status + "\n" + "Hint:\n" +
-				"1) Please check if token has expired or is wrong.\n" +
-				"2) Harness Provider is misconfigured. For firstgen resources please give the correct api_key and for nextgen resources please give the correct platform_api_key.")
-		}
+const forbiddenHint = "Hint:\n" +
+	"1) Please check if the token has required permission for this operation.\n" +
+	"2) Please check if the token has expired or is wrong."
 
-		if httpResp != nil && httpResp.StatusCode == 403 {
-			return diag.Errorf(httpResp.Status + "\n" + "Hint:\n" +
-				"1) Please check if the token has required permission for this operation.\n" +
-				"2) Please check if the token has expired or is wrong.")
-		}
-		var jsonMap map[string]interface{}
-		err := json.Unmarshal(err_openapi_client.Body(), &jsonMap)
-		if err == nil {
-			return diag.Errorf(jsonMap["message"].(string))
-		}
-		return diag.Errorf(err_openapi_client.Error())
+// errorCodeHints maps well known Harness error codes to a hint on how to resolve them.
+var errorCodeHints = map[string]string{
+	"DUPLICATE_FIELD":            "An entity with the same identifier already exists at this scope. Import it with `terraform import` or choose a different identifier.",
+	"DUPLICATE_FILE_IMPORT":      "The entity has already been imported from git. Import it with `terraform import` instead.",
+	"INVALID_YAML_ERROR":         "The YAML is not valid. Check the indentation and that the identifiers in the YAML match the resource arguments.",
+	"SCM_CONFLICT_ERROR":         "The file was changed in git since it was last read. Refresh the state to pick up the latest commit and try again.",
+	"SCM_CONFLICT_ERROR_V2":      "The file was changed in git since it was last read. Refresh the state to pick up the latest commit and try again.",
+	"SCM_BAD_REQUEST":            "The git operation was rejected. Check the branch, file path and the permissions of the git connector.",
+	"NG_ACCESS_DENIED":           "The token does not have the permission required for this operation. Check the role bindings of the API key or service account.",
+	"ACCESS_DENIED":              "The token does not have the permission required for this operation. Check the role bindings of the API key or service account.",
+	"INVALID_TOKEN":              "The token is not valid. Check that it has not expired and belongs to this account.",
+	"EXPIRED_TOKEN":              "The token has expired. Create a new token and update the provider configuration.",
+	"RESOURCE_NOT_FOUND":         "The entity or one of the entities it references does not exist at this scope. Check the identifiers and the org_id/project_id.",
+	"ENTITY_REFERENCE_EXCEPTION": "The entity is still referenced by other entities. Remove the references first, or use force delete where supported.",
+}
+
+// apiError is the error body returned by the Harness APIs.
+type apiError struct {
+	Status           string               `json:"status"`
+	Code             string               `json:"code"`
+	Message          string               `json:"message"`
+	DetailedMessage  string               `json:"detailedMessage"`
+	CorrelationId    string               `json:"correlationId"`
+	ResponseMessages []apiResponseMessage `json:"responseMessages"`
+	Errors           []apiFieldError      `json:"errors"`
+}
+
+type apiResponseMessage struct {
+	Code    string `json:"code"`
+	Level   string `json:"level"`
+	Message string `json:"message"`
+}
+
+// apiFieldError is a validation error of a single field. The field name and message use
+// different keys in the nextgen and openapi responses.
+type apiFieldError struct {
+	FieldId string `json:"fieldId"`
+	Field   string `json:"field"`
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
+
+func (e apiFieldError) field() string {
+	if e.FieldId != "" {
+		return e.FieldId
 	}
+	return e.Field
+}
 
-	return diag.Errorf(err.Error())
+func (e apiFieldError) message() string {
+	if e.Error != "" {
+		return e.Error
+	}
+	return e.Message
+}
+
+func HandleApiError(err error, d *schema.ResourceData, httpResp *http.Response) diag.Diagnostics {
+	return apiErrorDiagnostics(err, d, httpResp)
 }
 
 func HandleReadApiError(err error, d *schema.ResourceData, httpResp *http.Response) diag.Diagnostics {
 	erro, ok := err.(nextgen.GenericSwaggerError)
-	if ok {
-		if httpResp != nil && httpResp.StatusCode == 401 {
-			return diag.Errorf(httpResp.Status + "\n" + "Hint:\n" +
-				"1) Please check if token has expired or is wrong.\n" +
-				"2) Harness Provider is misconfigured. For firstgen resources please give the correct api_key and for nextgen resources please give the correct platform_api_key.")
-		}
-		if httpResp != nil && httpResp.StatusCode == 403 {
-			return diag.Errorf(httpResp.Status + "\n" + "Hint:\n" +
-				"1) Please check if the token has required permission for this operation.\n" +
-				"2) Please check if the token has expired or is wrong.")
-		}
+	if ok && !isAuthError(httpResp) {
 		if erro.Model() != nil && (erro.Code() == nextgen.ErrorCodes.ResourceNotFound || erro.Code() == nextgen.ErrorCodes.EntityNotFound) {
 			d.SetId("")
 			d.MarkNewResource()
 			return nil
 		}
-		return diag.Errorf(erro.Error())
 	}
 
-	err_openapi_client, ok := err.(openapi_client_nextgen.GenericSwaggerError)
-	if ok {
-		if httpResp != nil && httpResp.StatusCode == 401 {
-			return diag.Errorf(httpResp.Status + "\n" + "Hint:\n" +
-				"1) Please check if token has expired or is wrong.\n" +
-				"2) Harness Provider is misconfigured. For firstgen resources please give the correct api_key and for nextgen resources please give the correct platform_api_key.")
-		}
-		if httpResp != nil && httpResp.StatusCode == 403 {
-			return diag.Errorf(httpResp.Status + "\n" + "Hint:\n" +
-				"1) Please check if the token has required permission for this operation.\n" +
-				"2) Please check if the token has expired or is wrong.")
+	return apiErrorDiagnostics(err, d, httpResp)
+}
+
+func isAuthError(httpResp *http.Response) bool {
+	return httpResp != nil && (httpResp.StatusCode == http.StatusUnauthorized || httpResp.StatusCode == http.StatusForbidden)
+}
+
+// apiErrorDiagnostics translates an error returned by either of the Harness SDKs into diagnostics.
+func apiErrorDiagnostics(err error, d *schema.ResourceData, httpResp *http.Response) diag.Diagnostics {
+	var body []byte
+	switch e := err.(type) {
+	case nextgen.GenericSwaggerError:
+		body = e.Body()
+	case openapi_client_nextgen.GenericSwaggerError:
+		body = e.Body()
+	default:
+		return diag.Errorf(err.Error())
+	}
+
+	var apiErr apiError
+	if len(body) == 0 || json.Unmarshal(body, &apiErr) != nil {
+		apiErr = apiError{}
+	}
+
+	return buildApiErrorDiagnostics(err.Error(), &apiErr, d, httpResp)
+}
+
+func buildApiErrorDiagnostics(fallback string, apiErr *apiError, d *schema.ResourceData, httpResp *http.Response) diag.Diagnostics {
+	summary := apiErr.Message
+	if summary == "" {
+		summary = fallback
+	}
+	if summary == "" && httpResp != nil {
+		summary = httpResp.Status
+	}
+
+	var detail []string
+
+	if apiErr.DetailedMessage != "" && apiErr.DetailedMessage != summary {
+		detail = append(detail, apiErr.DetailedMessage)
+	}
+
+	for _, msg := range apiErr.ResponseMessages {
+		if msg.Message != "" && msg.Message != summary {
+			detail = append(detail, msg.Message)
 		}
+	}
+
+	if httpResp != nil && httpResp.StatusCode == http.StatusUnauthorized {
+		detail = append(detail, httpResp.Status+"\n"+unauthorizedHint)
+	} else if httpResp != nil && httpResp.StatusCode == http.StatusForbidden {
+		detail = append(detail, httpResp.Status+"\n"+forbiddenHint)
+	} else if hint := getErrorCodeHint(apiErr); hint != "" {
+		detail = append(detail, "Hint: "+hint)
+	}
+
+	if apiErr.CorrelationId != "" {
+		detail = append(detail, fmt.Sprintf("Harness correlation ID: %s. Please include it when contacting Harness support.", apiErr.CorrelationId))
+	}
+
+	diags := diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  summary,
+		Detail:   strings.Join(detail, "\n\n"),
+	}}
+
+	for _, fieldErr := range apiErr.Errors {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("Invalid value for %s", fieldErr.field()),
+			Detail:        fieldErr.message(),
+			AttributePath: getAttributePath(d, fieldErr.field()),
+		})
+	}
+
+	return diags
+}
 
-		var jsonMap map[string]interface{}
-		err := json.Unmarshal(err_openapi_client.Body(), &jsonMap)
-		if err == nil {
-			return diag.Errorf(jsonMap["message"].(string))
+func getErrorCodeHint(apiErr *apiError) string {
+	if hint, ok := errorCodeHints[apiErr.Code]; ok {
+		return hint
+	}
+
+	for _, msg := range apiErr.ResponseMessages {
+		if hint, ok := errorCodeHints[msg.Code]; ok {
+			return hint
 		}
-		return diag.Errorf(err_openapi_client.Error())
 	}
 
-	return diag.Errorf(err.Error())
+	return ""
+}
+
+// serverFieldNames maps field names used by the Harness APIs to the names of the resource arguments.
+var serverFieldNames = map[string]string{
+	"orgIdentifier":     "org_id",
+	"projectIdentifier": "project_id",
+	"org":               "org_id",
+	"project":           "project_id",
+	"slug":              "identifier",
+}
+
+// getAttributePath returns the path of the resource argument identified by the server, or nil
+// when the field does not correspond to a top level argument of the resource.
+func getAttributePath(d *schema.ResourceData, field string) cty.Path {
+	if d == nil || field == "" {
+		return nil
+	}
+
+	parts := strings.Split(field, ".")
+	name := parts[len(parts)-1]
+	if mapped, ok := serverFieldNames[name]; ok {
+		name = mapped
+	} else {
+		name = toSnakeCase(name)
+	}
+
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.Type().IsObjectType() || !config.Type().HasAttribute(name) {
+		return nil
+	}
+
+	return cty.GetAttrPath(name)
+}
+
+func toSnakeCase(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteRune('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
 }
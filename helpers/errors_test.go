@@ -0,0 +1,52 @@
+package helpers
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildApiErrorDiagnostics(t *testing.T) {
+	var apiErr apiError
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"status": "ERROR",
+		"code": "DUPLICATE_FIELD",
+		"message": "Connector [test] already exists",
+		"correlationId": "abc-123",
+		"responseMessages": [{"code": "DUPLICATE_FIELD", "level": "ERROR", "message": "Connector [test] already exists"}]
+	}`), &apiErr))
+
+	diags := buildApiErrorDiagnostics("400 Bad Request", &apiErr, nil, &http.Response{StatusCode: http.StatusBadRequest, Status: "400 Bad Request"})
+	require.Len(t, diags, 1)
+	require.Equal(t, "Connector [test] already exists", diags[0].Summary)
+	require.Contains(t, diags[0].Detail, errorCodeHints["DUPLICATE_FIELD"])
+	require.Contains(t, diags[0].Detail, "abc-123")
+}
+
+func TestBuildApiErrorDiagnosticsFieldErrors(t *testing.T) {
+	var apiErr apiError
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"status": "FAILURE",
+		"code": "INVALID_REQUEST",
+		"errors": [{"fieldId": "identifier", "error": "must match regex"}]
+	}`), &apiErr))
+
+	diags := buildApiErrorDiagnostics("400 Bad Request", &apiErr, nil, nil)
+	require.Len(t, diags, 2)
+	require.Equal(t, "400 Bad Request", diags[0].Summary)
+	require.Equal(t, "Invalid value for identifier", diags[1].Summary)
+	require.Equal(t, "must match regex", diags[1].Detail)
+}
+
+func TestBuildApiErrorDiagnosticsUnauthorized(t *testing.T) {
+	diags := buildApiErrorDiagnostics("401 Unauthorized", &apiError{}, nil, &http.Response{StatusCode: http.StatusUnauthorized, Status: "401 Unauthorized"})
+	require.Len(t, diags, 1)
+	require.Contains(t, diags[0].Detail, unauthorizedHint)
+}
+
+func TestToSnakeCase(t *testing.T) {
+	require.Equal(t, "connector_ref", toSnakeCase("connectorRef"))
+	require.Equal(t, "identifier", toSnakeCase("identifier"))
+}
@@ -0,0 +1,503 @@
+package helpers
+
+import (
+	"github.com/antihax/optional"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	GitStoreTypeInline = "INLINE"
+	GitStoreTypeRemote = "REMOTE"
+
+	GitMoveConfigInlineToRemote = "INLINE_TO_REMOTE"
+	GitMoveConfigRemoteToInline = "REMOTE_TO_INLINE"
+)
+
+// gitImportInfoKeys are the names of the block configuring the import of an entity from git. The template
+// resource predates the other resources and names it `git_import_details`.
+var gitImportInfoKeys = []string{"git_import_info", "git_import_details"}
+
+// GitDetails is the Git Experience configuration of an entity. The resources convert it from and into the
+// models of their API.
+type GitDetails struct {
+	StoreType                string
+	ConnectorRef             string
+	RepoName                 string
+	FilePath                 string
+	BranchName               string
+	BaseBranch               string
+	CommitMessage            string
+	LastObjectId             string
+	LastCommitId             string
+	ParentEntityConnectorRef string
+	ParentEntityRepoName     string
+}
+
+// IsNewBranch reports whether the changes are committed to a new branch created from the base branch.
+func (g *GitDetails) IsNewBranch() bool {
+	return g.BaseBranch != ""
+}
+
+// GitImportInfo is the location of an entity imported from git.
+type GitImportInfo struct {
+	ConnectorRef  string
+	RepoName      string
+	FilePath      string
+	BranchName    string
+	IsForceImport bool
+}
+
+// GitOptions are the Git Experience parameters of the requests creating or updating an entity.
+type GitOptions struct {
+	StoreType     optional.String
+	ConnectorRef  optional.String
+	RepoName      optional.String
+	FilePath      optional.String
+	BranchName    optional.String
+	BaseBranch    optional.String
+	IsNewBranch   optional.Bool
+	CommitMessage optional.String
+	LastObjectId  optional.String
+	LastCommitId  optional.String
+}
+
+// GitImportOptions are the parameters of the requests importing an entity from git.
+type GitImportOptions struct {
+	ConnectorRef  optional.String
+	RepoName      optional.String
+	FilePath      optional.String
+	BranchName    optional.String
+	IsForceImport optional.Bool
+}
+
+// GitReadOptions are the parameters to read an entity from the branch it's managed on.
+type GitReadOptions struct {
+	BranchName               optional.String
+	ConnectorRef             optional.String
+	RepoName                 optional.String
+	ParentEntityConnectorRef optional.String
+	ParentEntityRepoName     optional.String
+}
+
+// GitMoveDetails is the location in git an entity is moved to or from.
+type GitMoveDetails struct {
+	ConnectorRef  string `json:"connector_ref,omitempty"`
+	RepoName      string `json:"repo_name,omitempty"`
+	FilePath      string `json:"file_path,omitempty"`
+	BranchName    string `json:"branch_name,omitempty"`
+	BaseBranch    string `json:"base_branch,omitempty"`
+	CommitMessage string `json:"commit_message,omitempty"`
+	IsNewBranch   bool   `json:"is_new_branch,omitempty"`
+}
+
+// GitMoveConfigRequestBody is the body of the requests moving a pipeline or an input set between Harness and git.
+type GitMoveConfigRequestBody struct {
+	PipelineIdentifier      string          `json:"pipeline_identifier,omitempty"`
+	GitDetails              *GitMoveDetails `json:"git_details,omitempty"`
+	MoveConfigOperationType string          `json:"move_config_operation_type"`
+}
+
+// GetGitDetailsSchema returns the schema of the `git_details` block. The parent entity fields are only
+// used by the entities stored along with a parent entity, e.g. input sets.
+func GetGitDetailsSchema(parentEntity bool) *schema.Schema {
+	s := map[string]*schema.Schema{
+		"branch_name": {
+			Description: "Name of the branch.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"file_path": {
+			Description: "File path of the Entity in the repository.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"commit_message": {
+			Description: "Commit message used for the merge commit.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"base_branch": {
+			Description: "Name of the default branch (this checks out a new branch titled by branch_name).",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"connector_ref": {
+			Description: "Identifier of the Harness Connector used for CRUD operations on the Entity." + Descriptions.ConnectorRefText.String(),
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"store_type": {
+			Description:  "Specifies whether the Entity is to be stored in Git or not. Possible values: INLINE, REMOTE.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice([]string{GitStoreTypeInline, GitStoreTypeRemote}, false),
+		},
+		"repo_name": {
+			Description: "Name of the repository.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"last_object_id": {
+			Description: "Last object identifier (for Github). To be provided only when updating the Entity.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"last_commit_id": {
+			Description: "Last commit identifier (for Git Repositories other than Github). To be provided only when updating the Entity.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+	}
+
+	if parentEntity {
+		s["parent_entity_connector_ref"] = &schema.Schema{
+			Description: "Connector reference for Parent Entity (Pipeline)." + Descriptions.ConnectorRefText.String(),
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		}
+		s["parent_entity_repo_name"] = &schema.Schema{
+			Description: "Repository name for Parent Entity (Pipeline).",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		}
+	}
+
+	return &schema.Schema{
+		Description: "Contains parameters related to creating an Entity for Git Experience.",
+		Type:        schema.TypeList,
+		MaxItems:    1,
+		Optional:    true,
+		Computed:    true,
+		Elem:        &schema.Resource{Schema: s},
+	}
+}
+
+// GetImportFromGitSchema returns the schema of the `import_from_git` flag.
+func GetImportFromGitSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Flag to set if importing from Git",
+		Type:        schema.TypeBool,
+		Optional:    true,
+	}
+}
+
+// GetGitImportInfoSchema returns the schema of the block locating the entity to import from git.
+func GetGitImportInfoSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Contains Git Information for importing entities from Git",
+		Type:        schema.TypeList,
+		MaxItems:    1,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"branch_name": {
+					Description: "Name of the branch.",
+					Type:        schema.TypeString,
+					Optional:    true,
+				},
+				"file_path": {
+					Description: "File path of the Entity in the repository.",
+					Type:        schema.TypeString,
+					Optional:    true,
+				},
+				"connector_ref": {
+					Description: "Identifier of the Harness Connector used for importing entity from Git" + Descriptions.ConnectorRefText.String(),
+					Type:        schema.TypeString,
+					Optional:    true,
+				},
+				"repo_name": {
+					Description: "Name of the repository.",
+					Type:        schema.TypeString,
+					Optional:    true,
+				},
+				"is_force_import": {
+					Description: "Import the entity even if its YAML fails validation.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+				},
+			},
+		},
+	}
+}
+
+// SetGitExperienceSchema adds the `git_details`, `import_from_git` and `git_import_info` arguments to the schema of
+// a resource that can be stored in git.
+func SetGitExperienceSchema(s map[string]*schema.Schema) {
+	s["git_details"] = GetGitDetailsSchema(false)
+	s["import_from_git"] = GetImportFromGitSchema()
+	s["git_import_info"] = GetGitImportInfoSchema()
+}
+
+// SetGitMoveConfigSchema documents that changing `git_details.store_type` moves an existing entity between
+// Harness and git, for the resources supporting it.
+func SetGitMoveConfigSchema(s map[string]*schema.Schema) {
+	storeType := s["git_details"].Elem.(*schema.Resource).Schema["store_type"]
+	storeType.Description += " Changing it moves the existing Entity between Harness and Git, keeping its identifier and execution history."
+}
+
+// IsImportFromGit reports whether the entity is to be created by importing it from git.
+func IsImportFromGit(d *schema.ResourceData) bool {
+	return d.Get("import_from_git").(bool)
+}
+
+// ExpandGitImportInfo returns the location of the entity to import from git, nil when it's not set.
+func ExpandGitImportInfo(d *schema.ResourceData) *GitImportInfo {
+	for _, key := range gitImportInfoKeys {
+		attr, ok := d.GetOk(key)
+		if !ok {
+			continue
+		}
+
+		configs := attr.([]interface{})
+		if len(configs) == 0 || configs[0] == nil {
+			return nil
+		}
+
+		config := configs[0].(map[string]interface{})
+		info := &GitImportInfo{}
+		info.BranchName, _ = config["branch_name"].(string)
+		info.FilePath, _ = config["file_path"].(string)
+		info.ConnectorRef, _ = config["connector_ref"].(string)
+		info.RepoName, _ = config["repo_name"].(string)
+		info.IsForceImport, _ = config["is_force_import"].(bool)
+		return info
+	}
+
+	return nil
+}
+
+// ExpandGitDetails returns the Git Experience configuration of an entity, nil when it's not set. The location of
+// an entity imported from git is used when `git_details` isn't set.
+func ExpandGitDetails(d *schema.ResourceData) *GitDetails {
+	if attr, ok := d.GetOk("git_details"); ok {
+		configs := attr.([]interface{})
+		if len(configs) > 0 && configs[0] != nil {
+			config := configs[0].(map[string]interface{})
+			git := &GitDetails{}
+			git.StoreType, _ = config["store_type"].(string)
+			git.ConnectorRef, _ = config["connector_ref"].(string)
+			git.RepoName, _ = config["repo_name"].(string)
+			git.FilePath, _ = config["file_path"].(string)
+			git.BranchName, _ = config["branch_name"].(string)
+			git.BaseBranch, _ = config["base_branch"].(string)
+			git.CommitMessage, _ = config["commit_message"].(string)
+			git.LastObjectId, _ = config["last_object_id"].(string)
+			git.LastCommitId, _ = config["last_commit_id"].(string)
+			git.ParentEntityConnectorRef, _ = config["parent_entity_connector_ref"].(string)
+			git.ParentEntityRepoName, _ = config["parent_entity_repo_name"].(string)
+			return git
+		}
+	}
+
+	if _, ok := d.GetOk("import_from_git"); ok {
+		if info := ExpandGitImportInfo(d); info != nil {
+			return &GitDetails{
+				StoreType:    GitStoreTypeRemote,
+				ConnectorRef: info.ConnectorRef,
+				RepoName:     info.RepoName,
+				FilePath:     info.FilePath,
+				BranchName:   info.BranchName,
+			}
+		}
+	}
+
+	return nil
+}
+
+// BuildGitOptions returns the Git Experience parameters of the requests creating or updating an entity. All the
+// parameters are empty for the entities stored in Harness.
+func BuildGitOptions(d *schema.ResourceData) GitOptions {
+	opts := GitOptions{
+		StoreType:     optional.EmptyString(),
+		ConnectorRef:  optional.EmptyString(),
+		RepoName:      optional.EmptyString(),
+		FilePath:      optional.EmptyString(),
+		BranchName:    optional.EmptyString(),
+		BaseBranch:    optional.EmptyString(),
+		IsNewBranch:   optional.EmptyBool(),
+		CommitMessage: optional.EmptyString(),
+		LastObjectId:  optional.EmptyString(),
+		LastCommitId:  optional.EmptyString(),
+	}
+
+	git := ExpandGitDetails(d)
+	if git == nil {
+		return opts
+	}
+
+	opts.StoreType = buildOptionalString(git.StoreType)
+	opts.ConnectorRef = buildOptionalString(git.ConnectorRef)
+	opts.RepoName = buildOptionalString(git.RepoName)
+	opts.FilePath = buildOptionalString(git.FilePath)
+	opts.BranchName = buildOptionalString(git.BranchName)
+	opts.BaseBranch = buildOptionalString(git.BaseBranch)
+	opts.CommitMessage = buildOptionalString(git.CommitMessage)
+	opts.LastObjectId = buildOptionalString(git.LastObjectId)
+	opts.LastCommitId = buildOptionalString(git.LastCommitId)
+	if git.IsNewBranch() {
+		opts.IsNewBranch = optional.NewBool(true)
+	}
+	return opts
+}
+
+// BuildGitImportOptions returns the parameters of the requests importing an entity from git.
+func BuildGitImportOptions(d *schema.ResourceData) GitImportOptions {
+	opts := GitImportOptions{
+		ConnectorRef:  optional.EmptyString(),
+		RepoName:      optional.EmptyString(),
+		FilePath:      optional.EmptyString(),
+		BranchName:    optional.EmptyString(),
+		IsForceImport: optional.EmptyBool(),
+	}
+
+	info := ExpandGitImportInfo(d)
+	if info == nil {
+		return opts
+	}
+
+	opts.ConnectorRef = buildOptionalString(info.ConnectorRef)
+	opts.RepoName = buildOptionalString(info.RepoName)
+	opts.FilePath = buildOptionalString(info.FilePath)
+	opts.BranchName = buildOptionalString(info.BranchName)
+	if info.IsForceImport {
+		opts.IsForceImport = optional.NewBool(true)
+	}
+	return opts
+}
+
+// BuildGitReadOptions returns the parameters to read an entity from the branch it's managed on. The default branch
+// of the repository is read when no branch is set.
+func BuildGitReadOptions(d *schema.ResourceData) GitReadOptions {
+	opts := GitReadOptions{
+		BranchName:               optional.EmptyString(),
+		ConnectorRef:             optional.EmptyString(),
+		RepoName:                 optional.EmptyString(),
+		ParentEntityConnectorRef: optional.EmptyString(),
+		ParentEntityRepoName:     optional.EmptyString(),
+	}
+
+	git := ExpandGitDetails(d)
+	if git == nil {
+		return opts
+	}
+
+	opts.BranchName = buildOptionalString(git.BranchName)
+	opts.ConnectorRef = buildOptionalString(git.ConnectorRef)
+	opts.RepoName = buildOptionalString(git.RepoName)
+	opts.ParentEntityConnectorRef = buildOptionalString(git.ParentEntityConnectorRef)
+	opts.ParentEntityRepoName = buildOptionalString(git.ParentEntityRepoName)
+	return opts
+}
+
+// FlattenGitDetails returns the `git_details` of an entity from the details returned by Harness. The fields
+// that aren't returned, e.g. the commit message, keep their configured value.
+func FlattenGitDetails(d *schema.ResourceData, remote *GitDetails) []interface{} {
+	git := ExpandGitDetails(d)
+	if git == nil {
+		git = &GitDetails{}
+	}
+
+	if remote != nil {
+		mergeString(&git.StoreType, remote.StoreType)
+		mergeString(&git.ConnectorRef, remote.ConnectorRef)
+		mergeString(&git.RepoName, remote.RepoName)
+		mergeString(&git.FilePath, remote.FilePath)
+		mergeString(&git.BranchName, remote.BranchName)
+		mergeString(&git.BaseBranch, remote.BaseBranch)
+		mergeString(&git.CommitMessage, remote.CommitMessage)
+		mergeString(&git.LastObjectId, remote.LastObjectId)
+		mergeString(&git.LastCommitId, remote.LastCommitId)
+		mergeString(&git.ParentEntityConnectorRef, remote.ParentEntityConnectorRef)
+		mergeString(&git.ParentEntityRepoName, remote.ParentEntityRepoName)
+	}
+
+	details := map[string]interface{}{
+		"store_type":     git.StoreType,
+		"connector_ref":  git.ConnectorRef,
+		"repo_name":      git.RepoName,
+		"file_path":      git.FilePath,
+		"branch_name":    git.BranchName,
+		"base_branch":    git.BaseBranch,
+		"commit_message": git.CommitMessage,
+		"last_object_id": git.LastObjectId,
+		"last_commit_id": git.LastCommitId,
+	}
+	if _, ok := d.GetOk("git_details.0.parent_entity_connector_ref"); ok || git.ParentEntityConnectorRef != "" {
+		details["parent_entity_connector_ref"] = git.ParentEntityConnectorRef
+	}
+	if _, ok := d.GetOk("git_details.0.parent_entity_repo_name"); ok || git.ParentEntityRepoName != "" {
+		details["parent_entity_repo_name"] = git.ParentEntityRepoName
+	}
+
+	return []interface{}{details}
+}
+
+// SetGitDetails sets the `git_details` of an entity from the details returned by Harness, if any.
+func SetGitDetails(d *schema.ResourceData, remote *GitDetails) {
+	if remote == nil {
+		return
+	}
+
+	d.Set("git_details", FlattenGitDetails(d, remote))
+}
+
+// GetGitMoveConfigType returns the move between Harness and git of an existing entity requested by a change of
+// `git_details.store_type`, an empty string when the entity isn't moved. Entities without a store type are
+// stored in Harness.
+func GetGitMoveConfigType(d *schema.ResourceData) string {
+	if d.Id() == "" || d.IsNewResource() || !d.HasChange("git_details.0.store_type") {
+		return ""
+	}
+
+	old, new := d.GetChange("git_details.0.store_type")
+	switch {
+	case new.(string) == GitStoreTypeRemote && old.(string) != GitStoreTypeRemote:
+		return GitMoveConfigInlineToRemote
+	case new.(string) == GitStoreTypeInline && old.(string) == GitStoreTypeRemote:
+		return GitMoveConfigRemoteToInline
+	}
+	return ""
+}
+
+// BuildGitMoveConfigRequestBody returns the body of the request moving an entity between Harness and git.
+func BuildGitMoveConfigRequestBody(d *schema.ResourceData, moveConfigType string) *GitMoveConfigRequestBody {
+	body := &GitMoveConfigRequestBody{MoveConfigOperationType: moveConfigType}
+
+	if git := ExpandGitDetails(d); git != nil {
+		body.GitDetails = &GitMoveDetails{
+			ConnectorRef:  git.ConnectorRef,
+			RepoName:      git.RepoName,
+			FilePath:      git.FilePath,
+			BranchName:    git.BranchName,
+			BaseBranch:    git.BaseBranch,
+			CommitMessage: git.CommitMessage,
+			IsNewBranch:   git.IsNewBranch(),
+		}
+	}
+	return body
+}
+
+func mergeString(dst *string, src string) {
+	if src != "" {
+		*dst = src
+	}
+}
+
+func buildOptionalString(v string) optional.String {
+	if v == "" {
+		return optional.EmptyString()
+	}
+	return optional.NewString(v)
+}
@@ -0,0 +1,124 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitDetails(t *testing.T) {
+	s := map[string]*schema.Schema{}
+	SetGitExperienceSchema(s)
+
+	d := schema.TestResourceDataRaw(t, s, map[string]interface{}{
+		"git_details": []interface{}{map[string]interface{}{
+			"store_type":     GitStoreTypeRemote,
+			"connector_ref":  "account.github",
+			"repo_name":      "entities",
+			"file_path":      ".harness/service.yaml",
+			"branch_name":    "feature",
+			"base_branch":    "main",
+			"commit_message": "Create service",
+		}},
+	})
+
+	git := ExpandGitDetails(d)
+	require.Equal(t, GitStoreTypeRemote, git.StoreType)
+	require.True(t, git.IsNewBranch())
+
+	opts := BuildGitOptions(d)
+	require.Equal(t, "feature", opts.BranchName.Value())
+	require.True(t, opts.IsNewBranch.Value())
+	require.False(t, opts.LastObjectId.IsSet())
+
+	read := BuildGitReadOptions(d)
+	require.Equal(t, "feature", read.BranchName.Value())
+	require.Equal(t, "entities", read.RepoName.Value())
+
+	SetGitDetails(d, &GitDetails{
+		StoreType:    GitStoreTypeRemote,
+		BranchName:   "feature",
+		FilePath:     ".harness/service.yaml",
+		LastObjectId: "object",
+	})
+	require.Equal(t, "object", d.Get("git_details.0.last_object_id"))
+	require.Equal(t, "Create service", d.Get("git_details.0.commit_message"))
+	require.Equal(t, "account.github", d.Get("git_details.0.connector_ref"))
+}
+
+func TestGitImportInfo(t *testing.T) {
+	s := map[string]*schema.Schema{}
+	SetGitExperienceSchema(s)
+
+	d := schema.TestResourceDataRaw(t, s, map[string]interface{}{})
+	require.False(t, IsImportFromGit(d))
+	require.Nil(t, ExpandGitDetails(d))
+	require.False(t, BuildGitOptions(d).StoreType.IsSet())
+	require.False(t, BuildGitImportOptions(d).FilePath.IsSet())
+
+	d = schema.TestResourceDataRaw(t, s, map[string]interface{}{
+		"import_from_git": true,
+		"git_import_info": []interface{}{map[string]interface{}{
+			"connector_ref":   "account.github",
+			"repo_name":       "entities",
+			"file_path":       ".harness/service.yaml",
+			"branch_name":     "main",
+			"is_force_import": true,
+		}},
+	})
+	require.True(t, IsImportFromGit(d))
+
+	opts := BuildGitImportOptions(d)
+	require.Equal(t, ".harness/service.yaml", opts.FilePath.Value())
+	require.True(t, opts.IsForceImport.Value())
+
+	// The entity is read from the branch it was imported from.
+	require.Equal(t, "main", BuildGitReadOptions(d).BranchName.Value())
+	require.Equal(t, GitStoreTypeRemote, ExpandGitDetails(d).StoreType)
+}
+
+func TestGitMoveConfigType(t *testing.T) {
+	s := map[string]*schema.Schema{}
+	SetGitExperienceSchema(s)
+
+	newData := func(oldStoreType string, newStoreType string) *schema.ResourceData {
+		attributes := map[string]string{"id": "pipeline"}
+		if oldStoreType != "" {
+			attributes["git_details.#"] = "1"
+			attributes["git_details.0.store_type"] = oldStoreType
+		}
+		state := &terraform.InstanceState{ID: "pipeline", Attributes: attributes}
+
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"git_details": []interface{}{map[string]interface{}{
+				"store_type":     newStoreType,
+				"connector_ref":  "account.github",
+				"repo_name":      "entities",
+				"file_path":      ".harness/pipeline.yaml",
+				"branch_name":    "main",
+				"commit_message": "Move pipeline",
+			}},
+		})
+
+		diff, err := schema.InternalMap(s).Diff(context.Background(), state, config, nil, nil, true)
+		require.NoError(t, err)
+		d, err := schema.InternalMap(s).Data(state, diff)
+		require.NoError(t, err)
+		return d
+	}
+
+	d := newData("", GitStoreTypeRemote)
+	require.Equal(t, GitMoveConfigInlineToRemote, GetGitMoveConfigType(d))
+
+	body := BuildGitMoveConfigRequestBody(d, GitMoveConfigInlineToRemote)
+	require.Equal(t, GitMoveConfigInlineToRemote, body.MoveConfigOperationType)
+	require.Equal(t, ".harness/pipeline.yaml", body.GitDetails.FilePath)
+	require.False(t, body.GitDetails.IsNewBranch)
+
+	require.Equal(t, GitMoveConfigRemoteToInline, GetGitMoveConfigType(newData(GitStoreTypeRemote, GitStoreTypeInline)))
+	require.Equal(t, "", GetGitMoveConfigType(newData(GitStoreTypeRemote, GitStoreTypeRemote)))
+	require.Equal(t, "", GetGitMoveConfigType(newData("", GitStoreTypeInline)))
+}
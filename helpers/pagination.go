@@ -0,0 +1,113 @@
+package helpers
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ListPageSize is the number of entities requested per page by the list data sources.
+const ListPageSize = 100
+
+// maxListPages protects the list data sources against APIs that never report the last page.
+const maxListPages = 10000
+
+// PageFunc fetches the page with the given index, starting at 0, and reports whether more pages
+// follow it.
+type PageFunc func(page int32) (more bool, httpResp *http.Response, err error)
+
+// ListAllPages calls fetch for each page until it reports that there are no more pages. The http
+// response of the failing call is returned along with the error.
+func ListAllPages(fetch PageFunc) (*http.Response, error) {
+	for page := int32(0); page < maxListPages; page++ {
+		more, httpResp, err := fetch(page)
+		if err != nil {
+			return httpResp, err
+		}
+
+		if !more {
+			return httpResp, nil
+		}
+	}
+
+	return nil, fmt.Errorf("listing stopped after %d pages", maxListPages)
+}
+
+// HasMorePages reports whether the given page is followed by more pages, for APIs returning the
+// total number of pages.
+func HasMorePages(page int32, totalPages int64) bool {
+	return int64(page)+1 < totalPages
+}
+
+// IsFullPage reports whether a page holding count entities may be followed by more pages, for
+// APIs that don't return the total number of pages.
+func IsFullPage(count int) bool {
+	return count >= ListPageSize
+}
+
+// ListDataSourceId identifies the entities listed by a list data source by the account and the
+// values of the given arguments, so that the id stays the same between reads of the same list.
+func ListDataSourceId(accountId string, d *schema.ResourceData, keys ...string) string {
+	h := sha256.New()
+	for _, k := range keys {
+		v := d.Get(k)
+		if set, ok := v.(*schema.Set); ok {
+			v = set.List()
+		}
+		fmt.Fprintf(h, "%s=%v;", k, v)
+	}
+
+	return fmt.Sprintf("%s/%x", accountId, h.Sum(nil))
+}
+
+// SetListDataSourceFilterSchema adds the `search_term` and `filter_tags` filters shared by the
+// list data sources.
+func SetListDataSourceFilterSchema(s map[string]*schema.Schema) {
+	s["search_term"] = &schema.Schema{
+		Description: "Filter the results by a search term matched against the name and identifier.",
+		Type:        schema.TypeString,
+		Optional:    true,
+	}
+
+	s["filter_tags"] = &schema.Schema{
+		Description: "Filter the results by tags in the format `key:value`. Only entities having all of the tags are returned.",
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+// MatchesFilterTags reports whether the tags hold every tag of the `filter_tags` argument. It is
+// used by the list data sources whose API can't filter by tags.
+func MatchesFilterTags(d *schema.ResourceData, tags map[string]string) bool {
+	filter, ok := d.GetOk("filter_tags")
+	if !ok {
+		return true
+	}
+
+	for k, v := range ExpandTags(filter.(*schema.Set).List()) {
+		if value, ok := tags[k]; !ok || value != v {
+			return false
+		}
+	}
+	return true
+}
+
+// MatchesSearchTerm reports whether one of the values contains the `search_term` argument,
+// ignoring case. It is used by the list data sources whose API can't filter by search term.
+func MatchesSearchTerm(d *schema.ResourceData, values ...string) bool {
+	term := strings.ToLower(d.Get("search_term").(string))
+	if term == "" {
+		return true
+	}
+
+	for _, v := range values {
+		if strings.Contains(strings.ToLower(v), term) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,69 @@
+package helpers
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListAllPages(t *testing.T) {
+	var pages []int32
+	_, err := ListAllPages(func(page int32) (bool, *http.Response, error) {
+		pages = append(pages, page)
+		return HasMorePages(page, 3), nil, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int32{0, 1, 2}, pages)
+
+	_, err = ListAllPages(func(page int32) (bool, *http.Response, error) {
+		if page == 1 {
+			return false, nil, errors.New("failed")
+		}
+		return true, nil, nil
+	})
+	require.EqualError(t, err, "failed")
+
+	require.True(t, IsFullPage(ListPageSize))
+	require.False(t, IsFullPage(ListPageSize-1))
+}
+
+func TestListDataSourceFilters(t *testing.T) {
+	s := map[string]*schema.Schema{}
+	SetListDataSourceFilterSchema(s)
+
+	d := schema.TestResourceDataRaw(t, s, map[string]interface{}{
+		"search_term": "Prod",
+		"filter_tags": []interface{}{"team:platform", "critical"},
+	})
+
+	require.True(t, MatchesSearchTerm(d, "my_service", "production"))
+	require.False(t, MatchesSearchTerm(d, "my_service", "staging"))
+
+	require.True(t, MatchesFilterTags(d, map[string]string{"team": "platform", "critical": "", "other": "x"}))
+	require.False(t, MatchesFilterTags(d, map[string]string{"team": "platform"}))
+	require.False(t, MatchesFilterTags(d, map[string]string{"team": "other", "critical": ""}))
+
+	d = schema.TestResourceDataRaw(t, s, map[string]interface{}{})
+	require.True(t, MatchesSearchTerm(d, "anything"))
+	require.True(t, MatchesFilterTags(d, nil))
+}
+
+func TestListDataSourceId(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"org_id": {Type: schema.TypeString, Optional: true},
+	}
+	SetListDataSourceFilterSchema(s)
+
+	id := func(raw map[string]interface{}) string {
+		return ListDataSourceId("account", schema.TestResourceDataRaw(t, s, raw), "org_id", "search_term", "filter_tags")
+	}
+
+	first := id(map[string]interface{}{"org_id": "org", "filter_tags": []interface{}{"a:b", "c:d"}})
+	require.Equal(t, first, id(map[string]interface{}{"org_id": "org", "filter_tags": []interface{}{"c:d", "a:b"}}))
+	require.Regexp(t, "^account/[0-9a-f]{64}$", first)
+	require.NotEqual(t, first, id(map[string]interface{}{"org_id": "org"}))
+	require.NotEqual(t, first, id(map[string]interface{}{"org_id": "other", "filter_tags": []interface{}{"a:b", "c:d"}}))
+}
@@ -2,6 +2,7 @@ package helpers
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/antihax/optional"
@@ -342,6 +343,24 @@ var ProjectResourceImporter = &schema.ResourceImporter{
 	},
 }
 
+// FeatureFlagEnvironmentResourceImporter defines the importer configuration for the configuration of a feature flag in an environment.
+// The id used for the import should be in the format <org_id>/<project_id>/<flag_id>/<environment_id>
+var FeatureFlagEnvironmentResourceImporter = &schema.ResourceImporter{
+	State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		parts := strings.Split(d.Id(), "/")
+		if len(parts) != 4 {
+			return nil, fmt.Errorf("invalid identifier: %s, expected <org_id>/<project_id>/<flag_id>/<environment_id>", d.Id())
+		}
+		d.Set("org_id", parts[0])
+		d.Set("project_id", parts[1])
+		d.Set("flag_id", parts[2])
+		d.Set("environment_id", parts[3])
+		d.SetId(fmt.Sprintf("%s/%s", parts[2], parts[3]))
+
+		return []*schema.ResourceData{d}, nil
+	},
+}
+
 // GitopsAgentResourceImporter defines the importer configuration for all project level gitops agent resources.
 // The id used for the import should be in the format <org_id>/<project_id>/<identifier>/<agentId>
 var GitopsAgentResourceImporter = &schema.ResourceImporter{
@@ -397,6 +416,20 @@ var OrgResourceImporter = &schema.ResourceImporter{
 	},
 }
 
+// NumericIdResourceImporter defines the importer configuration for the account level resources identified by a number,
+// such as the AutoStopping rules and schedules. The id used for the import should be in the format <identifier>
+var NumericIdResourceImporter = &schema.ResourceImporter{
+	State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		id, err := strconv.ParseInt(d.Id(), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid identifier: %s, expected a number", d.Id())
+		}
+		d.SetId(strconv.FormatInt(id, 10))
+
+		return []*schema.ResourceData{d}, nil
+	},
+}
+
 // MultiLevelResourceImporter defines the importer configuration for all multi level resources.
 // The format used for the id is as follows:
 //   - Account Level: <identifier>
@@ -0,0 +1,158 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/harness/harness-go-sdk/harness/nextgen"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// WriteOnlyStateFunc is used as the StateFunc of write-only arguments so that their value is never
+// written to the state. The value must be read from the configuration with GetWriteOnlyString.
+func WriteOnlyStateFunc(interface{}) string {
+	return ""
+}
+
+// GetWriteOnlyString returns the value of a write-only argument from the configuration.
+func GetWriteOnlyString(d *schema.ResourceData, key string) string {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() || !config.Type().IsObjectType() || !config.Type().HasAttribute(key) {
+		return ""
+	}
+
+	v := config.GetAttr(key)
+	if v.IsNull() || !v.IsKnown() {
+		return ""
+	}
+
+	return v.AsString()
+}
+
+const defaultValueSecretManager = "harnessSecretManager"
+
+// GetValueSecretSchema returns the schema of the `value_secret` block of the resources generating a value, e.g. a token,
+// that can be stored in a Harness secret instead of the state.
+func GetValueSecretSchema(attr string) *schema.Schema {
+	return &schema.Schema{
+		Description: fmt.Sprintf("Store the generated value in a Harness text secret instead of the Terraform state. `%s` is left empty when set. The secret is deleted along with the resource.", attr),
+		Type:        schema.TypeList,
+		Optional:    true,
+		ForceNew:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"identifier": {
+					Description: "Identifier of the secret.",
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+				},
+				"name": {
+					Description: "Name of the secret. Defaults to the identifier.",
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+				},
+				"secret_manager_identifier": {
+					Description: fmt.Sprintf("Identifier of the Secret Manager used to store the secret. Defaults to `%s`.", defaultValueSecretManager),
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Default:     defaultValueSecretManager,
+				},
+				"org_id": {
+					Description: "Unique identifier of the organization of the secret. The secret is created at the account level when not set.",
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+				},
+				"project_id": {
+					Description: "Unique identifier of the project of the secret.",
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+				},
+				"secret_ref": {
+					Description: "Reference to the secret, e.g. `account.my_token`, to be used in the fields expecting a secret reference.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+// HasValueSecret reports whether the generated value is to be stored in a secret.
+func HasValueSecret(d *schema.ResourceData) bool {
+	return len(d.Get("value_secret").([]interface{})) > 0
+}
+
+func valueSecretConfig(d *schema.ResourceData) map[string]interface{} {
+	return d.Get("value_secret.0").(map[string]interface{})
+}
+
+// StoreValueInSecret creates the secret configured in the `value_secret` block with the given value.
+func StoreValueInSecret(ctx context.Context, c *nextgen.APIClient, d *schema.ResourceData, value string) (*http.Response, error) {
+	cfg := valueSecretConfig(d)
+	orgId := cfg["org_id"].(string)
+	projectId := cfg["project_id"].(string)
+
+	secret := &nextgen.Secret{
+		Type_:             nextgen.SecretTypes.SecretText,
+		Identifier:        cfg["identifier"].(string),
+		Name:              cfg["name"].(string),
+		OrgIdentifier:     orgId,
+		ProjectIdentifier: projectId,
+		Text: &nextgen.SecretTextSpec{
+			SecretManagerIdentifier: cfg["secret_manager_identifier"].(string),
+			ValueType:               nextgen.SecretTextValueType("Inline"),
+			Value:                   value,
+		},
+	}
+	if secret.Name == "" {
+		secret.Name = secret.Identifier
+	}
+
+	_, httpResp, err := c.SecretsApi.PostSecret(ctx, nextgen.SecretRequestWrapper{Secret: secret}, c.AccountId, &nextgen.SecretsApiPostSecretOpts{
+		OrgIdentifier:     BuildField(d, "value_secret.0.org_id"),
+		ProjectIdentifier: BuildField(d, "value_secret.0.project_id"),
+	})
+	if err != nil {
+		return httpResp, err
+	}
+
+	cfg["secret_ref"] = secretRef(secret.Identifier, orgId, projectId)
+	d.Set("value_secret", []interface{}{cfg})
+
+	return httpResp, nil
+}
+
+// DeleteValueSecret deletes the secret configured in the `value_secret` block, if any.
+func DeleteValueSecret(ctx context.Context, c *nextgen.APIClient, d *schema.ResourceData) (*http.Response, error) {
+	if !HasValueSecret(d) {
+		return nil, nil
+	}
+
+	_, httpResp, err := c.SecretsApi.DeleteSecretV2(ctx, d.Get("value_secret.0.identifier").(string), c.AccountId, &nextgen.SecretsApiDeleteSecretV2Opts{
+		OrgIdentifier:     BuildField(d, "value_secret.0.org_id"),
+		ProjectIdentifier: BuildField(d, "value_secret.0.project_id"),
+	})
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		return httpResp, nil
+	}
+
+	return httpResp, err
+}
+
+func secretRef(id, orgId, projectId string) string {
+	switch {
+	case projectId != "":
+		return id
+	case orgId != "":
+		return "org." + id
+	default:
+		return "account." + id
+	}
+}
@@ -0,0 +1,299 @@
+package helpers
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/yaml.v3"
+)
+
+// The schemas bundled with the provider only describe the structure of the entities that is stable
+// across Harness releases: the root element, the required fields and the types of the fields.
+// Harness adds fields and values with every release and accepts expressions in most fields, so
+// unknown fields and the values of the fields are not checked.
+//
+//go:embed yamlschema/*.json
+var yamlSchemas embed.FS
+
+// YamlScopeArguments maps the scope fields of an entity YAML to the arguments of the resource.
+var YamlScopeArguments = map[string]string{
+	"identifier":        "identifier",
+	"orgIdentifier":     "org_id",
+	"projectIdentifier": "project_id",
+}
+
+// yamlSchema is the subset of JSON schema used by the bundled schemas.
+type yamlSchema struct {
+	Type          string                 `json:"type"`
+	Required      []string               `json:"required"`
+	Properties    map[string]*yamlSchema `json:"properties"`
+	MinProperties *int                   `json:"minProperties"`
+	MaxProperties *int                   `json:"maxProperties"`
+	Items         *yamlSchema            `json:"items"`
+}
+
+// YamlError is a validation error of a YAML document.
+type YamlError struct {
+	// Line is the line of the document the error refers to, starting at 1.
+	Line int
+	// Path is the path of the invalid field, e.g. `pipeline.stages[0].stage`.
+	Path    string
+	Message string
+}
+
+func (e YamlError) Error() string {
+	if e.Path == "" {
+		return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+	}
+	return fmt.Sprintf("line %d: %s: %s", e.Line, e.Path, e.Message)
+}
+
+// ValidateYamlDiff returns a CustomizeDiffFunc validating the YAML held by the given attribute
+// against the bundled schema of the entity, e.g. `pipeline`, during plan.
+//
+// The arguments map the fields of the entity in the YAML, e.g. `orgIdentifier` or
+// `pipeline.identifier` for the pipeline of an input set, to the resource arguments they must
+// match. Fields and arguments that are not set or not known yet are not compared.
+func ValidateYamlDiff(entity string, attribute string, arguments map[string]string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !d.NewValueKnown(attribute) {
+			return nil
+		}
+
+		doc := d.Get(attribute).(string)
+		if strings.TrimSpace(doc) == "" {
+			return nil
+		}
+
+		values := map[string]string{}
+		for field, argument := range arguments {
+			if !d.NewValueKnown(argument) {
+				continue
+			}
+			if v, ok := d.Get(argument).(string); ok && v != "" {
+				values[field] = v
+			}
+		}
+
+		errs, err := ValidateYaml(entity, doc, values)
+		if err != nil {
+			return err
+		}
+
+		if len(errs) == 0 {
+			return nil
+		}
+
+		msgs := make([]string, 0, len(errs))
+		for _, e := range errs {
+			msgs = append(msgs, e.Error())
+		}
+		return fmt.Errorf("invalid %s YAML in %s:\n  %s", entity, attribute, strings.Join(msgs, "\n  "))
+	}
+}
+
+// ValidateYaml validates the YAML document of an entity against the bundled schema and checks
+// that the fields of the entity match the given values, keyed by field path relative to the root
+// element of the entity.
+func ValidateYaml(entity string, doc string, values map[string]string) ([]YamlError, error) {
+	s, err := loadYamlSchema(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	var root yaml.Node
+	if err := yaml.Unmarshal([]byte(doc), &root); err != nil {
+		return []YamlError{yamlSyntaxError(err)}, nil
+	}
+
+	if len(root.Content) == 0 {
+		return nil, nil
+	}
+
+	node := root.Content[0]
+	errs := validateYamlNode(s, node, "")
+
+	// The fields to compare are relative to the entity, i.e. the value of the root element.
+	if node.Kind == yaml.MappingNode && len(node.Content) == 2 {
+		key, entityNode := node.Content[0].Value, resolveYamlAlias(node.Content[1])
+
+		fields := make([]string, 0, len(values))
+		for field := range values {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+
+		for _, field := range fields {
+			n := lookupYamlNode(entityNode, field)
+			if n == nil || n.Kind != yaml.ScalarNode || isYamlExpression(n.Value) || n.Value == "" {
+				continue
+			}
+
+			if n.Value != values[field] {
+				errs = append(errs, YamlError{
+					Line:    n.Line,
+					Path:    key + "." + field,
+					Message: fmt.Sprintf("%q does not match the value %q set on the resource", n.Value, values[field]),
+				})
+			}
+		}
+	}
+
+	sort.SliceStable(errs, func(i, j int) bool {
+		return errs[i].Line < errs[j].Line
+	})
+
+	return errs, nil
+}
+
+func loadYamlSchema(entity string) (*yamlSchema, error) {
+	data, err := yamlSchemas.ReadFile("yamlschema/" + entity + ".json")
+	if err != nil {
+		return nil, fmt.Errorf("no YAML schema for %s: %s", entity, err)
+	}
+
+	s := &yamlSchema{}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, fmt.Errorf("invalid YAML schema for %s: %s", entity, err)
+	}
+	return s, nil
+}
+
+var yamlLineRegexp = regexp.MustCompile(`^yaml: line (\d+): (.*)$`)
+
+func yamlSyntaxError(err error) YamlError {
+	if m := yamlLineRegexp.FindStringSubmatch(err.Error()); m != nil {
+		var line int
+		fmt.Sscanf(m[1], "%d", &line)
+		return YamlError{Line: line, Message: m[2]}
+	}
+	return YamlError{Line: 1, Message: strings.TrimPrefix(err.Error(), "yaml: ")}
+}
+
+func validateYamlNode(s *yamlSchema, node *yaml.Node, path string) []YamlError {
+	node = resolveYamlAlias(node)
+
+	// Runtime inputs and expressions are resolved by Harness during the execution.
+	if node.Kind == yaml.ScalarNode && isYamlExpression(node.Value) {
+		return nil
+	}
+
+	if msg := checkYamlType(s.Type, node); msg != "" {
+		return []YamlError{{Line: node.Line, Path: path, Message: msg}}
+	}
+
+	var errs []YamlError
+	switch node.Kind {
+	case yaml.MappingNode:
+		errs = append(errs, validateYamlMapping(s, node, path)...)
+	case yaml.SequenceNode:
+		if s.Items != nil {
+			for i, item := range node.Content {
+				errs = append(errs, validateYamlNode(s.Items, item, fmt.Sprintf("%s[%d]", path, i))...)
+			}
+		}
+	}
+
+	return errs
+}
+
+func validateYamlMapping(s *yamlSchema, node *yaml.Node, path string) []YamlError {
+	var errs []YamlError
+
+	count := len(node.Content) / 2
+	if s.MinProperties != nil && count < *s.MinProperties {
+		errs = append(errs, YamlError{Line: node.Line, Path: path, Message: fmt.Sprintf("expected one of %s", strings.Join(yamlSchemaKeys(s), ", "))})
+	}
+	if s.MaxProperties != nil && count > *s.MaxProperties {
+		errs = append(errs, YamlError{Line: node.Line, Path: path, Message: fmt.Sprintf("expected only one of %s", strings.Join(yamlSchemaKeys(s), ", "))})
+	}
+
+	present := map[string]bool{}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		present[key.Value] = true
+
+		if p, ok := s.Properties[key.Value]; ok {
+			errs = append(errs, validateYamlNode(p, value, joinYamlPath(path, key.Value))...)
+		}
+	}
+
+	for _, key := range s.Required {
+		if !present[key] {
+			errs = append(errs, YamlError{Line: node.Line, Path: path, Message: fmt.Sprintf("missing required field %q", key)})
+		}
+	}
+
+	return errs
+}
+
+func checkYamlType(t string, node *yaml.Node) string {
+	switch t {
+	case "object":
+		if node.Kind != yaml.MappingNode {
+			return "expected a map"
+		}
+	case "array":
+		if node.Kind != yaml.SequenceNode {
+			return "expected a list"
+		}
+	case "string":
+		if node.Kind != yaml.ScalarNode || node.Tag == "!!null" {
+			return "expected a string"
+		}
+	case "boolean":
+		if node.Kind != yaml.ScalarNode || node.Tag != "!!bool" {
+			return "expected true or false"
+		}
+	case "number":
+		if node.Kind != yaml.ScalarNode || (node.Tag != "!!int" && node.Tag != "!!float") {
+			return "expected a number"
+		}
+	}
+	return ""
+}
+
+// lookupYamlNode returns the node at the given dot separated path of a mapping.
+func lookupYamlNode(node *yaml.Node, path string) *yaml.Node {
+	for _, key := range strings.Split(path, ".") {
+		if node == nil || node.Kind != yaml.MappingNode {
+			return nil
+		}
+
+		var next *yaml.Node
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == key {
+				next = resolveYamlAlias(node.Content[i+1])
+				break
+			}
+		}
+		node = next
+	}
+	return node
+}
+
+func resolveYamlAlias(node *yaml.Node) *yaml.Node {
+	for node.Kind == yaml.AliasNode && node.Alias != nil {
+		node = node.Alias
+	}
+	return node
+}
+
+func isYamlExpression(value string) bool {
+	return strings.HasPrefix(strings.TrimSpace(value), "<+")
+}
+
+func yamlSchemaKeys(s *yamlSchema) []string {
+	keys := make([]string, 0, len(s.Properties))
+	for key := range s.Properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,147 @@
+package helpers_test
+
+import (
+	"testing"
+
+	"github.com/harness/terraform-provider-harness/helpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateYaml(t *testing.T) {
+	pipeline := `
+pipeline:
+  name: test
+  identifier: test
+  orgIdentifier: default
+  projectIdentifier: <+input>
+  stages:
+    - stage:
+        name: build
+        identifier: build
+        type: CI
+    - parallel:
+        - stage:
+            name: deploy
+            identifier: deploy
+`
+	errs, err := helpers.ValidateYaml("pipeline", pipeline, map[string]string{
+		"identifier":        "test",
+		"orgIdentifier":     "default",
+		"projectIdentifier": "proj",
+	})
+	require.NoError(t, err)
+	require.Empty(t, errs)
+
+	errs, err = helpers.ValidateYaml("pipeline", pipeline, map[string]string{
+		"identifier":    "other",
+		"orgIdentifier": "default",
+	})
+	require.NoError(t, err)
+	require.Len(t, errs, 1)
+	require.Equal(t, 4, errs[0].Line)
+	require.Equal(t, "pipeline.identifier", errs[0].Path)
+}
+
+func TestValidateYamlSchemaErrors(t *testing.T) {
+	errs, err := helpers.ValidateYaml("environment", `
+environment:
+  name: test
+  tags: []
+`, nil)
+	require.NoError(t, err)
+	require.Len(t, errs, 3)
+	require.Equal(t, "line 3: environment: missing required field \"identifier\"", errs[0].Error())
+	require.Equal(t, "line 3: environment: missing required field \"type\"", errs[1].Error())
+	require.Equal(t, "line 4: environment.tags: expected a map", errs[2].Error())
+
+	errs, err = helpers.ValidateYaml("pipeline", `
+pipelin:
+  name: test
+`, nil)
+	require.NoError(t, err)
+	require.Len(t, errs, 1)
+	require.Equal(t, "line 2: missing required field \"pipeline\"", errs[0].Error())
+
+	errs, err = helpers.ValidateYaml("trigger", `
+trigger:
+  name: test
+  identifier: test
+  enabled: yes please
+  source: Webhook
+`, nil)
+	require.NoError(t, err)
+	require.Len(t, errs, 2)
+	require.Equal(t, "trigger.enabled", errs[0].Path)
+	require.Equal(t, "trigger.source", errs[1].Path)
+}
+
+func TestValidateYamlAcceptsUnknownFieldsAndValues(t *testing.T) {
+	errs, err := helpers.ValidateYaml("environment", `
+environment:
+  name: test
+  identifier: test-env
+  type: <+input>.allowedValues(PreProduction,Production)
+  newField: value
+  variables:
+    - name: connector
+      type: Connector
+      value: account.<+env.name>
+`, nil)
+	require.NoError(t, err)
+	require.Empty(t, errs)
+
+	errs, err = helpers.ValidateYaml("pipeline", `
+pipeline:
+  name: test
+  identifier: test
+  stages:
+    - stage:
+        name: build
+        identifier: build_<+pipeline.sequenceId>
+      when:
+        condition: true
+`, nil)
+	require.NoError(t, err)
+	require.Empty(t, errs)
+}
+
+func TestValidateYamlSyntaxError(t *testing.T) {
+	errs, err := helpers.ValidateYaml("service", `
+service:
+  name: test
+  identifier: test
+    serviceDefinition: {}
+`, nil)
+	require.NoError(t, err)
+	require.Len(t, errs, 1)
+	require.Equal(t, 5, errs[0].Line)
+
+	_, err = helpers.ValidateYaml("unknown", "unknown: {}", nil)
+	require.Error(t, err)
+}
+
+func TestValidateYamlInputSet(t *testing.T) {
+	errs, err := helpers.ValidateYaml("input_set", `
+inputSet:
+  name: test
+  identifier: test
+  pipeline:
+    identifier: pipeline
+`, map[string]string{"pipeline.identifier": "other"})
+	require.NoError(t, err)
+	require.Len(t, errs, 1)
+	require.Equal(t, "inputSet.pipeline.identifier", errs[0].Path)
+
+	errs, err = helpers.ValidateYaml("input_set", `
+inputSet:
+  name: test
+  identifier: test
+overlayInputSet:
+  name: test
+  identifier: test
+  inputSetReferences: []
+`, nil)
+	require.NoError(t, err)
+	require.Len(t, errs, 1)
+	require.Equal(t, 2, errs[0].Line)
+}
@@ -1,7 +1,9 @@
 package helpers
 
 import (
+	"fmt"
 	"reflect"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"gopkg.in/yaml.v3"
@@ -20,3 +22,68 @@ func YamlDiffSuppressFunction(k, old, new string, d *schema.ResourceData) bool {
 	return reflect.DeepEqual(oldYaml, newYaml)
 
 }
+
+// YamlDiffPaths returns the paths of the fields that differ between two yaml documents, e.g.
+// `pipeline.stages[0].stage.name`. An error is returned if either document isn't valid yaml.
+func YamlDiffPaths(old, new string) ([]string, error) {
+	var oldYaml, newYaml interface{}
+	if err := yaml.Unmarshal([]byte(old), &oldYaml); err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal([]byte(new), &newYaml); err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	diffYaml("", oldYaml, newYaml, &paths)
+	sort.Strings(paths)
+	return paths, nil
+}
+
+func diffYaml(path string, old, new interface{}, paths *[]string) {
+	switch oldValue := old.(type) {
+	case map[string]interface{}:
+		newValue, ok := new.(map[string]interface{})
+		if !ok {
+			break
+		}
+
+		for k, v := range oldValue {
+			diffYaml(joinYamlPath(path, k), v, newValue[k], paths)
+		}
+		for k, v := range newValue {
+			if _, ok := oldValue[k]; !ok {
+				diffYaml(joinYamlPath(path, k), nil, v, paths)
+			}
+		}
+		return
+	case []interface{}:
+		newValue, ok := new.([]interface{})
+		if !ok {
+			break
+		}
+
+		for i := 0; i < len(oldValue) || i < len(newValue); i++ {
+			var o, n interface{}
+			if i < len(oldValue) {
+				o = oldValue[i]
+			}
+			if i < len(newValue) {
+				n = newValue[i]
+			}
+			diffYaml(fmt.Sprintf("%s[%d]", path, i), o, n, paths)
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(old, new) {
+		*paths = append(*paths, path)
+	}
+}
+
+func joinYamlPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -57,3 +57,33 @@ field1: value1
 `, nil))
 
 }
+
+func TestYamlDiffPaths(t *testing.T) {
+	paths, err := helpers.YamlDiffPaths(`
+pipeline:
+  name: test
+  tags: {}
+  stages:
+    - stage:
+        name: dep
+`, `
+pipeline:
+  tags:
+    foo: bar
+  name: test
+  stages:
+    - stage:
+        name: deploy
+    - stage:
+        name: verify
+`)
+	require.NoError(t, err)
+	require.Equal(t, []string{"pipeline.stages[0].stage.name", "pipeline.stages[1]", "pipeline.tags.foo"}, paths)
+
+	paths, err = helpers.YamlDiffPaths("field1: value1", `"field1": "value1"`)
+	require.NoError(t, err)
+	require.Empty(t, paths)
+
+	_, err = helpers.YamlDiffPaths("field1: [", "field1: value1")
+	require.Error(t, err)
+}
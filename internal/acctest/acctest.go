@@ -3,6 +3,7 @@ package acctest
 import (
 	"context"
 	"fmt"
+	"os"
 	"sync"
 	"testing"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/harness/harness-go-sdk/harness/utils"
 	openapi_client_nextgen "github.com/harness/harness-openapi-go-client/nextgen"
 	"github.com/harness/terraform-provider-harness/internal"
+	"github.com/harness/terraform-provider-harness/internal/acctest/fakeserver"
 	"github.com/harness/terraform-provider-harness/internal/provider"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -28,8 +30,31 @@ const (
 `
 )
 
+// TestAccFakeServerEnvVar runs the tests against an in-memory fake of the Harness API instead of a
+// real account when set to `true`. Only the resources supported by the fake server can be tested.
+const TestAccFakeServerEnvVar = "HARNESS_FAKE_API"
+
+// TestAccFakeServer is the fake Harness API the tests run against, or nil when running against a
+// real account.
+var TestAccFakeServer *fakeserver.Server
+
+// testAccStartFakeServer starts the fake Harness API and points the provider at it through the
+// environment, so that the providers created by ProviderFactories use it as well.
+func testAccStartFakeServer() {
+	TestAccFakeServer = fakeserver.New()
+
+	os.Setenv(helpers.EnvVars.Endpoint.String(), TestAccFakeServer.URL)
+	os.Setenv(helpers.EnvVars.AccountId.String(), TestAccFakeServer.AccountId)
+	os.Setenv(helpers.EnvVars.ApiKey.String(), "fake-api-key")
+	os.Setenv(helpers.EnvVars.PlatformApiKey.String(), "fake-platform-api-key")
+}
+
 func TestAccConfigureProvider() {
 	TestAccProviderConfigure.Do(func() {
+		if os.Getenv(TestAccFakeServerEnvVar) == "true" {
+			testAccStartFakeServer()
+		}
+
 		TestAccProvider = provider.Provider("dev")()
 
 		config := map[string]interface{}{
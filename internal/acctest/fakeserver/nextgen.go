@@ -0,0 +1,266 @@
+package fakeserver
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// nextgenEntity describes how an entity is exchanged with the `/ng/api` endpoints.
+type nextgenEntity struct {
+	kind string
+	// requestKey is the property the entity is wrapped in by the request, if any.
+	requestKey string
+	// responseKey is the property the entity is wrapped in by the response.
+	responseKey string
+}
+
+func (s *Server) registerNextgenRoutes() {
+	organization := nextgenEntity{kind: KindOrganization, requestKey: "organization", responseKey: "organization"}
+	s.handle(http.MethodGet, "/ng/api/organizations", s.nextgenList(organization))
+	s.handle(http.MethodPost, "/ng/api/organizations", s.nextgenCreate(organization))
+	s.handle(http.MethodGet, "/ng/api/organizations/{identifier}", s.nextgenGet(organization))
+	s.handle(http.MethodPut, "/ng/api/organizations/{identifier}", s.nextgenUpdate(organization))
+	s.handle(http.MethodDelete, "/ng/api/organizations/{identifier}", s.nextgenDelete(organization))
+
+	project := nextgenEntity{kind: KindProject, requestKey: "project", responseKey: "project"}
+	s.handle(http.MethodGet, "/ng/api/projects", s.nextgenList(project))
+	s.handle(http.MethodPost, "/ng/api/projects", s.nextgenCreate(project))
+	s.handle(http.MethodGet, "/ng/api/projects/{identifier}", s.nextgenGet(project))
+	s.handle(http.MethodPut, "/ng/api/projects/{identifier}", s.nextgenUpdate(project))
+	s.handle(http.MethodDelete, "/ng/api/projects/{identifier}", s.nextgenDelete(project))
+
+	connector := nextgenEntity{kind: KindConnector, requestKey: "connector", responseKey: "connector"}
+	s.handle(http.MethodPost, "/ng/api/connectors/listV2", s.connectorList)
+	s.handle(http.MethodPost, "/ng/api/connectors", s.nextgenCreate(connector))
+	s.handle(http.MethodPut, "/ng/api/connectors", s.nextgenUpdate(connector))
+	s.handle(http.MethodGet, "/ng/api/connectors/{identifier}", s.nextgenGet(connector))
+	s.handle(http.MethodDelete, "/ng/api/connectors/{identifier}", s.nextgenDelete(connector))
+
+	secret := nextgenEntity{kind: KindSecret, requestKey: "secret", responseKey: "secret"}
+	s.handle(http.MethodPost, "/ng/api/v2/secrets", s.nextgenCreate(secret))
+	s.handle(http.MethodGet, "/ng/api/v2/secrets/{identifier}", s.nextgenGet(secret))
+	s.handle(http.MethodPut, "/ng/api/v2/secrets/{identifier}", s.nextgenUpdate(secret))
+	s.handle(http.MethodDelete, "/ng/api/v2/secrets/{identifier}", s.nextgenDelete(secret))
+
+	environment := nextgenEntity{kind: KindEnvironment, responseKey: "environment"}
+	s.handle(http.MethodGet, "/ng/api/environmentsV2", s.nextgenList(environment))
+	s.handle(http.MethodPost, "/ng/api/environmentsV2", s.nextgenCreate(environment))
+	s.handle(http.MethodPut, "/ng/api/environmentsV2", s.nextgenUpdate(environment))
+	s.handle(http.MethodGet, "/ng/api/environmentsV2/{identifier}", s.nextgenGet(environment))
+	s.handle(http.MethodDelete, "/ng/api/environmentsV2/{identifier}", s.nextgenDelete(environment))
+}
+
+// writeNextgen writes a successful response in the envelope used by the `/ng/api` endpoints.
+func writeNextgen(w http.ResponseWriter, data interface{}) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"status": "SUCCESS",
+		"data":   data,
+	})
+}
+
+func (e nextgenEntity) wrap(obj map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		e.responseKey:    obj,
+		"createdAt":      obj["createdAt"],
+		"lastModifiedAt": obj["lastModifiedAt"],
+	}
+}
+
+func (e nextgenEntity) unwrap(body map[string]interface{}) map[string]interface{} {
+	if e.requestKey == "" {
+		return body
+	}
+
+	obj, _ := body[e.requestKey].(map[string]interface{})
+	if obj == nil {
+		obj = map[string]interface{}{}
+	}
+	return obj
+}
+
+// scope returns the org and project of an entity. The scope is taken from the request body and
+// falls back to the query parameters.
+func (e nextgenEntity) scope(r *http.Request, obj map[string]interface{}) (string, string) {
+	org := getString(obj, "orgIdentifier")
+	if org == "" {
+		org = r.URL.Query().Get("orgIdentifier")
+	}
+
+	project := getString(obj, "projectIdentifier")
+	if project == "" {
+		project = r.URL.Query().Get("projectIdentifier")
+	}
+
+	// Organizations themselves aren't scoped.
+	if e.kind == KindOrganization {
+		return "", ""
+	}
+	// Projects are scoped to their org only.
+	if e.kind == KindProject {
+		return org, ""
+	}
+	return org, project
+}
+
+func (s *Server) nextgenCreate(e nextgenEntity) func(http.ResponseWriter, *http.Request, map[string]string) {
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		body, err := decodeBody(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+			return
+		}
+
+		obj := e.unwrap(body)
+		id := getString(obj, "identifier")
+		if id == "" {
+			writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "identifier must be set")
+			return
+		}
+
+		org, project := e.scope(r, obj)
+		if e.kind == KindProject && obj["orgIdentifier"] == nil {
+			obj["orgIdentifier"] = org
+		}
+
+		key := scopedKey(org, project, id)
+		if _, ok := s.entities[e.kind][key]; ok {
+			writeError(w, http.StatusBadRequest, "DUPLICATE_FIELD", fmt.Sprintf("A %s with identifier [%s] already exists", e.kind, id))
+			return
+		}
+
+		now := time.Now().UnixMilli()
+		obj["createdAt"] = now
+		obj["lastModifiedAt"] = now
+		s.put(e.kind, key, obj)
+
+		writeNextgen(w, e.wrap(obj))
+	}
+}
+
+func (s *Server) nextgenGet(e nextgenEntity) func(http.ResponseWriter, *http.Request, map[string]string) {
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		org, project := e.scope(r, nil)
+		obj, ok := s.entities[e.kind][scopedKey(org, project, params["identifier"])]
+		if !ok {
+			writeError(w, http.StatusNotFound, "RESOURCE_NOT_FOUND", fmt.Sprintf("%s with identifier [%s] not found", e.kind, params["identifier"]))
+			return
+		}
+
+		writeNextgen(w, e.wrap(obj))
+	}
+}
+
+func (s *Server) nextgenUpdate(e nextgenEntity) func(http.ResponseWriter, *http.Request, map[string]string) {
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		body, err := decodeBody(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+			return
+		}
+
+		obj := e.unwrap(body)
+		id := params["identifier"]
+		if id == "" {
+			id = getString(obj, "identifier")
+		}
+
+		org, project := e.scope(r, obj)
+		key := scopedKey(org, project, id)
+		existing, ok := s.entities[e.kind][key]
+		if !ok {
+			writeError(w, http.StatusNotFound, "RESOURCE_NOT_FOUND", fmt.Sprintf("%s with identifier [%s] not found", e.kind, id))
+			return
+		}
+
+		if e.kind == KindProject && obj["orgIdentifier"] == nil {
+			obj["orgIdentifier"] = org
+		}
+		obj["createdAt"] = existing["createdAt"]
+		obj["lastModifiedAt"] = time.Now().UnixMilli()
+		s.put(e.kind, key, obj)
+
+		writeNextgen(w, e.wrap(obj))
+	}
+}
+
+func (s *Server) nextgenDelete(e nextgenEntity) func(http.ResponseWriter, *http.Request, map[string]string) {
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		org, project := e.scope(r, nil)
+		key := scopedKey(org, project, params["identifier"])
+		if _, ok := s.entities[e.kind][key]; !ok {
+			writeError(w, http.StatusNotFound, "RESOURCE_NOT_FOUND", fmt.Sprintf("%s with identifier [%s] not found", e.kind, params["identifier"]))
+			return
+		}
+
+		delete(s.entities[e.kind], key)
+		writeNextgen(w, true)
+	}
+}
+
+func (s *Server) nextgenList(e nextgenEntity) func(http.ResponseWriter, *http.Request, map[string]string) {
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		org, project := e.scope(r, nil)
+		term := r.URL.Query().Get("searchTerm")
+
+		var items []map[string]interface{}
+		for _, obj := range s.list(e.kind, org, project) {
+			if matchesSearchTerm(obj, term, "identifier", "name") {
+				items = append(items, e.wrap(obj))
+			}
+		}
+
+		writeNextgen(w, pageResponse(items, getInt(r, "pageIndex", 0), getInt(r, "pageSize", 50)))
+	}
+}
+
+// connectorList implements the filtered connector list used by the connector data source.
+func (s *Server) connectorList(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	filter, err := decodeBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+
+	types := map[string]bool{}
+	if v, ok := filter["types"].([]interface{}); ok {
+		for _, t := range v {
+			types[fmt.Sprint(t)] = true
+		}
+	}
+
+	e := nextgenEntity{kind: KindConnector, responseKey: "connector"}
+	org, project := e.scope(r, nil)
+	term := r.URL.Query().Get("searchTerm")
+
+	var items []map[string]interface{}
+	for _, obj := range s.list(KindConnector, org, project) {
+		if len(types) > 0 && !types[getString(obj, "type")] {
+			continue
+		}
+
+		if matchesSearchTerm(obj, term, "identifier", "name") {
+			items = append(items, e.wrap(obj))
+		}
+	}
+
+	writeNextgen(w, pageResponse(items, getInt(r, "pageIndex", 0), getInt(r, "pageSize", 100)))
+}
+
+func pageResponse(items []map[string]interface{}, index int, size int) map[string]interface{} {
+	totalPages := 0
+	if size > 0 {
+		totalPages = (len(items) + size - 1) / size
+	}
+
+	content := page(items, index, size)
+	return map[string]interface{}{
+		"content":       content,
+		"pageIndex":     index,
+		"pageSize":      size,
+		"pageItemCount": len(content),
+		"totalItems":    len(items),
+		"totalPages":    totalPages,
+		"empty":         len(content) == 0,
+	}
+}
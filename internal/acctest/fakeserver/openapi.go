@@ -0,0 +1,302 @@
+package fakeserver
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+func (s *Server) registerOpenApiRoutes() {
+	pipelines := "/v1/orgs/{org}/projects/{project}/pipelines"
+	s.handle(http.MethodGet, pipelines, s.pipelineList)
+	s.handle(http.MethodPost, pipelines, s.pipelineCreate)
+	s.handle(http.MethodGet, pipelines+"/{pipeline}", s.pipelineGet)
+	s.handle(http.MethodPut, pipelines+"/{pipeline}", s.pipelineUpdate)
+	s.handle(http.MethodDelete, pipelines+"/{pipeline}", s.pipelineDelete)
+
+	for _, templates := range []string{"/v1/orgs/{org}/projects/{project}/templates", "/v1/orgs/{org}/templates", "/v1/templates"} {
+		s.handle(http.MethodGet, templates, s.templateList)
+		s.handle(http.MethodPost, templates, s.templateCreate)
+		s.handle(http.MethodGet, templates+"/{template}", s.templateGetStable)
+		s.handle(http.MethodGet, templates+"/{template}/versions/{version}", s.templateGet)
+		s.handle(http.MethodPut, templates+"/{template}/versions/{version}", s.templateUpdate)
+		s.handle(http.MethodPut, templates+"/{template}/versions/{version}/stable", s.templateUpdateStable)
+		s.handle(http.MethodDelete, templates+"/{template}/versions/{version}", s.templateDelete)
+	}
+}
+
+func (s *Server) pipelineCreate(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+
+	id := getString(body, "identifier")
+	key := scopedKey(params["org"], params["project"], id)
+	if _, ok := s.entities[KindPipeline][key]; ok {
+		writeError(w, http.StatusBadRequest, "DUPLICATE_FIELD", fmt.Sprintf("Pipeline [%s] already exists", id))
+		return
+	}
+
+	now := time.Now().UnixMilli()
+	body["org"] = params["org"]
+	body["project"] = params["project"]
+	body["valid"] = true
+	body["created"] = now
+	body["updated"] = now
+	s.put(KindPipeline, key, body)
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"identifier": id})
+}
+
+func (s *Server) pipelineGet(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	obj, ok := s.entities[KindPipeline][scopedKey(params["org"], params["project"], params["pipeline"])]
+	if !ok {
+		writeError(w, http.StatusNotFound, "RESOURCE_NOT_FOUND", fmt.Sprintf("Pipeline [%s] not found", params["pipeline"]))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, obj)
+}
+
+func (s *Server) pipelineUpdate(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+
+	key := scopedKey(params["org"], params["project"], params["pipeline"])
+	existing, ok := s.entities[KindPipeline][key]
+	if !ok {
+		writeError(w, http.StatusNotFound, "RESOURCE_NOT_FOUND", fmt.Sprintf("Pipeline [%s] not found", params["pipeline"]))
+		return
+	}
+
+	body["org"] = params["org"]
+	body["project"] = params["project"]
+	body["valid"] = true
+	body["created"] = existing["created"]
+	body["updated"] = time.Now().UnixMilli()
+	s.put(KindPipeline, key, body)
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"identifier": params["pipeline"]})
+}
+
+func (s *Server) pipelineDelete(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	key := scopedKey(params["org"], params["project"], params["pipeline"])
+	if _, ok := s.entities[KindPipeline][key]; !ok {
+		writeError(w, http.StatusNotFound, "RESOURCE_NOT_FOUND", fmt.Sprintf("Pipeline [%s] not found", params["pipeline"]))
+		return
+	}
+
+	delete(s.entities[KindPipeline], key)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) pipelineList(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	term := r.URL.Query().Get("search_term")
+
+	var items []map[string]interface{}
+	for _, obj := range s.list(KindPipeline, params["org"], params["project"]) {
+		if matchesSearchTerm(obj, term, "identifier", "name") {
+			items = append(items, obj)
+		}
+	}
+
+	writeJSON(w, http.StatusOK, page(items, getInt(r, "page", 0), getInt(r, "limit", 30)))
+}
+
+// templateYaml is the part of a template definition the fake server needs to know about.
+type templateYaml struct {
+	Template struct {
+		Name         string            `yaml:"name"`
+		Identifier   string            `yaml:"identifier"`
+		VersionLabel string            `yaml:"versionLabel"`
+		Type         string            `yaml:"type"`
+		Description  string            `yaml:"description"`
+		Tags         map[string]string `yaml:"tags"`
+		Spec         struct {
+			Type string `yaml:"type"`
+		} `yaml:"spec"`
+	} `yaml:"template"`
+}
+
+// templateKey returns the key of a version of a template. The versions of a template are stored
+// under the key of the template, e.g. `org/project/template/version`.
+func templateKey(params map[string]string, id string, version string) string {
+	return scopedKey(params["org"], params["project"], id+"/"+version)
+}
+
+func templateScope(params map[string]string) string {
+	if params["project"] != "" {
+		return "project"
+	}
+	if params["org"] != "" {
+		return "org"
+	}
+	return "account"
+}
+
+func (s *Server) templateCreate(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+
+	var tpl templateYaml
+	if err := yaml.Unmarshal([]byte(getString(body, "template_yaml")), &tpl); err != nil || tpl.Template.Identifier == "" || tpl.Template.VersionLabel == "" {
+		writeError(w, http.StatusBadRequest, "INVALID_YAML_ERROR", "template_yaml must contain the identifier and versionLabel of the template")
+		return
+	}
+
+	id := tpl.Template.Identifier
+	version := tpl.Template.VersionLabel
+	key := templateKey(params, id, version)
+	if _, ok := s.entities[KindTemplate][key]; ok {
+		writeError(w, http.StatusBadRequest, "DUPLICATE_FIELD", fmt.Sprintf("Template [%s] of versionLabel [%s] already exists", id, version))
+		return
+	}
+
+	// The first version of a template becomes the stable version.
+	stable := body["is_stable"] == true || len(s.templateVersions(params, id)) == 0
+	if stable {
+		s.clearStable(params, id)
+	}
+
+	obj := map[string]interface{}{
+		"account":         s.AccountId,
+		"org":             params["org"],
+		"project":         params["project"],
+		"identifier":      id,
+		"slug":            id,
+		"name":            tpl.Template.Name,
+		"description":     tpl.Template.Description,
+		"tags":            tpl.Template.Tags,
+		"yaml":            getString(body, "template_yaml"),
+		"version_label":   version,
+		"entity_type":     tpl.Template.Type,
+		"child_type":      tpl.Template.Spec.Type,
+		"scope":           templateScope(params),
+		"store_type":      "INLINE",
+		"stable_template": stable,
+		"updated":         time.Now().UnixMilli(),
+	}
+	s.put(KindTemplate, key, obj)
+
+	writeJSON(w, http.StatusOK, obj)
+}
+
+func (s *Server) templateGet(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	obj, ok := s.entities[KindTemplate][templateKey(params, params["template"], params["version"])]
+	if !ok {
+		writeError(w, http.StatusNotFound, "RESOURCE_NOT_FOUND", fmt.Sprintf("Template [%s] of versionLabel [%s] not found", params["template"], params["version"]))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, obj)
+}
+
+func (s *Server) templateGetStable(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	for _, obj := range s.templateVersions(params, params["template"]) {
+		if obj["stable_template"] == true {
+			writeJSON(w, http.StatusOK, obj)
+			return
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "RESOURCE_NOT_FOUND", fmt.Sprintf("Template [%s] not found", params["template"]))
+}
+
+func (s *Server) templateUpdate(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+
+	key := templateKey(params, params["template"], params["version"])
+	obj, ok := s.entities[KindTemplate][key]
+	if !ok {
+		writeError(w, http.StatusNotFound, "RESOURCE_NOT_FOUND", fmt.Sprintf("Template [%s] of versionLabel [%s] not found", params["template"], params["version"]))
+		return
+	}
+
+	var tpl templateYaml
+	if err := yaml.Unmarshal([]byte(getString(body, "template_yaml")), &tpl); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_YAML_ERROR", err.Error())
+		return
+	}
+
+	obj["name"] = tpl.Template.Name
+	obj["description"] = tpl.Template.Description
+	obj["tags"] = tpl.Template.Tags
+	obj["yaml"] = getString(body, "template_yaml")
+	obj["child_type"] = tpl.Template.Spec.Type
+	obj["updated"] = time.Now().UnixMilli()
+
+	writeJSON(w, http.StatusOK, obj)
+}
+
+func (s *Server) templateUpdateStable(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	key := templateKey(params, params["template"], params["version"])
+	obj, ok := s.entities[KindTemplate][key]
+	if !ok {
+		writeError(w, http.StatusNotFound, "RESOURCE_NOT_FOUND", fmt.Sprintf("Template [%s] of versionLabel [%s] not found", params["template"], params["version"]))
+		return
+	}
+
+	s.clearStable(params, params["template"])
+	obj["stable_template"] = true
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"identifier": params["template"], "stable_version": params["version"]})
+}
+
+func (s *Server) templateDelete(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	key := templateKey(params, params["template"], params["version"])
+	if _, ok := s.entities[KindTemplate][key]; !ok {
+		writeError(w, http.StatusNotFound, "RESOURCE_NOT_FOUND", fmt.Sprintf("Template [%s] of versionLabel [%s] not found", params["template"], params["version"]))
+		return
+	}
+
+	delete(s.entities[KindTemplate], key)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) templateList(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	term := r.URL.Query().Get("search_term")
+	all := r.URL.Query().Get("type") == "ALL"
+
+	var items []map[string]interface{}
+	for _, obj := range s.entities[KindTemplate] {
+		if obj["org"] != params["org"] || obj["project"] != params["project"] {
+			continue
+		}
+
+		if (all || obj["stable_template"] == true) && matchesSearchTerm(obj, term, "identifier", "name") {
+			items = append(items, obj)
+		}
+	}
+
+	writeJSON(w, http.StatusOK, page(sortByKeys(items, "identifier", "version_label"), getInt(r, "page", 0), getInt(r, "limit", 30)))
+}
+
+func (s *Server) templateVersions(params map[string]string, id string) []map[string]interface{} {
+	var versions []map[string]interface{}
+	for _, obj := range s.entities[KindTemplate] {
+		if obj["org"] == params["org"] && obj["project"] == params["project"] && obj["identifier"] == id {
+			versions = append(versions, obj)
+		}
+	}
+	return sortByKeys(versions, "version_label")
+}
+
+func (s *Server) clearStable(params map[string]string, id string) {
+	for _, obj := range s.templateVersions(params, id) {
+		obj["stable_template"] = false
+	}
+}
@@ -0,0 +1,261 @@
+// Package fakeserver implements an in-memory fake of the Harness API so that the schema and the
+// expand/flatten logic of resources can be tested without a Harness account.
+//
+// Only the endpoints used by the organization, project, connector, secret, environment, pipeline
+// and template resources are implemented. Validation is limited to what is needed to detect
+// duplicates and missing entities.
+package fakeserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultAccountId is the account the fake server pretends to serve.
+const DefaultAccountId = "fake-account"
+
+// Kinds of the entities stored by the fake server.
+const (
+	KindOrganization = "organization"
+	KindProject      = "project"
+	KindConnector    = "connector"
+	KindSecret       = "secret"
+	KindEnvironment  = "environment"
+	KindPipeline     = "pipeline"
+	KindTemplate     = "template"
+)
+
+// Server is a fake Harness API. Entities are stored as the JSON objects sent by the client.
+type Server struct {
+	*httptest.Server
+
+	AccountId string
+
+	mu       sync.Mutex
+	entities map[string]map[string]map[string]interface{}
+	routes   []route
+}
+
+// New starts a fake Harness API. The server must be closed by the caller.
+func New() *Server {
+	s := &Server{
+		AccountId: DefaultAccountId,
+		entities:  map[string]map[string]map[string]interface{}{},
+	}
+
+	s.registerNextgenRoutes()
+	s.registerOpenApiRoutes()
+
+	// Every Harness account has a default organization.
+	s.Put(KindOrganization, "", "", "default", map[string]interface{}{
+		"identifier": "default",
+		"name":       "default",
+	})
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Get returns the entity with the given identifier at the given scope.
+func (s *Server) Get(kind string, org string, project string, id string) (map[string]interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	obj, ok := s.entities[kind][scopedKey(org, project, id)]
+	return obj, ok
+}
+
+// Put stores an entity, e.g. to seed data referenced by the resource under test.
+func (s *Server) Put(kind string, org string, project string, id string, obj map[string]interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.put(kind, scopedKey(org, project, id), obj)
+}
+
+// Count returns the number of stored entities of the given kind.
+func (s *Server) Count(kind string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.entities[kind])
+}
+
+func (s *Server) put(kind string, key string, obj map[string]interface{}) {
+	if s.entities[kind] == nil {
+		s.entities[kind] = map[string]map[string]interface{}{}
+	}
+	s.entities[kind][key] = obj
+}
+
+// list returns the entities of the given kind at exactly the given scope, sorted by key.
+func (s *Server) list(kind string, org string, project string) []map[string]interface{} {
+	prefix := scopedKey(org, project, "")
+
+	var keys []string
+	for key := range s.entities[kind] {
+		if strings.HasPrefix(key, prefix) && !strings.Contains(key[len(prefix):], "/") {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	objs := make([]map[string]interface{}, 0, len(keys))
+	for _, key := range keys {
+		objs = append(objs, s.entities[kind][key])
+	}
+	return objs
+}
+
+// sortByKeys sorts the entities by the given properties so that lists are returned in a stable
+// order.
+func sortByKeys(objs []map[string]interface{}, keys ...string) []map[string]interface{} {
+	sort.SliceStable(objs, func(i, j int) bool {
+		for _, key := range keys {
+			a, b := getString(objs[i], key), getString(objs[j], key)
+			if a != b {
+				return a < b
+			}
+		}
+		return false
+	})
+	return objs
+}
+
+func scopedKey(org string, project string, id string) string {
+	return fmt.Sprintf("%s/%s/%s", org, project, id)
+}
+
+type route struct {
+	method   string
+	segments []string
+	handler  func(w http.ResponseWriter, r *http.Request, params map[string]string)
+}
+
+func (s *Server) handle(method string, pattern string, handler func(w http.ResponseWriter, r *http.Request, params map[string]string)) {
+	s.routes = append(s.routes, route{
+		method:   method,
+		segments: strings.Split(strings.Trim(pattern, "/"), "/"),
+		handler:  handler,
+	})
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+
+	// Routes are matched in the order they are registered, so literal paths such as
+	// `/connectors/listV2` must be registered before `/connectors/{identifier}`.
+	for _, rt := range s.routes {
+		if rt.method != r.Method {
+			continue
+		}
+
+		if params, ok := match(rt.segments, segments); ok {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			rt.handler(w, r, params)
+			return
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "RESOURCE_NOT_FOUND", fmt.Sprintf("%s %s is not implemented by the fake Harness API", r.Method, r.URL.Path))
+}
+
+// match matches the path against the segments of a pattern. The leading segments of the path
+// are ignored so that the endpoint may have a path prefix, e.g. `/gateway`.
+func match(pattern []string, path []string) (map[string]string, bool) {
+	if len(path) < len(pattern) {
+		return nil, false
+	}
+	path = path[len(path)-len(pattern):]
+
+	params := map[string]string{}
+	for i, segment := range pattern {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			params[segment[1:len(segment)-1]] = path[i]
+		} else if segment != path[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+func decodeBody(r *http.Request) (map[string]interface{}, error) {
+	body := map[string]interface{}{}
+	if r.Body == nil {
+		return body, nil
+	}
+
+	err := json.NewDecoder(r.Body).Decode(&body)
+	if err != nil && err.Error() != "EOF" {
+		return nil, err
+	}
+	return body, nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error in the format shared by the nextgen and openapi endpoints.
+func writeError(w http.ResponseWriter, status int, code string, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"status":        "ERROR",
+		"code":          code,
+		"message":       message,
+		"correlationId": strconv.FormatInt(time.Now().UnixNano(), 36),
+	})
+}
+
+func getString(obj map[string]interface{}, key string) string {
+	if v, ok := obj[key].(string); ok {
+		return v
+	}
+	return ""
+}
+
+func getInt(r *http.Request, key string, fallback int) int {
+	if v, err := strconv.Atoi(r.URL.Query().Get(key)); err == nil {
+		return v
+	}
+	return fallback
+}
+
+// page returns the items of the requested page.
+func page(items []map[string]interface{}, index int, size int) []map[string]interface{} {
+	if size <= 0 {
+		return items
+	}
+
+	start := index * size
+	if start >= len(items) {
+		return []map[string]interface{}{}
+	}
+
+	end := start + size
+	if end > len(items) {
+		end = len(items)
+	}
+	return items[start:end]
+}
+
+// matchesSearchTerm returns true when the identifier or name contains the search term.
+func matchesSearchTerm(obj map[string]interface{}, term string, keys ...string) bool {
+	if term == "" {
+		return true
+	}
+
+	for _, key := range keys {
+		if strings.Contains(strings.ToLower(getString(obj, key)), strings.ToLower(term)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,95 @@
+package fakeserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func doRequest(t *testing.T, s *Server, method string, path string, body interface{}) (int, map[string]interface{}) {
+	var buf bytes.Buffer
+	if body != nil {
+		require.NoError(t, json.NewEncoder(&buf).Encode(body))
+	}
+
+	req, err := http.NewRequest(method, s.URL+path, &buf)
+	require.NoError(t, err)
+
+	resp, err := s.Client().Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	var out map[string]interface{}
+	_ = json.NewDecoder(resp.Body).Decode(&out)
+	return resp.StatusCode, out
+}
+
+func TestNextgenEntityLifecycle(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	connector := map[string]interface{}{
+		"connector": map[string]interface{}{
+			"identifier":        "test",
+			"name":              "test",
+			"orgIdentifier":     "default",
+			"projectIdentifier": "proj",
+			"type":              "K8sCluster",
+		},
+	}
+
+	status, _ := doRequest(t, s, http.MethodPost, "/gateway/ng/api/connectors?accountIdentifier=fake-account", connector)
+	require.Equal(t, http.StatusOK, status)
+
+	status, resp := doRequest(t, s, http.MethodPost, "/gateway/ng/api/connectors", connector)
+	require.Equal(t, http.StatusBadRequest, status)
+	require.Equal(t, "DUPLICATE_FIELD", resp["code"])
+
+	status, resp = doRequest(t, s, http.MethodGet, "/gateway/ng/api/connectors/test?orgIdentifier=default&projectIdentifier=proj", nil)
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, "K8sCluster", resp["data"].(map[string]interface{})["connector"].(map[string]interface{})["type"])
+
+	status, resp = doRequest(t, s, http.MethodPost, "/gateway/ng/api/connectors/listV2?orgIdentifier=default&projectIdentifier=proj", map[string]interface{}{"types": []string{"Git"}})
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, float64(0), resp["data"].(map[string]interface{})["totalItems"])
+
+	status, _ = doRequest(t, s, http.MethodDelete, "/gateway/ng/api/connectors/test?orgIdentifier=default&projectIdentifier=proj", nil)
+	require.Equal(t, http.StatusOK, status)
+
+	status, resp = doRequest(t, s, http.MethodGet, "/gateway/ng/api/connectors/test?orgIdentifier=default&projectIdentifier=proj", nil)
+	require.Equal(t, http.StatusNotFound, status)
+	require.Equal(t, "RESOURCE_NOT_FOUND", resp["code"])
+}
+
+func TestTemplateStableVersion(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	yaml := func(version string) map[string]interface{} {
+		return map[string]interface{}{
+			"template_yaml": "template:\n  name: test\n  identifier: test\n  versionLabel: " + version + "\n  type: Step\n  spec:\n    type: ShellScript\n",
+		}
+	}
+
+	status, resp := doRequest(t, s, http.MethodPost, "/v1/orgs/default/templates", yaml("v1"))
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, true, resp["stable_template"])
+
+	status, resp = doRequest(t, s, http.MethodPost, "/v1/orgs/default/templates", yaml("v2"))
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, false, resp["stable_template"])
+
+	status, _ = doRequest(t, s, http.MethodPut, "/v1/orgs/default/templates/test/versions/v2/stable", nil)
+	require.Equal(t, http.StatusOK, status)
+
+	status, resp = doRequest(t, s, http.MethodGet, "/v1/orgs/default/templates/test", nil)
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, "v2", resp["version_label"])
+	require.Equal(t, "org", resp["scope"])
+
+	status, _ = doRequest(t, s, http.MethodGet, "/v1/templates/test", nil)
+	require.Equal(t, http.StatusNotFound, status)
+}
@@ -0,0 +1,368 @@
+package generator
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/antihax/optional"
+	"github.com/harness/harness-go-sdk/harness/nextgen"
+	"github.com/harness/terraform-provider-harness/helpers"
+	"github.com/harness/terraform-provider-harness/internal"
+)
+
+// connectorResourceTypes maps the connector types to the resource managing them.
+var connectorResourceTypes = map[string]string{
+	nextgen.ConnectorTypes.AppDynamics.String():      "harness_platform_connector_appdynamics",
+	nextgen.ConnectorTypes.Artifactory.String():      "harness_platform_connector_artifactory",
+	nextgen.ConnectorTypes.Aws.String():              "harness_platform_connector_aws",
+	nextgen.ConnectorTypes.AwsKms.String():           "harness_platform_connector_awskms",
+	nextgen.ConnectorTypes.AwsSecretManager.String(): "harness_platform_connector_aws_secret_manager",
+	nextgen.ConnectorTypes.Azure.String():            "harness_platform_connector_azure_cloud_provider",
+	nextgen.ConnectorTypes.AzureKeyVault.String():    "harness_platform_connector_azure_key_vault",
+	nextgen.ConnectorTypes.Bitbucket.String():        "harness_platform_connector_bitbucket",
+	nextgen.ConnectorTypes.CEAws.String():            "harness_platform_connector_awscc",
+	nextgen.ConnectorTypes.CEAzure.String():          "harness_platform_connector_azure_cloud_cost",
+	nextgen.ConnectorTypes.CEK8sCluster.String():     "harness_platform_connector_kubernetes_cloud_cost",
+	nextgen.ConnectorTypes.CustomHealth.String():     "harness_platform_connector_customhealthsource",
+	nextgen.ConnectorTypes.Datadog.String():          "harness_platform_connector_datadog",
+	nextgen.ConnectorTypes.DockerRegistry.String():   "harness_platform_connector_docker",
+	nextgen.ConnectorTypes.Dynatrace.String():        "harness_platform_connector_dynatrace",
+	nextgen.ConnectorTypes.ElasticSearch.String():    "harness_platform_connector_elasticsearch",
+	nextgen.ConnectorTypes.Gcp.String():              "harness_platform_connector_gcp",
+	nextgen.ConnectorTypes.GcpCloudCost.String():     "harness_platform_connector_gcp_cloud_cost",
+	nextgen.ConnectorTypes.GcpSecretManager.String(): "harness_platform_connector_gcp_secret_manager",
+	nextgen.ConnectorTypes.Git.String():              "harness_platform_connector_git",
+	nextgen.ConnectorTypes.Github.String():           "harness_platform_connector_github",
+	nextgen.ConnectorTypes.Gitlab.String():           "harness_platform_connector_gitlab",
+	nextgen.ConnectorTypes.HttpHelmRepo.String():     "harness_platform_connector_helm",
+	nextgen.ConnectorTypes.Jenkins.String():          "harness_platform_connector_jenkins",
+	nextgen.ConnectorTypes.Jira.String():             "harness_platform_connector_jira",
+	nextgen.ConnectorTypes.K8sCluster.String():       "harness_platform_connector_kubernetes",
+	nextgen.ConnectorTypes.NewRelic.String():         "harness_platform_connector_newrelic",
+	nextgen.ConnectorTypes.Nexus.String():            "harness_platform_connector_nexus",
+	nextgen.ConnectorTypes.OciHelmRepo.String():      "harness_platform_connector_oci_helm",
+	nextgen.ConnectorTypes.PagerDuty.String():        "harness_platform_connector_pagerduty",
+	nextgen.ConnectorTypes.Pdc.String():              "harness_platform_connector_pdc",
+	nextgen.ConnectorTypes.Prometheus.String():       "harness_platform_connector_prometheus",
+	nextgen.ConnectorTypes.Rancher.String():          "harness_platform_connector_rancher",
+	nextgen.ConnectorTypes.ServiceNow.String():       "harness_platform_connector_service_now",
+	nextgen.ConnectorTypes.Splunk.String():           "harness_platform_connector_splunk",
+	nextgen.ConnectorTypes.Spot.String():             "harness_platform_connector_spot",
+	nextgen.ConnectorTypes.SumoLogic.String():        "harness_platform_connector_sumologic",
+	nextgen.ConnectorTypes.Tas.String():              "harness_platform_connector_tas",
+	nextgen.ConnectorTypes.TerraformCloud.String():   "harness_platform_connector_terraform_cloud",
+	nextgen.ConnectorTypes.Vault.String():            "harness_platform_connector_vault",
+}
+
+// secretResourceTypes maps the secret types to the resource managing them.
+var secretResourceTypes = map[string]string{
+	nextgen.SecretTypes.SecretText.String(): "harness_platform_secret_text",
+	nextgen.SecretTypes.SecretFile.String(): "harness_platform_secret_file",
+	nextgen.SecretTypes.SSHKey.String():     "harness_platform_secret_sshkey",
+}
+
+// scope is the account, an organization or a project.
+type scope struct {
+	orgId     string
+	projectId string
+}
+
+func (s scope) isProject() bool {
+	return s.projectId != ""
+}
+
+// args returns the scope arguments of the list data sources.
+func (s scope) args() map[string]interface{} {
+	args := map[string]interface{}{}
+	if s.orgId != "" {
+		args["org_id"] = s.orgId
+	}
+	if s.projectId != "" {
+		args["project_id"] = s.projectId
+	}
+	return args
+}
+
+func (s scope) String() string {
+	switch {
+	case s.isProject():
+		return fmt.Sprintf("project %s/%s", s.orgId, s.projectId)
+	case s.orgId != "":
+		return fmt.Sprintf("organization %s", s.orgId)
+	default:
+		return "account"
+	}
+}
+
+// Discover walks the account, organization or project given in the options and returns every
+// entity found, account level entities first.
+func (g *Generator) Discover(ctx context.Context) ([]*Entity, error) {
+	scopes, entities, err := g.scopes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	listers := []struct {
+		kind string
+		list func(context.Context, scope) ([]*Entity, error)
+	}{
+		{KindConnector, g.listConnectors},
+		{KindSecret, g.listSecrets},
+		{KindService, g.listServices},
+		{KindEnvironment, g.listEnvironments},
+		{KindInfrastructure, g.listInfrastructures},
+		{KindPipeline, g.listPipelines},
+		{KindTemplate, g.listTemplates},
+		{KindUserGroup, g.listUserGroups},
+		{KindRoleAssignment, g.listRoleAssignments},
+	}
+
+	for _, s := range scopes {
+		for _, l := range listers {
+			if !g.wants(l.kind) {
+				continue
+			}
+
+			log.Printf("[INFO] Listing %s entities of the %s", l.kind, s)
+			found, err := l.list(ctx, s)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list %s entities of the %s: %w", l.kind, s, err)
+			}
+			entities = append(entities, found...)
+		}
+	}
+
+	return entities, nil
+}
+
+// scopes returns the scopes to walk, along with the organizations and projects found on the way.
+func (g *Generator) scopes(ctx context.Context) ([]scope, []*Entity, error) {
+	if g.opts.ProjectId != "" {
+		return []scope{{orgId: g.opts.OrgId, projectId: g.opts.ProjectId}}, nil, nil
+	}
+
+	scopes := []scope{}
+	entities := []*Entity{}
+
+	orgIds := []string{g.opts.OrgId}
+	if g.opts.OrgId == "" {
+		scopes = append(scopes, scope{})
+
+		orgs, err := g.listDataSource(ctx, "harness_platform_organization_list", nil, "organizations")
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to list organizations: %w", err)
+		}
+
+		orgIds = []string{}
+		for _, org := range orgs {
+			id := org["identifier"].(string)
+			orgIds = append(orgIds, id)
+			if g.wants(KindOrganization) {
+				entities = append(entities, g.newEntity("harness_platform_organization", id))
+			}
+		}
+	}
+
+	for _, orgId := range orgIds {
+		scopes = append(scopes, scope{orgId: orgId})
+
+		projects, err := g.listDataSource(ctx, "harness_platform_project_list", map[string]interface{}{"org_id": orgId}, "projects")
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to list the projects of organization %s: %w", orgId, err)
+		}
+
+		for _, project := range projects {
+			id := project["identifier"].(string)
+			scopes = append(scopes, scope{orgId: orgId, projectId: id})
+			if g.wants(KindProject) {
+				entities = append(entities, g.newEntity("harness_platform_project", orgId, id))
+			}
+		}
+	}
+
+	return scopes, entities, nil
+}
+
+// listDataSource reads a list data source of the provider and returns the elements of its list attribute.
+func (g *Generator) listDataSource(ctx context.Context, name string, args map[string]interface{}, attr string) ([]map[string]interface{}, error) {
+	r, ok := g.provider.DataSourcesMap[name]
+	if !ok {
+		return nil, fmt.Errorf("data source %s not found", name)
+	}
+
+	d := r.Data(nil)
+	for k, v := range args {
+		if err := d.Set(k, v); err != nil {
+			return nil, err
+		}
+	}
+
+	if diags := read(ctx, r, d, g.meta); diags.HasError() {
+		return nil, diagsError(diags)
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range d.Get(attr).([]interface{}) {
+		items = append(items, item.(map[string]interface{}))
+	}
+
+	return items, nil
+}
+
+// listScopedEntities lists the entities of a scope with a list data source and maps each of them to a resource.
+// Entities for which resourceType returns an empty string are skipped.
+func (g *Generator) listScopedEntities(ctx context.Context, s scope, dataSource string, args map[string]interface{}, attr string, resourceType func(map[string]interface{}) string) ([]*Entity, error) {
+	for k, v := range s.args() {
+		args[k] = v
+	}
+
+	items, err := g.listDataSource(ctx, dataSource, args, attr)
+	if err != nil {
+		return nil, err
+	}
+
+	entities := []*Entity{}
+	for _, item := range items {
+		t := resourceType(item)
+		if t == "" {
+			continue
+		}
+		entities = append(entities, g.newEntity(t, s.orgId, s.projectId, item["identifier"].(string)))
+	}
+
+	return entities, nil
+}
+
+func (g *Generator) listConnectors(ctx context.Context, s scope) ([]*Entity, error) {
+	return g.listScopedEntities(ctx, s, "harness_platform_connector_list", map[string]interface{}{}, "connectors", func(item map[string]interface{}) string {
+		t, ok := connectorResourceTypes[item["type"].(string)]
+		if !ok {
+			log.Printf("[WARN] Skipping connector %s, connectors of type %s are not supported", item["identifier"], item["type"])
+		}
+		return t
+	})
+}
+
+func (g *Generator) listSecrets(ctx context.Context, s scope) ([]*Entity, error) {
+	return g.listScopedEntities(ctx, s, "harness_platform_secret_list", map[string]interface{}{}, "secrets", func(item map[string]interface{}) string {
+		t, ok := secretResourceTypes[item["type"].(string)]
+		if !ok {
+			log.Printf("[WARN] Skipping secret %s, secrets of type %s are not supported", item["identifier"], item["type"])
+		}
+		return t
+	})
+}
+
+func (g *Generator) listServices(ctx context.Context, s scope) ([]*Entity, error) {
+	return g.listScopedEntities(ctx, s, "harness_platform_service_list", map[string]interface{}{}, "services", resourceType("harness_platform_service"))
+}
+
+func (g *Generator) listEnvironments(ctx context.Context, s scope) ([]*Entity, error) {
+	return g.listScopedEntities(ctx, s, "harness_platform_environment_list", map[string]interface{}{}, "environments", resourceType("harness_platform_environment"))
+}
+
+// listInfrastructures lists the infrastructures of every environment of the scope. The import id of an
+// infrastructure includes the environment it belongs to.
+func (g *Generator) listInfrastructures(ctx context.Context, s scope) ([]*Entity, error) {
+	envs, err := g.listDataSource(ctx, "harness_platform_environment_list", s.args(), "environments")
+	if err != nil {
+		return nil, err
+	}
+
+	entities := []*Entity{}
+	for _, env := range envs {
+		envId := env["identifier"].(string)
+
+		args := s.args()
+		args["env_id"] = envId
+
+		infras, err := g.listDataSource(ctx, "harness_platform_infrastructure_list", args, "infrastructures")
+		if err != nil {
+			return nil, err
+		}
+
+		for _, infra := range infras {
+			entities = append(entities, g.newEntity("harness_platform_infrastructure", s.orgId, s.projectId, envId, infra["identifier"].(string)))
+		}
+	}
+
+	return entities, nil
+}
+
+// listPipelines lists the pipelines of a project, pipelines only exist at the project level.
+func (g *Generator) listPipelines(ctx context.Context, s scope) ([]*Entity, error) {
+	if !s.isProject() {
+		return nil, nil
+	}
+
+	return g.listScopedEntities(ctx, s, "harness_platform_pipeline_list", map[string]interface{}{}, "pipelines", resourceType("harness_platform_pipeline"))
+}
+
+func (g *Generator) listTemplates(ctx context.Context, s scope) ([]*Entity, error) {
+	return g.listScopedEntities(ctx, s, "harness_platform_template_list", map[string]interface{}{}, "templates", resourceType("harness_platform_template"))
+}
+
+// listUserGroups lists the user groups of the scope, except for the groups managed by Harness
+// such as `_account_all_users`.
+func (g *Generator) listUserGroups(ctx context.Context, s scope) ([]*Entity, error) {
+	return g.listScopedEntities(ctx, s, "harness_platform_usergroup_list", map[string]interface{}{}, "user_groups", func(item map[string]interface{}) string {
+		if strings.HasPrefix(item["identifier"].(string), "_") {
+			return ""
+		}
+		return "harness_platform_usergroup"
+	})
+}
+
+// listRoleAssignments lists the role assignments of the scope, except for the assignments managed by Harness.
+// There is no list data source for role assignments so the API is called directly.
+func (g *Generator) listRoleAssignments(ctx context.Context, s scope) ([]*Entity, error) {
+	c, ctx := g.meta.(*internal.Session).GetPlatformClientWithContext(ctx)
+
+	opts := &nextgen.RoleAssignmentsApiGetRoleAssignmentListOpts{
+		PageSize: optional.NewInt32(helpers.ListPageSize),
+	}
+	if s.orgId != "" {
+		opts.OrgIdentifier = optional.NewString(s.orgId)
+	}
+	if s.projectId != "" {
+		opts.ProjectIdentifier = optional.NewString(s.projectId)
+	}
+
+	entities := []*Entity{}
+	_, err := helpers.ListAllPages(func(page int32) (bool, *http.Response, error) {
+		opts.PageIndex = optional.NewInt32(page)
+
+		resp, httpResp, err := c.RoleAssignmentsApi.GetRoleAssignmentList(ctx, c.AccountId, opts)
+		if err != nil {
+			return false, httpResp, err
+		}
+
+		if resp.Data == nil {
+			return false, httpResp, nil
+		}
+
+		for _, v := range resp.Data.Content {
+			ra := v.RoleAssignment
+			if ra == nil || ra.Managed {
+				continue
+			}
+			entities = append(entities, g.newEntity("harness_platform_role_assignments", s.orgId, s.projectId, ra.Identifier))
+		}
+
+		return helpers.HasMorePages(page, resp.Data.TotalPages), httpResp, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return entities, nil
+}
+
+func resourceType(t string) func(map[string]interface{}) string {
+	return func(map[string]interface{}) string {
+		return t
+	}
+}
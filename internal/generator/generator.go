@@ -0,0 +1,176 @@
+// Package generator walks a Harness account through the nextgen APIs and generates Terraform
+// `import` blocks and resource configuration for the entities it finds.
+//
+// Discovery is done through the provider's own list data sources and every entity is read back
+// through the importer and read function of its resource, so the generated configuration matches
+// what the provider itself would store in the state after `terraform import`.
+package generator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Kinds of entities the generator knows how to discover, in the order they are generated.
+const (
+	KindOrganization   = "organization"
+	KindProject        = "project"
+	KindConnector      = "connector"
+	KindSecret         = "secret"
+	KindService        = "service"
+	KindEnvironment    = "environment"
+	KindInfrastructure = "infrastructure"
+	KindPipeline       = "pipeline"
+	KindTemplate       = "template"
+	KindUserGroup      = "user_group"
+	KindRoleAssignment = "role_assignment"
+)
+
+// AllKinds lists every supported kind.
+var AllKinds = []string{
+	KindOrganization,
+	KindProject,
+	KindConnector,
+	KindSecret,
+	KindService,
+	KindEnvironment,
+	KindInfrastructure,
+	KindPipeline,
+	KindTemplate,
+	KindUserGroup,
+	KindRoleAssignment,
+}
+
+// Options controls which part of the account is walked.
+type Options struct {
+	// OrgId limits the generation to a single organization and its projects.
+	OrgId string
+	// ProjectId limits the generation to a single project, OrgId must be set as well.
+	ProjectId string
+	// Kinds limits the generation to the given kinds, all kinds are generated when empty.
+	Kinds []string
+}
+
+// Entity is a Harness entity to be imported into Terraform.
+type Entity struct {
+	// ResourceType is the Terraform resource type, e.g. harness_platform_service.
+	ResourceType string
+	// Name is the Terraform resource name, unique per resource type.
+	Name string
+	// ImportId is the id accepted by the importer of the resource.
+	ImportId string
+}
+
+// Address returns the Terraform address of the entity, e.g. harness_platform_service.my_service.
+func (e *Entity) Address() string {
+	return fmt.Sprintf("%s.%s", e.ResourceType, e.Name)
+}
+
+// Generator discovers Harness entities and generates the Terraform configuration for them.
+type Generator struct {
+	provider *schema.Provider
+	meta     interface{}
+	opts     Options
+	names    map[string]int
+}
+
+// New creates a generator for an already configured provider.
+func New(p *schema.Provider, opts Options) (*Generator, error) {
+	if opts.ProjectId != "" && opts.OrgId == "" {
+		return nil, errors.New("an organization is required when a project is given")
+	}
+
+	for _, k := range opts.Kinds {
+		if !contains(AllKinds, k) {
+			return nil, fmt.Errorf("unsupported kind %q, valid kinds are %s", k, strings.Join(AllKinds, ", "))
+		}
+	}
+
+	return &Generator{
+		provider: p,
+		meta:     p.Meta(),
+		opts:     opts,
+		names:    map[string]int{},
+	}, nil
+}
+
+func (g *Generator) wants(kind string) bool {
+	return len(g.opts.Kinds) == 0 || contains(g.opts.Kinds, kind)
+}
+
+// newEntity builds an entity with a resource name derived from its scope and identifier. The import
+// id is built from the same parts, which is the format used by the multi level importers.
+func (g *Generator) newEntity(resourceType string, parts ...string) *Entity {
+	ids := []string{}
+	for _, p := range parts {
+		if p != "" {
+			ids = append(ids, p)
+		}
+	}
+
+	name := resourceName(strings.Join(ids, "_"))
+	key := resourceType + "." + name
+	g.names[key]++
+	if n := g.names[key]; n > 1 {
+		name = fmt.Sprintf("%s_%d", name, n)
+	}
+
+	return &Entity{
+		ResourceType: resourceType,
+		Name:         name,
+		ImportId:     strings.Join(ids, "/"),
+	}
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-zA-Z0-9_-]`)
+
+// resourceName converts a Harness identifier into a valid Terraform resource name.
+func resourceName(id string) string {
+	name := invalidNameChars.ReplaceAllString(id, "_")
+	if name == "" || !(name[0] == '_' || (name[0] >= 'a' && name[0] <= 'z') || (name[0] >= 'A' && name[0] <= 'Z')) {
+		name = "_" + name
+	}
+	return name
+}
+
+func contains(values []string, v string) bool {
+	for _, s := range values {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+func diagsError(diags diag.Diagnostics) error {
+	msgs := []string{}
+	for _, d := range diags {
+		if d.Severity != diag.Error {
+			continue
+		}
+		msg := d.Summary
+		if d.Detail != "" {
+			msg += ": " + d.Detail
+		}
+		msgs = append(msgs, msg)
+	}
+	return errors.New(strings.Join(msgs, "; "))
+}
+
+// read runs the read function of a resource or data source.
+func read(ctx context.Context, r *schema.Resource, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	switch {
+	case r.ReadContext != nil:
+		return r.ReadContext(ctx, d, meta)
+	case r.ReadWithoutTimeout != nil:
+		return r.ReadWithoutTimeout(ctx, d, meta)
+	default:
+		return diag.FromErr(r.Read(d, meta))
+	}
+}
@@ -0,0 +1,243 @@
+package generator
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// ImportBlock returns the `import` block of an entity.
+func ImportBlock(e *Entity) *hclwrite.Block {
+	block := hclwrite.NewBlock("import", nil)
+	block.Body().SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: e.ResourceType},
+		hcl.TraverseAttr{Name: e.Name},
+	})
+	block.Body().SetAttributeValue("id", cty.StringVal(e.ImportId))
+	return block
+}
+
+// Config imports and reads an entity the same way `terraform import` does and returns its `resource` block.
+// A nil block is returned when the entity no longer exists.
+func (g *Generator) Config(ctx context.Context, e *Entity) (*hclwrite.Block, error) {
+	r, ok := g.provider.ResourcesMap[e.ResourceType]
+	if !ok {
+		return nil, fmt.Errorf("resource %s not found", e.ResourceType)
+	}
+
+	d := r.Data(nil)
+	d.SetId(e.ImportId)
+
+	states := []*schema.ResourceData{d}
+	if r.Importer != nil {
+		var err error
+		if r.Importer.StateContext != nil {
+			states, err = r.Importer.StateContext(ctx, d, g.meta)
+		} else {
+			states, err = r.Importer.State(d, g.meta)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to import %s: %w", e.Address(), err)
+		}
+	}
+
+	d = states[0]
+	if diags := read(ctx, r, d, g.meta); diags.HasError() {
+		return nil, fmt.Errorf("failed to read %s: %w", e.Address(), diagsError(diags))
+	}
+
+	if d.Id() == "" {
+		return nil, nil
+	}
+
+	values := map[string]interface{}{}
+	for k := range r.Schema {
+		values[k] = d.Get(k)
+	}
+
+	block := hclwrite.NewBlock("resource", []string{e.ResourceType, e.Name})
+	writeBody(block.Body(), r.Schema, values)
+	return block, nil
+}
+
+// writeBody writes the configurable attributes and blocks of a schema. Computed only attributes,
+// deprecated attributes and optional attributes left to their default value are omitted.
+func writeBody(body *hclwrite.Body, s map[string]*schema.Schema, values map[string]interface{}) {
+	keys := make([]string, 0, len(s))
+	for k := range s {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	written := map[string]bool{}
+	for _, k := range keys {
+		attr := s[k]
+		v := values[k]
+
+		if !attr.Required && !attr.Optional {
+			continue
+		}
+
+		if attr.Deprecated != "" || conflicts(attr, written) {
+			continue
+		}
+
+		if !isRequired(k, attr, values) && isDefault(attr, v) {
+			continue
+		}
+
+		if attr.Sensitive && isZero(v) {
+			body.AppendUnstructuredTokens(hclwrite.Tokens{
+				{Type: hclsyntax.TokenComment, Bytes: []byte(fmt.Sprintf("# %s is not returned by the Harness API and must be set before applying.\n", k))},
+			})
+		}
+
+		if elem, ok := attr.Elem.(*schema.Resource); ok && (attr.Type == schema.TypeList || attr.Type == schema.TypeSet) {
+			for _, item := range listValue(v) {
+				m, ok := item.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				writeBody(body.AppendNewBlock(k, nil).Body(), elem.Schema, m)
+			}
+			written[k] = true
+			continue
+		}
+
+		if str, ok := v.(string); ok && strings.Contains(str, "\n") {
+			body.SetAttributeRaw(k, heredoc(str))
+		} else {
+			body.SetAttributeValue(k, toValue(attr, v))
+		}
+		written[k] = true
+	}
+}
+
+// isRequired reports whether an attribute must be written. This is the case of the required attributes
+// and of the first attribute of an `ExactlyOneOf` group of which no attribute is set.
+func isRequired(k string, attr *schema.Schema, values map[string]interface{}) bool {
+	if attr.Required {
+		return true
+	}
+
+	if len(attr.ExactlyOneOf) == 0 || attr.ExactlyOneOf[0] != k {
+		return false
+	}
+
+	for _, o := range attr.ExactlyOneOf {
+		if !isZero(values[o]) {
+			return false
+		}
+	}
+	return true
+}
+
+// conflicts reports whether an attribute conflicts with an attribute that was already written.
+func conflicts(attr *schema.Schema, written map[string]bool) bool {
+	for _, c := range append(attr.ConflictsWith, attr.ExactlyOneOf...) {
+		if written[c] {
+			return true
+		}
+	}
+	return false
+}
+
+func isDefault(attr *schema.Schema, v interface{}) bool {
+	if attr.Default != nil {
+		return reflect.DeepEqual(attr.Default, v)
+	}
+	return isZero(v)
+}
+
+func isZero(v interface{}) bool {
+	switch t := v.(type) {
+	case nil:
+		return true
+	case string:
+		return t == ""
+	case int:
+		return t == 0
+	case float64:
+		return t == 0
+	case bool:
+		return !t
+	case map[string]interface{}:
+		return len(t) == 0
+	default:
+		return len(listValue(v)) == 0
+	}
+}
+
+func listValue(v interface{}) []interface{} {
+	switch t := v.(type) {
+	case *schema.Set:
+		return t.List()
+	case []interface{}:
+		return t
+	default:
+		return nil
+	}
+}
+
+// toValue converts a value read from the resource data into a cty value.
+func toValue(attr *schema.Schema, v interface{}) cty.Value {
+	switch attr.Type {
+	case schema.TypeString:
+		return cty.StringVal(v.(string))
+	case schema.TypeInt:
+		return cty.NumberIntVal(int64(v.(int)))
+	case schema.TypeFloat:
+		return cty.NumberFloatVal(v.(float64))
+	case schema.TypeBool:
+		return cty.BoolVal(v.(bool))
+	case schema.TypeMap:
+		if isZero(v) {
+			return cty.MapValEmpty(cty.String)
+		}
+		m := map[string]cty.Value{}
+		for k, e := range v.(map[string]interface{}) {
+			m[k] = cty.StringVal(fmt.Sprint(e))
+		}
+		return cty.MapVal(m)
+	default:
+		elem, _ := attr.Elem.(*schema.Schema)
+		if elem == nil {
+			elem = &schema.Schema{Type: schema.TypeString}
+		}
+
+		items := listValue(v)
+		values := make([]cty.Value, 0, len(items))
+		for _, item := range items {
+			values = append(values, toValue(elem, item))
+		}
+		if attr.Type == schema.TypeSet {
+			sort.Slice(values, func(i, j int) bool {
+				return values[i].GoString() < values[j].GoString()
+			})
+		}
+		return cty.TupleVal(values)
+	}
+}
+
+// heredoc renders a multi line string, e.g. a YAML definition, as a heredoc.
+func heredoc(s string) hclwrite.Tokens {
+	s = strings.ReplaceAll(s, "${", "$${")
+	s = strings.ReplaceAll(s, "%{", "%%{")
+	if !strings.HasSuffix(s, "\n") {
+		s += "\n"
+	}
+
+	return hclwrite.Tokens{
+		{Type: hclsyntax.TokenOHeredoc, Bytes: []byte("<<-EOT\n")},
+		{Type: hclsyntax.TokenStringLit, Bytes: []byte(s)},
+		{Type: hclsyntax.TokenCHeredoc, Bytes: []byte("EOT")},
+	}
+}
@@ -0,0 +1,175 @@
+package generator
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportBlock(t *testing.T) {
+	g := &Generator{names: map[string]int{}}
+
+	e := g.newEntity("harness_platform_service", "default", "my-project", "svc")
+	require.Equal(t, "harness_platform_service.default_my-project_svc", e.Address())
+	require.Equal(t, "default/my-project/svc", e.ImportId)
+
+	f := hclwrite.NewEmptyFile()
+	f.Body().AppendBlock(ImportBlock(e))
+	require.Equal(t, `import {
+  to = harness_platform_service.default_my-project_svc
+  id = "default/my-project/svc"
+}
+`, string(f.Bytes()))
+}
+
+func TestEntityNames(t *testing.T) {
+	g := &Generator{names: map[string]int{}}
+
+	require.Equal(t, "svc", g.newEntity("harness_platform_service", "", "", "svc").Name)
+	require.Equal(t, "default_svc", g.newEntity("harness_platform_service", "default", "", "svc").Name)
+	require.Equal(t, "_1svc", g.newEntity("harness_platform_service", "", "", "1svc").Name)
+	require.Equal(t, "svc_", g.newEntity("harness_platform_service", "", "", "svc$").Name)
+	require.Equal(t, "svc_2", g.newEntity("harness_platform_service", "", "", "svc").Name)
+	require.Equal(t, "svc", g.newEntity("harness_platform_environment", "", "", "svc").Name)
+}
+
+func TestWriteBody(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"identifier": {Type: schema.TypeString, Required: true},
+		"name":       {Type: schema.TypeString, Required: true},
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"force_delete": {Type: schema.TypeBool, Optional: true, Default: true},
+		"created_at":   {Type: schema.TypeInt, Computed: true},
+		"old_field":    {Type: schema.TypeString, Optional: true, Deprecated: "use name"},
+		"value":        {Type: schema.TypeString, Required: true, Sensitive: true},
+		"tags":         {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		"yaml":         {Type: schema.TypeString, Optional: true, ConflictsWith: []string{"name"}},
+		"spec": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"url":  {Type: schema.TypeString, Required: true},
+					"port": {Type: schema.TypeInt, Optional: true},
+				},
+			},
+		},
+		"template": {Type: schema.TypeString, Optional: true},
+	}
+
+	values := map[string]interface{}{
+		"identifier":   "id",
+		"name":         "name",
+		"description":  "",
+		"force_delete": false,
+		"created_at":   123,
+		"old_field":    "old",
+		"value":        "",
+		"tags":         schema.NewSet(schema.HashString, []interface{}{"foo:bar", "baz"}),
+		"yaml":         "conflicting",
+		"spec":         []interface{}{map[string]interface{}{"url": "https://example.com", "port": 0}},
+		"template":     "pipeline:\n  name: ${name}\n",
+	}
+
+	f := hclwrite.NewEmptyFile()
+	writeBody(f.Body().AppendNewBlock("resource", []string{"harness_platform_test", "test"}).Body(), s, values)
+
+	require.Equal(t, `resource "harness_platform_test" "test" {
+  force_delete = false
+  identifier   = "id"
+  name         = "name"
+  spec {
+    url = "https://example.com"
+  }
+  tags     = ["baz", "foo:bar"]
+  template = <<-EOT
+pipeline:
+  name: $${name}
+EOT
+  # value is not returned by the Harness API and must be set before applying.
+  value = ""
+}
+`, string(hclwrite.Format(f.Bytes())))
+}
+
+func TestWriteBodyExactlyOneOf(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"value":    {Type: schema.TypeString, Optional: true, Sensitive: true, ExactlyOneOf: []string{"value", "value_wo"}},
+		"value_wo": {Type: schema.TypeString, Optional: true, Sensitive: true, ExactlyOneOf: []string{"value", "value_wo"}},
+	}
+
+	f := hclwrite.NewEmptyFile()
+	writeBody(f.Body(), s, map[string]interface{}{"value": "", "value_wo": ""})
+	require.Equal(t, `# value is not returned by the Harness API and must be set before applying.
+value = ""
+`, string(f.Bytes()))
+
+	f = hclwrite.NewEmptyFile()
+	writeBody(f.Body(), s, map[string]interface{}{"value": "account.secret", "value_wo": ""})
+	require.Equal(t, `value = "account.secret"
+`, string(f.Bytes()))
+}
+
+func TestConfig(t *testing.T) {
+	p := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"harness_platform_test": {
+				Schema: map[string]*schema.Schema{
+					"identifier": {Type: schema.TypeString, Required: true},
+					"org_id":     {Type: schema.TypeString, Optional: true},
+					"name":       {Type: schema.TypeString, Required: true},
+				},
+				Importer: &schema.ResourceImporter{
+					State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+						parts := strings.Split(d.Id(), "/")
+						d.Set("org_id", parts[0])
+						d.Set("identifier", parts[1])
+						d.SetId(parts[1])
+						return []*schema.ResourceData{d}, nil
+					},
+				},
+				ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+					if d.Id() == "gone" {
+						d.SetId("")
+						return nil
+					}
+					d.Set("name", strings.ToUpper(d.Id()))
+					return nil
+				},
+			},
+		},
+	}
+
+	g, err := New(p, Options{})
+	require.NoError(t, err)
+
+	block, err := g.Config(context.Background(), g.newEntity("harness_platform_test", "default", "", "test"))
+	require.NoError(t, err)
+
+	f := hclwrite.NewEmptyFile()
+	f.Body().AppendBlock(block)
+	require.Equal(t, `resource "harness_platform_test" "default_test" {
+  identifier = "test"
+  name       = "TEST"
+  org_id     = "default"
+}
+`, string(f.Bytes()))
+
+	block, err = g.Config(context.Background(), g.newEntity("harness_platform_test", "default", "", "gone"))
+	require.NoError(t, err)
+	require.Nil(t, block)
+
+	_, err = New(p, Options{ProjectId: "project"})
+	require.Error(t, err)
+
+	_, err = New(p, Options{Kinds: []string{"pipelines"}})
+	require.EqualError(t, err, "unsupported kind \"pipelines\", valid kinds are "+strings.Join(AllKinds, ", "))
+}
@@ -0,0 +1,296 @@
+package provider
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	authTokenTypeBearer = "Bearer"
+	authTokenTypeApiKey = "ApiKey"
+
+	apiKeyHeader = "x-api-key"
+)
+
+var authTokenTypes = []string{authTokenTypeBearer, authTokenTypeApiKey}
+
+var authSources = []string{"auth.0.bearer_token", "auth.0.token_file", "auth.0.credential_command"}
+
+func getAuthSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Alternative to `platform_api_key` for authenticating against the Harness next gen platform with short-lived credentials. Exactly one of `bearer_token`, `token_file` or `credential_command` must be set.",
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"bearer_token": {
+					Description:  "A static token, for example a JWT minted by your CI system.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					ExactlyOneOf: authSources,
+				},
+				"token_file": {
+					Description:  "Path to a file containing the token. The file is re-read whenever it changes so the token can be rotated while the provider is running.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					ExactlyOneOf: authSources,
+				},
+				"credential_command": {
+					Description:  "Command, and its arguments, of a credential helper that prints the token on stdout. The command is re-run once `refresh_interval` has elapsed or when the token is rejected.",
+					Type:         schema.TypeList,
+					Optional:     true,
+					MinItems:     1,
+					Elem:         &schema.Schema{Type: schema.TypeString},
+					ExactlyOneOf: authSources,
+				},
+				"token_type": {
+					Description:  fmt.Sprintf("How the token is sent to Harness. `%s` sends it in the `Authorization` header and `%s` sends it as an API key. Valid values are %s.", authTokenTypeBearer, authTokenTypeApiKey, strings.Join(authTokenTypes, ", ")),
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      authTokenTypeBearer,
+					ValidateFunc: validation.StringInSlice(authTokenTypes, false),
+				},
+				"refresh_interval": {
+					Description:  "How long the output of `credential_command` is cached before the command is run again, e.g. `5m`.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "5m",
+					ValidateFunc: validateDuration,
+				},
+			},
+		},
+	}
+}
+
+// tokenSource provides the credential used to authenticate a request.
+type tokenSource interface {
+	Token() (string, error)
+	// Invalidate discards any cached token so that the next call to Token fetches a fresh one.
+	Invalidate()
+}
+
+type staticTokenSource string
+
+func (s staticTokenSource) Token() (string, error) { return string(s), nil }
+
+func (s staticTokenSource) Invalidate() {}
+
+// fileTokenSource reads the token from a file and re-reads it whenever the file is modified.
+type fileTokenSource struct {
+	path string
+
+	mu      sync.Mutex
+	modTime time.Time
+	token   string
+}
+
+func (s *fileTokenSource) Token() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read token file: %w", err)
+	}
+
+	if s.token != "" && info.ModTime().Equal(s.modTime) {
+		return s.token, nil
+	}
+
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read token file: %w", err)
+	}
+
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("token file %s is empty", s.path)
+	}
+
+	s.token = token
+	s.modTime = info.ModTime()
+	return s.token, nil
+}
+
+func (s *fileTokenSource) Invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.token = ""
+}
+
+// commandTokenSource runs a credential helper and caches its output for the refresh interval.
+type commandTokenSource struct {
+	command  []string
+	interval time.Duration
+
+	mu      sync.Mutex
+	expires time.Time
+	token   string
+}
+
+func (s *commandTokenSource) Token() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && time.Now().Before(s.expires) {
+		return s.token, nil
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(s.command[0], s.command[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("credential command %s failed: %w: %s", s.command[0], err, strings.TrimSpace(stderr.String()))
+	}
+
+	token := strings.TrimSpace(stdout.String())
+	if token == "" {
+		return "", fmt.Errorf("credential command %s returned an empty token", s.command[0])
+	}
+
+	s.token = token
+	s.expires = time.Now().Add(s.interval)
+	return s.token, nil
+}
+
+func (s *commandTokenSource) Invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.token = ""
+}
+
+// authTransport sets the credential from a tokenSource on every outgoing request. When the
+// server rejects the credential the token is refreshed and the request is sent once more.
+type authTransport struct {
+	source    tokenSource
+	tokenType string
+	next      http.RoundTripper
+}
+
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		// The body can only be read once, it is buffered so that the request can be sent again.
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the body of %s %s: %w", req.Method, req.URL, err)
+		}
+
+		req = req.Clone(req.Context())
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+		req.Body, _ = req.GetBody()
+	}
+
+	resp, err := t.roundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+	resp.Body.Close()
+
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, fmt.Errorf("failed to rewind the body of %s %s to send it with a refreshed token: %w", req.Method, req.URL, err)
+		}
+		retry.Body = body
+	}
+
+	t.source.Invalidate()
+	return t.roundTrip(retry)
+}
+
+func (t *authTransport) roundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.source.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	// The request must not be modified by a RoundTripper, so work on a copy.
+	r := req.Clone(req.Context())
+	r.Header.Del(apiKeyHeader)
+	r.Header.Del("Authorization")
+
+	if t.tokenType == authTokenTypeApiKey {
+		r.Header.Set(apiKeyHeader, token)
+	} else {
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	return t.next.RoundTrip(r)
+}
+
+// authConfig holds the credential configured in the `auth` block.
+type authConfig struct {
+	source    tokenSource
+	tokenType string
+}
+
+// getAuthConfig builds the credential configured in the `auth` block. It returns nil when the
+// block isn't set and the static `platform_api_key` should be used instead.
+func getAuthConfig(d *schema.ResourceData) (*authConfig, error) {
+	attr, ok := d.GetOk("auth")
+	if !ok {
+		return nil, nil
+	}
+
+	config := attr.([]interface{})[0].(map[string]interface{})
+	auth := &authConfig{tokenType: config["token_type"].(string)}
+
+	if token := config["bearer_token"].(string); token != "" {
+		auth.source = staticTokenSource(token)
+	} else if path := config["token_file"].(string); path != "" {
+		auth.source = &fileTokenSource{path: path}
+	} else if command := config["credential_command"].([]interface{}); len(command) > 0 {
+		interval, err := time.ParseDuration(config["refresh_interval"].(string))
+		if err != nil {
+			return nil, err
+		}
+
+		args := make([]string, 0, len(command))
+		for _, v := range command {
+			args = append(args, v.(string))
+		}
+
+		auth.source = &commandTokenSource{command: args, interval: interval}
+	} else {
+		return nil, fmt.Errorf("one of bearer_token, token_file or credential_command must be set in the auth block")
+	}
+
+	// Fail fast on a misconfigured credential instead of on the first API call.
+	if _, err := auth.source.Token(); err != nil {
+		return nil, err
+	}
+
+	return auth, nil
+}
+
+// withAuthTransport wraps the transport so that requests are authenticated with the configured
+// credential. The transport is returned unchanged when no `auth` block is set.
+func withAuthTransport(next http.RoundTripper, auth *authConfig) http.RoundTripper {
+	if auth == nil {
+		return next
+	}
+
+	return &authTransport{
+		source:    auth.source,
+		tokenType: auth.tokenType,
+		next:      next,
+	}
+}
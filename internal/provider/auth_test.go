@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileTokenSourceRereadsRotatedToken(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(path, []byte("first\n"), 0600))
+
+	source := &fileTokenSource{path: path}
+	token, err := source.Token()
+	require.NoError(t, err)
+	require.Equal(t, "first", token)
+
+	require.NoError(t, os.WriteFile(path, []byte("second"), 0600))
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(time.Minute)))
+
+	token, err = source.Token()
+	require.NoError(t, err)
+	require.Equal(t, "second", token)
+}
+
+func TestAuthTransportSetsCredential(t *testing.T) {
+	var authorization, apiKey string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		apiKey = r.Header.Get(apiKeyHeader)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: withAuthTransport(http.DefaultTransport, &authConfig{
+		source:    staticTokenSource("jwt"),
+		tokenType: authTokenTypeBearer,
+	})}
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	req.Header.Set(apiKeyHeader, "")
+	_, err := client.Do(req)
+	require.NoError(t, err)
+	require.Equal(t, "Bearer jwt", authorization)
+	require.Empty(t, apiKey)
+
+	client.Transport = withAuthTransport(http.DefaultTransport, &authConfig{
+		source:    staticTokenSource("pat"),
+		tokenType: authTokenTypeApiKey,
+	})
+
+	_, err = client.Do(req)
+	require.NoError(t, err)
+	require.Empty(t, authorization)
+	require.Equal(t, "pat", apiKey)
+}
+
+func TestAuthTransportRetriesUnauthorizedRequestWithBody(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: withAuthTransport(http.DefaultTransport, &authConfig{
+		source:    staticTokenSource("jwt"),
+		tokenType: authTokenTypeBearer,
+	})}
+
+	// The body isn't rewindable, so the request has no GetBody.
+	req, _ := http.NewRequest(http.MethodPost, server.URL, io.NopCloser(strings.NewReader(`{"name":"test"}`)))
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, []string{`{"name":"test"}`, `{"name":"test"}`}, bodies)
+}
@@ -135,9 +135,14 @@ func Provider(version string) func() *schema.Provider {
 					Optional:    true,
 					DefaultFunc: schema.EnvDefaultFunc(helpers.EnvVars.PlatformApiKey.String(), nil),
 				},
+				"auth": getAuthSchema(),
 			},
 			DataSourcesMap: map[string]*schema.Resource{
 				"harness_platform_template":                        pl_template.DataSourceTemplate(),
+				"harness_platform_template_versions":               pl_template.DataSourceTemplateVersions(),
+				"harness_platform_template_list":                   pl_template.DataSourceTemplateList(),
+				"harness_platform_connector":                       connector.DataSourceConnector(),
+				"harness_platform_connector_list":                  connector.DataSourceConnectorList(),
 				"harness_platform_connector_azure_key_vault":       connector.DataSourceConnectorAzureKeyVault(),
 				"harness_platform_connector_gcp_cloud_cost":        connector.DataSourceConnectorGCPCloudCost(),
 				"harness_platform_connector_kubernetes_cloud_cost": connector.DatasourceConnectorKubernetesCloudCost(),
@@ -182,9 +187,14 @@ func Provider(version string) func() *schema.Provider {
 				"harness_platform_environment_clusters_mapping":    pl_environment_clusters_mapping.DataSourceEnvironmentClustersMapping(),
 				"harness_platform_environment_service_overrides":   pl_environment_service_overrides.DataSourceEnvironmentServiceOverrides(),
 				"harness_platform_service_overrides_v2":            pl_service_overrides_v2.DataSourceServiceOverrides(),
+				"harness_platform_feature_flag":                    feature_flag.DataSourceFeatureFlag(),
+				"harness_platform_feature_flag_evaluation":         feature_flag.DataSourceFeatureFlagEvaluation(),
+				"harness_platform_feature_flag_target":             feature_flag_target.DataSourceFeatureFlagTarget(),
+				"harness_platform_feature_flag_target_group":       feature_flag_target_group.DataSourceFeatureFlagTargetGroup(),
 				"harness_platform_gitops_agent":                    gitops_agent.DataSourceGitopsAgent(),
 				"harness_platform_gitops_agent_deploy_yaml":        agent_yaml.DataSourceGitopsAgentDeployYaml(),
 				"harness_platform_gitops_applications":             gitops_applications.DataSourceGitopsApplications(),
+				"harness_platform_gitops_applicationset":           gitops_applications.DataSourceGitopsApplicationSet(),
 				"harness_platform_gitops_cluster":                  gitops_cluster.DataSourceGitopsCluster(),
 				"harness_platform_gitops_gnupg":                    gitops_gnupg.DataSourceGitopsGnupg(),
 				"harness_platform_gitops_app_project_mapping":      gitops_project_mapping.DatasourceGitopsAppProjectMapping(),
@@ -192,21 +202,28 @@ func Provider(version string) func() *schema.Provider {
 				"harness_platform_gitops_repo_cert":                gitops_repo_cert.DataSourceGitOpsRepoCert(),
 				"harness_platform_gitops_repo_cred":                gitops_repo_cred.DataSourceGitOpsRepoCred(),
 				"harness_platform_infrastructure":                  pl_infrastructure.DataSourceInfrastructure(),
+				"harness_platform_infrastructure_list":             pl_infrastructure.DataSourceInfrastructureList(),
 				"harness_platform_input_set":                       input_set.DataSourceInputSet(),
 				"harness_platform_monitored_service":               monitored_service.DataSourceMonitoredService(),
 				"harness_platform_organization":                    organization.DataSourceOrganization(),
+				"harness_platform_organization_list":               organization.DataSourceOrganizationList(),
 				"harness_platform_pipeline":                        pipeline.DataSourcePipeline(),
+				"harness_platform_pipeline_list":                   pipeline.DataSourcePipelineList(),
 				"harness_platform_permissions":                     pl_permissions.DataSourcePermissions(),
 				"harness_platform_project":                         project.DataSourceProject(),
+				"harness_platform_project_list":                    project.DataSourceProjectList(),
 				"harness_platform_service":                         pl_service.DataSourceService(),
 				"harness_platform_service_list":                    pl_service.DataSourceServiceList(),
 				"harness_platform_usergroup":                       usergroup.DataSourceUserGroup(),
+				"harness_platform_usergroup_list":                  usergroup.DataSourceUserGroupList(),
 				"harness_platform_secret_text":                     secret.DataSourceSecretText(),
 				"harness_platform_secret_file":                     secret.DataSourceSecretFile(),
 				"harness_platform_secret_sshkey":                   secret.DataSourceSecretSSHKey(),
+				"harness_platform_secret_list":                     secret.DataSourceSecretList(),
 				"harness_platform_roles":                           roles.DataSourceRoles(),
 				"harness_platform_resource_group":                  resource_group.DataSourceResourceGroup(),
 				"harness_platform_service_account":                 service_account.DataSourceServiceAccount(),
+				"harness_platform_service_account_list":            service_account.DataSourceServiceAccountList(),
 				"harness_platform_triggers":                        triggers.DataSourceTriggers(),
 				"harness_platform_role_assignments":                role_assignments.DataSourceRoleAssignments(),
 				"harness_platform_variables":                       variables.DataSourceVariables(),
@@ -225,6 +242,7 @@ func Provider(version string) func() *schema.Provider {
 				"harness_secret_manager":                           secrets.DataSourceSecretManager(),
 				"harness_service":                                  service.DataSourceService(),
 				"harness_platform_slo":                             slo.DataSourceSloService(),
+				"harness_platform_slo_status":                      slo.DataSourceSloStatus(),
 				"harness_ssh_credential":                           secrets.DataSourceSshCredential(),
 				"harness_sso_provider":                             sso.DataSourceSSOProvider(),
 				"harness_user_group":                               user.DataSourceUserGroup(),
@@ -242,6 +260,7 @@ func Provider(version string) func() *schema.Provider {
 				"harness_autostopping_rule_vm":                     as_rule.DataSourceVMRule(),
 				"harness_autostopping_rule_rds":                    as_rule.DataSourceRDSRule(),
 				"harness_autostopping_rule_ecs":                    as_rule.DataSourceECSRule(),
+				"harness_autostopping_rule_list":                   as_rule.DataSourceRuleList(),
 				"harness_platform_file_store_file":                 file_store.DataSourceFileStoreNodeFile(),
 				"harness_platform_file_store_folder":               file_store.DataSourceFileStoreNodeFolder(),
 				"harness_autostopping_azure_proxy":                 load_balancer.DataSourceAzureProxy(),
@@ -297,12 +316,15 @@ func Provider(version string) func() *schema.Provider {
 				"harness_platform_environment_clusters_mapping":    pl_environment_clusters_mapping.ResourceEnvironmentClustersMapping(),
 				"harness_platform_environment_service_overrides":   pl_environment_service_overrides.ResourceEnvironmentServiceOverrides(),
 				"harness_platform_feature_flag":                    feature_flag.ResourceFeatureFlag(),
+				"harness_platform_feature_flag_environment":        feature_flag.ResourceFeatureFlagEnvironment(),
 				"harness_platform_feature_flag_target_group":       feature_flag_target_group.ResourceFeatureFlagTargetGroup(),
 				"harness_platform_feature_flag_target":             feature_flag_target.ResourceFeatureFlagTarget(),
 				"harness_platform_service_overrides_v2":            pl_service_overrides_v2.ResourceServiceOverrides(),
 				"harness_platform_ff_api_key":                      ff_api_key.ResourceFFApiKey(),
 				"harness_platform_gitops_agent":                    gitops_agent.ResourceGitopsAgent(),
 				"harness_platform_gitops_applications":             gitops_applications.ResourceGitopsApplication(),
+				"harness_platform_gitops_application_sync":         gitops_applications.ResourceGitopsApplicationSync(),
+				"harness_platform_gitops_applicationset":           gitops_applications.ResourceGitopsApplicationSet(),
 				"harness_platform_gitops_cluster":                  gitops_cluster.ResourceGitopsCluster(),
 				"harness_platform_gitops_gnupg":                    gitops_gnupg.ResourceGitopsGnupg(),
 				"harness_platform_gitops_app_project_mapping":      gitops_project_mapping.ResourceGitopsAppProjectMapping(),
@@ -314,6 +336,7 @@ func Provider(version string) func() *schema.Provider {
 				"harness_platform_monitored_service":               monitored_service.ResourceMonitoredService(),
 				"harness_platform_organization":                    organization.ResourceOrganization(),
 				"harness_platform_pipeline":                        pipeline.ResourcePipeline(),
+				"harness_platform_pipeline_execution":              pipeline.ResourcePipelineExecution(),
 				"harness_platform_project":                         project.ResourceProject(),
 				"harness_platform_service":                         pl_service.ResourceService(),
 				"harness_platform_user":                            pl_user.ResourceUser(),
@@ -357,6 +380,7 @@ func Provider(version string) func() *schema.Provider {
 				"harness_service_tanzu":                            service.ResourcePCFService(),
 				"harness_service_winrm":                            service.ResourceWinRMService(),
 				"harness_platform_slo":                             slo.ResourceSloService(),
+				"harness_platform_slo_notification_rule":           slo.ResourceSloNotificationRule(),
 				"harness_ssh_credential":                           secrets.ResourceSSHCredential(),
 				"harness_user_group":                               user.ResourceUserGroup(),
 				"harness_user_group_permissions":                   user.ResourceUserGroupPermissions(),
@@ -373,6 +397,8 @@ func Provider(version string) func() *schema.Provider {
 				"harness_autostopping_rule_vm":                     as_rule.ResourceVMRule(),
 				"harness_autostopping_rule_rds":                    as_rule.ResourceRDSRule(),
 				"harness_autostopping_rule_ecs":                    as_rule.ResourceECSRule(),
+				"harness_autostopping_rule_k8s":                    as_rule.ResourceK8sRule(),
+				"harness_autostopping_rule_asg":                    as_rule.ResourceASGRule(),
 				"harness_platform_file_store_file":                 file_store.ResourceFileStoreNodeFile(),
 				"harness_platform_file_store_folder":               file_store.ResourceFileStoreNodeFolder(),
 				"harness_autostopping_azure_proxy":                 load_balancer.ResourceAzureProxy(),
@@ -386,33 +412,37 @@ func Provider(version string) func() *schema.Provider {
 			},
 		}
 
+		for k, v := range getTransportSchema() {
+			p.Schema[k] = v
+		}
+
 		p.ConfigureContextFunc = configure(version, p)
 
 		return p
 	}
 }
 
-func getHttpClient(logger *logrus.Logger) *retryablehttp.Client {
+func getHttpClient(logger *logrus.Logger, auth *authConfig, transport *transportConfig) *retryablehttp.Client {
 	httpClient := retryablehttp.NewClient()
-	httpClient.HTTPClient.Transport = logging.NewTransport(harness.SDKName, logger, cleanhttp.DefaultPooledClient().Transport)
-	httpClient.RetryMax = 10
+	httpClient.HTTPClient.Transport = logging.NewTransport(harness.SDKName, logger, withAuthTransport(transport.newTransport(), auth))
+	transport.configureClient(httpClient)
 	return httpClient
 }
 
-func getOpenApiHttpClient(logger *logrus.Logger) *retryablehttp.Client {
+func getOpenApiHttpClient(logger *logrus.Logger, auth *authConfig, transport *transportConfig) *retryablehttp.Client {
 	httpClient := retryablehttp.NewClient()
-	httpClient.HTTPClient.Transport = openapi_client_logging.NewTransport(harness.SDKName, logger, cleanhttp.DefaultPooledClient().Transport)
-	httpClient.RetryMax = 10
+	httpClient.HTTPClient.Transport = openapi_client_logging.NewTransport(harness.SDKName, logger, withAuthTransport(transport.newTransport(), auth))
+	transport.configureClient(httpClient)
 	return httpClient
 }
 
-func getCDClient(d *schema.ResourceData, version string) *cd.ApiClient {
+func getCDClient(d *schema.ResourceData, version string, transport *transportConfig) *cd.ApiClient {
 	cfg := cd.DefaultConfig()
 	cfg.AccountId = d.Get("account_id").(string)
 	cfg.Endpoint = d.Get("endpoint").(string)
 	cfg.APIKey = d.Get("api_key").(string)
 	cfg.UserAgent = fmt.Sprintf("terraform-provider-harness-%s", version)
-	cfg.HTTPClient = getHttpClient(cfg.Logger)
+	cfg.HTTPClient = getHttpClient(cfg.Logger, nil, transport)
 	cfg.DebugLogging = logging.IsDebugOrHigher(cfg.Logger)
 
 	client, err := cd.NewClient(cfg)
@@ -424,28 +454,28 @@ func getCDClient(d *schema.ResourceData, version string) *cd.ApiClient {
 	return client
 }
 
-func getPLClient(d *schema.ResourceData, version string) *nextgen.APIClient {
+func getPLClient(d *schema.ResourceData, version string, auth *authConfig, transport *transportConfig) *nextgen.APIClient {
 	cfg := nextgen.NewConfiguration()
 	client := nextgen.NewAPIClient(&nextgen.Configuration{
 		AccountId:    d.Get("account_id").(string),
 		BasePath:     d.Get("endpoint").(string),
 		ApiKey:       d.Get("platform_api_key").(string),
 		UserAgent:    fmt.Sprintf("terraform-provider-harness-platform-%s", version),
-		HTTPClient:   getHttpClient(cfg.Logger),
+		HTTPClient:   getHttpClient(cfg.Logger, auth, transport),
 		DebugLogging: logging.IsDebugOrHigher(cfg.Logger),
 	})
 
 	return client
 }
 
-func getClient(d *schema.ResourceData, version string) *openapi_client_nextgen.APIClient {
+func getClient(d *schema.ResourceData, version string, auth *authConfig, transport *transportConfig) *openapi_client_nextgen.APIClient {
 	cfg := openapi_client_nextgen.NewConfiguration()
 	client := openapi_client_nextgen.NewAPIClient(&openapi_client_nextgen.Configuration{
 		AccountId:    d.Get("account_id").(string),
 		BasePath:     d.Get("endpoint").(string),
 		ApiKey:       d.Get("platform_api_key").(string),
 		UserAgent:    fmt.Sprintf("terraform-provider-harness-platform-%s", version),
-		HTTPClient:   getOpenApiHttpClient(cfg.Logger),
+		HTTPClient:   getOpenApiHttpClient(cfg.Logger, auth, transport),
 		DebugLogging: openapi_client_logging.IsDebugOrHigher(cfg.Logger),
 	})
 
@@ -455,12 +485,22 @@ func getClient(d *schema.ResourceData, version string) *openapi_client_nextgen.A
 // Setup the client for interacting with the Harness API
 func configure(version string, p *schema.Provider) func(context.Context, *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		auth, err := getAuthConfig(d)
+		if err != nil {
+			return nil, diag.Errorf("failed to configure authentication: %s", err)
+		}
+
+		transport, err := getTransportConfig(d)
+		if err != nil {
+			return nil, diag.Errorf("failed to configure the http client: %s", err)
+		}
+
 		return &internal.Session{
 			AccountId: d.Get("account_id").(string),
 			Endpoint:  d.Get("endpoint").(string),
-			CDClient:  getCDClient(d, version),
-			PLClient:  getPLClient(d, version),
-			Client:    getClient(d, version),
+			CDClient:  getCDClient(d, version, transport),
+			PLClient:  getPLClient(d, version, auth, transport),
+			Client:    getClient(d, version, auth, transport),
 		}, nil
 	}
 }
@@ -0,0 +1,347 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func getTransportSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"max_retries": {
+			Description:  "Maximum number of times a failed request is retried. The default is `10`.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      10,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"retry_wait_min": {
+			Description:  "Minimum time to wait before retrying a failed request, e.g. `1s`. The wait time doubles on every attempt up to `retry_wait_max`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "1s",
+			ValidateFunc: validateDuration,
+		},
+		"retry_wait_max": {
+			Description:  "Maximum time to wait before retrying a failed request, e.g. `30s`. A `Retry-After` header sent by the server takes precedence.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "30s",
+			ValidateFunc: validateDuration,
+		},
+		"request_timeout": {
+			Description:  "Timeout of a single request attempt, e.g. `60s`. There is no timeout when not set.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateDuration,
+		},
+		"rate_limit": {
+			Description:  "Maximum number of requests per second sent to Harness. Requests are additionally paused when the server responds with `429 Too Many Requests`. There is no limit when set to `0`.",
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.FloatAtLeast(0),
+		},
+		"rate_limit_burst": {
+			Description:  "Number of requests that may be sent at once before `rate_limit` applies.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"https_proxy": {
+			Description: "URL of the proxy used to reach Harness. When not set the `HTTPS_PROXY` and `NO_PROXY` environment variables are used.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			ValidateFunc: func(i interface{}, k string) ([]string, []error) {
+				if _, err := url.Parse(i.(string)); err != nil {
+					return nil, []error{fmt.Errorf("%q must be a valid URL: %s", k, err)}
+				}
+				return nil, nil
+			},
+		},
+		"ca_bundle_file": {
+			Description: "Path to a PEM encoded bundle of certificate authorities trusted in addition to the system roots, e.g. for a self-managed Harness behind a private CA.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"client_certificate_file": {
+			Description:  "Path to a PEM encoded client certificate used for mutual TLS.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			RequiredWith: []string{"client_key_file"},
+		},
+		"client_key_file": {
+			Description:  "Path to the PEM encoded private key of `client_certificate_file`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			RequiredWith: []string{"client_certificate_file"},
+		},
+	}
+}
+
+func validateDuration(i interface{}, k string) ([]string, []error) {
+	if _, err := time.ParseDuration(i.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%q must be a valid duration: %s", k, err)}
+	}
+	return nil, nil
+}
+
+// transportConfig holds the HTTP settings shared by every client created by the provider.
+type transportConfig struct {
+	retryMax       int
+	retryWaitMin   time.Duration
+	retryWaitMax   time.Duration
+	requestTimeout time.Duration
+	proxy          *url.URL
+	tlsConfig      *tls.Config
+	limiter        *rateLimiter
+}
+
+func getTransportConfig(d *schema.ResourceData) (*transportConfig, error) {
+	cfg := &transportConfig{
+		retryMax: d.Get("max_retries").(int),
+	}
+
+	var err error
+	if cfg.retryWaitMin, err = time.ParseDuration(d.Get("retry_wait_min").(string)); err != nil {
+		return nil, err
+	}
+
+	if cfg.retryWaitMax, err = time.ParseDuration(d.Get("retry_wait_max").(string)); err != nil {
+		return nil, err
+	}
+
+	if attr, ok := d.GetOk("request_timeout"); ok {
+		if cfg.requestTimeout, err = time.ParseDuration(attr.(string)); err != nil {
+			return nil, err
+		}
+	}
+
+	if attr, ok := d.GetOk("https_proxy"); ok {
+		if cfg.proxy, err = url.Parse(attr.(string)); err != nil {
+			return nil, fmt.Errorf("invalid https_proxy: %w", err)
+		}
+	}
+
+	if cfg.tlsConfig, err = getTLSConfig(d); err != nil {
+		return nil, err
+	}
+
+	if limit := d.Get("rate_limit").(float64); limit > 0 {
+		cfg.limiter = newRateLimiter(limit, d.Get("rate_limit_burst").(int))
+	}
+
+	return cfg, nil
+}
+
+func getTLSConfig(d *schema.ResourceData) (*tls.Config, error) {
+	caFile := d.Get("ca_bundle_file").(string)
+	certFile := d.Get("client_certificate_file").(string)
+	keyFile := d.Get("client_key_file").(string)
+
+	if caFile == "" && certFile == "" {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if caFile != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read ca_bundle_file: %w", err)
+		}
+
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in ca_bundle_file %s", caFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if certFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// newTransport returns the base transport with the proxy, TLS and rate limit settings applied.
+func (c *transportConfig) newTransport() http.RoundTripper {
+	transport := cleanhttp.DefaultPooledTransport()
+
+	if c.proxy != nil {
+		transport.Proxy = http.ProxyURL(c.proxy)
+	}
+
+	if c.tlsConfig != nil {
+		transport.TLSClientConfig = c.tlsConfig.Clone()
+	}
+
+	if c.limiter == nil {
+		return transport
+	}
+
+	return &rateLimitTransport{limiter: c.limiter, next: transport}
+}
+
+// configureClient applies the retry and timeout settings to the client.
+func (c *transportConfig) configureClient(client *retryablehttp.Client) {
+	client.RetryMax = c.retryMax
+	client.RetryWaitMin = c.retryWaitMin
+	client.RetryWaitMax = c.retryWaitMax
+	client.Backoff = retryAfterBackoff
+	client.HTTPClient.Timeout = c.requestTimeout
+}
+
+// retryAfterBackoff waits for as long as the server asked in the Retry-After header of a 429 or
+// 503 response, and otherwise backs off exponentially between min and max.
+func retryAfterBackoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	if wait, ok := getRetryAfter(resp); ok {
+		return wait
+	}
+
+	mult := math.Pow(2, float64(attemptNum)) * float64(min)
+	sleep := time.Duration(mult)
+	if float64(sleep) != mult || sleep > max {
+		sleep = max
+	}
+	return sleep
+}
+
+func getRetryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil || (resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusServiceUnavailable) {
+		return 0, false
+	}
+
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		if wait := time.Until(date); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+
+	return 0, false
+}
+
+// rateLimiter is a token bucket shared by all clients of the provider, so the limit applies to
+// the total number of requests sent to Harness.
+type rateLimiter struct {
+	mu       sync.Mutex
+	rate     float64
+	burst    float64
+	tokens   float64
+	last     time.Time
+	resumeAt time.Time
+}
+
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	return &rateLimiter{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// reserve takes a token from the bucket and returns how long the caller has to wait before
+// sending its request.
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	l.tokens--
+
+	var wait time.Duration
+	if l.tokens < 0 {
+		wait = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+
+	if pause := l.resumeAt.Sub(now); pause > wait {
+		wait = pause
+	}
+
+	return wait
+}
+
+// pause stops all requests until the given time, e.g. after the server asked to back off.
+func (l *rateLimiter) pause(until time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if until.After(l.resumeAt) {
+		l.resumeAt = until
+	}
+}
+
+func (l *rateLimiter) wait(ctx context.Context) error {
+	wait := l.reserve()
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+type rateLimitTransport struct {
+	limiter *rateLimiter
+	next    http.RoundTripper
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	if wait, ok := getRetryAfter(resp); ok && resp.StatusCode == http.StatusTooManyRequests {
+		t.limiter.pause(time.Now().Add(wait))
+	}
+
+	return resp, nil
+}
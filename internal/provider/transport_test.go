@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryAfterBackoff(t *testing.T) {
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+	resp.Header.Set("Retry-After", "7")
+	require.Equal(t, 7*time.Second, retryAfterBackoff(time.Second, 30*time.Second, 0, resp))
+
+	resp.StatusCode = http.StatusInternalServerError
+	require.Equal(t, 4*time.Second, retryAfterBackoff(time.Second, 30*time.Second, 2, resp))
+	require.Equal(t, 30*time.Second, retryAfterBackoff(time.Second, 30*time.Second, 10, resp))
+}
+
+func TestRateLimiterReserve(t *testing.T) {
+	limiter := newRateLimiter(1, 2)
+	require.Zero(t, limiter.reserve())
+	require.Zero(t, limiter.reserve())
+	require.InDelta(t, float64(time.Second), float64(limiter.reserve()), float64(50*time.Millisecond))
+
+	limiter.pause(time.Now().Add(time.Minute))
+	require.Greater(t, limiter.reserve(), 30*time.Second)
+}
@@ -7,7 +7,7 @@ import (
 func DataSourceAWSProxy() *schema.Resource {
 	resource := &schema.Resource{
 		Description: "Data source for AWS Autostopping proxy",
-		ReadContext: resourceLoadBalancerRead,
+		ReadContext: dataSourceLoadBalancerRead("aws", "autostopping_proxy"),
 		Schema: map[string]*schema.Schema{
 			"identifier": {
 				Description: "Unique identifier of the resource",
@@ -7,7 +7,7 @@ import (
 func DataSourceAzureGateway() *schema.Resource {
 	resource := &schema.Resource{
 		Description: "Data source for AWS Autostopping proxy",
-		ReadContext: resourceLoadBalancerRead,
+		ReadContext: dataSourceLoadBalancerRead("azure", "app_gateway"),
 		Schema: map[string]*schema.Schema{
 			"identifier": {
 				Description: "Unique identifier of the resource",
@@ -7,7 +7,7 @@ import (
 func DataSourceAzureProxy() *schema.Resource {
 	resource := &schema.Resource{
 		Description: "Data source for Azure Autostopping proxy",
-		ReadContext: resourceLoadBalancerRead,
+		ReadContext: dataSourceLoadBalancerRead("azure", "autostopping_proxy"),
 		Schema: map[string]*schema.Schema{
 			"identifier": {
 				Description: "Unique identifier of the resource",
@@ -7,7 +7,7 @@ import (
 func DataSourceGCPProxy() *schema.Resource {
 	resource := &schema.Resource{
 		Description: "Data source for GCP Autostopping proxy",
-		ReadContext: resourceLoadBalancerRead,
+		ReadContext: dataSourceLoadBalancerRead("gcp", "autostopping_proxy"),
 		Schema: map[string]*schema.Schema{
 			"identifier": {
 				Description: "Unique identifier of the resource",
@@ -28,6 +28,31 @@ func resourceLoadBalancerRead(ctx context.Context, d *schema.ResourceData, meta
 	return nil
 }
 
+// dataSourceLoadBalancerRead reads the load balancer of the given type and kind with the name and cloud connector given
+// in the configuration.
+func dataSourceLoadBalancerRead(type_, kind string) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
+
+		resp, httpResp, err := c.CloudCostAutoStoppingLoadBalancersApi.ListLoadBalancers(ctx, c.AccountId, c.AccountId)
+		if err != nil {
+			return helpers.HandleApiError(err, d, httpResp)
+		}
+
+		name := d.Get("name").(string)
+		cloudConnectorId := d.Get("cloud_connector_id").(string)
+		for _, accessPoint := range resp.Response {
+			if strings.EqualFold(accessPoint.Type_, type_) && strings.EqualFold(accessPoint.Kind, kind) &&
+				accessPoint.Name == name && accessPoint.CloudAccountId == cloudConnectorId {
+				d.SetId(accessPoint.Id)
+				return resourceLoadBalancerRead(ctx, d, meta)
+			}
+		}
+
+		return diag.Errorf("AutoStopping load balancer %s not found", name)
+	}
+}
+
 func resourceLoadBalancerCreateOrUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, lb nextgen.AccessPoint) diag.Diagnostics {
 	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
 
@@ -80,6 +105,15 @@ func resourceLoadBalancerDelete(ctx context.Context, d *schema.ResourceData, met
 	return nil
 }
 
+// loadBalancerMetadataAttributes lists the attributes read from the metadata of each type and kind of load balancer.
+var loadBalancerMetadataAttributes = map[string][]string{
+	"aws:":                     {"security_groups", "certificate_id", "route53_hosted_zone_id", "alb_arn"},
+	"aws:autostopping_proxy":   {"security_groups", "allocate_static_ip", "machine_type", "route53_hosted_zone_id", "keypair", "certificates"},
+	"gcp:autostopping_proxy":   {"zone", "subnet_id", "security_groups", "allocate_static_ip", "machine_type", "certificates"},
+	"azure:autostopping_proxy": {"resource_group", "subnet_id", "security_groups", "allocate_static_ip", "machine_type", "keypair", "certificate_id", "certificates"},
+	"azure:app_gateway":        {"resource_group", "subnet_id", "azure_func_region", "frontend_ip", "sku_size", "app_gateway_id", "certificate_id"},
+}
+
 func readLoadBalancer(d *schema.ResourceData, accessPoint *nextgen.AccessPoint) {
 	d.SetId(accessPoint.Id)
 	d.Set("identifier", accessPoint.Id)
@@ -88,6 +122,58 @@ func readLoadBalancer(d *schema.ResourceData, accessPoint *nextgen.AccessPoint)
 	d.Set("cloud_connector_id", accessPoint.CloudAccountId)
 	d.Set("region", accessPoint.Region)
 	d.Set("vpc", accessPoint.Vpc)
+
+	if accessPoint.Metadata == nil {
+		return
+	}
+
+	metadata := flattenLoadBalancerMetadata(accessPoint)
+	impl := fmt.Sprintf("%s:%s", strings.ToLower(accessPoint.Type_), strings.ToLower(accessPoint.Kind))
+	for _, attr := range loadBalancerMetadataAttributes[impl] {
+		d.Set(attr, metadata[attr])
+	}
+}
+
+// flattenLoadBalancerMetadata returns the value of each attribute held in the metadata of a load balancer. The API
+// key isn't returned by the API.
+func flattenLoadBalancerMetadata(accessPoint *nextgen.AccessPoint) map[string]interface{} {
+	meta := accessPoint.Metadata
+
+	subnet := meta.SubnetId
+	if strings.ToLower(accessPoint.Type_) == "gcp" {
+		subnet = meta.SubnetName
+	}
+
+	hostedZoneId := ""
+	if meta.Dns != nil && meta.Dns.Route53 != nil {
+		hostedZoneId = meta.Dns.Route53.HostedZoneId
+	}
+
+	certificates := make([]interface{}, 0)
+	for _, certificate := range meta.Certificates {
+		certificates = append(certificates, map[string]interface{}{
+			"cert_secret_id": certificate.CertSecretId,
+			"key_secret_id":  certificate.KeySecretId,
+		})
+	}
+
+	return map[string]interface{}{
+		"security_groups":        meta.SecurityGroups,
+		"subnet_id":              subnet,
+		"zone":                   meta.Zone,
+		"alb_arn":                meta.AlbArn,
+		"certificate_id":         meta.CertificateId,
+		"machine_type":           meta.MachineType,
+		"keypair":                meta.Keypair,
+		"resource_group":         meta.ResourceGroup,
+		"allocate_static_ip":     meta.AllocateStaticIp,
+		"app_gateway_id":         meta.AppGatewayId,
+		"azure_func_region":      meta.FuncRegion,
+		"sku_size":               meta.Size,
+		"frontend_ip":            meta.FeIpId,
+		"route53_hosted_zone_id": hostedZoneId,
+		"certificates":           certificates,
+	}
 }
 
 func nonEmptyString(str string) bool {
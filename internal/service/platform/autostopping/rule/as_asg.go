@@ -0,0 +1,121 @@
+package as_rule
+
+import (
+	"context"
+
+	"github.com/harness/terraform-provider-harness/helpers"
+	"github.com/harness/terraform-provider-harness/internal"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceASGRule() *schema.Resource {
+	resource := &schema.Resource{
+		Description: "Resource for creating an AutoStopping rule for scaling groups: AWS Auto Scaling groups, GCP managed instance groups and Azure virtual machine scale sets. The group is scaled to zero when idle and back to its desired capacity when accessed.",
+
+		ReadContext:   resourceASRuleRead,
+		CreateContext: resourceASGRuleCreateOrUpdate,
+		UpdateContext: resourceASGRuleCreateOrUpdate,
+		DeleteContext: resourceASRuleDelete,
+		Importer:      helpers.NumericIdResourceImporter,
+		Schema: map[string]*schema.Schema{
+			"identifier": {
+				Description: "Unique identifier of the resource",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+			"name": {
+				Description: "Name of the rule",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"cloud_connector_id": {
+				Description: "Id of the cloud connector",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"idle_time_mins": {
+				Description: "Idle time in minutes. This is the time that the AutoStopping rule waits before scaling down the idle group.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     15,
+			},
+			"custom_domains": {
+				Description: "Custom URLs used to access the instances",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"scale_group": {
+				Description: "Scaling group managed by the rule",
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "Id of the scaling group. The ARN of AWS Auto Scaling groups, the self link of GCP instance groups and the resource id of Azure scale sets.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"name": {
+							Description: "Name of the scaling group",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"region": {
+							Description: "Region of the scaling group",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"zone": {
+							Description: "Zone of the scaling group, for zonal GCP instance groups",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"desired": {
+							Description: "Number of instances the group is scaled to when the rule warms it up",
+							Type:        schema.TypeInt,
+							Required:    true,
+						},
+						"min": {
+							Description: "Minimum number of instances of the group when it is warmed up",
+							Type:        schema.TypeInt,
+							Required:    true,
+						},
+						"max": {
+							Description: "Maximum number of instances of the group when it is warmed up",
+							Type:        schema.TypeInt,
+							Required:    true,
+						},
+						"on_demand": {
+							Description: "Number of on-demand instances among the desired instances. Defaults to all of them when neither on_demand nor spot is set.",
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+						},
+						"spot": {
+							Description: "Number of spot instances among the desired instances",
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"http":    getHttpSchema(),
+			"tcp":     getTcpSchema(),
+			"depends": getDependsSchema(),
+		},
+	}
+
+	return resource
+}
+
+func resourceASGRuleCreateOrUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
+	saveServiceRequestV2 := buildASRule(d, Instance, c.AccountId)
+	return resourceASRuleCreateOrUpdate(ctx, d, meta, saveServiceRequestV2)
+}
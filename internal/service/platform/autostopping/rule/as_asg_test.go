@@ -0,0 +1,62 @@
+package as_rule_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/harness/terraform-provider-harness/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestResourceASGRule(t *testing.T) {
+	name := "terraform-rule-test-asg"
+	resourceName := "harness_autostopping_rule_asg.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.TestAccPreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testASGRule(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "scale_group.0.desired", "2"),
+					resource.TestCheckResourceAttr(resourceName, "scale_group.0.on_demand", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testASGRule(name string) string {
+	return fmt.Sprintf(`
+	resource "harness_autostopping_rule_asg" "test" {
+		name = "%[1]s"
+		cloud_connector_id = "DoNotDelete_LightwingNonProd"
+		idle_time_mins = 10
+		scale_group {
+			id = "arn:aws:autoscaling:us-east-1:123456789012:autoScalingGroup:uuid:autoScalingGroupName/asg"
+			name = "asg"
+			region = "us-east-1"
+			desired = 2
+			min = 1
+			max = 3
+		}
+		http {
+			proxy_id = "ap-ciun1635us1fhpjiotfg"
+			routing {
+				source_protocol = "http"
+				target_protocol = "http"
+				source_port = 80
+				target_port = 80
+				action = "forward"
+			}
+		}
+	}
+`, name)
+}
@@ -17,7 +17,7 @@ func ResourceECSRule() *schema.Resource {
 		CreateContext: resourceECSRuleCreateOrUpdate,
 		UpdateContext: resourceECSRuleCreateOrUpdate,
 		DeleteContext: resourceASRuleDelete,
-		Importer:      helpers.MultiLevelResourceImporter,
+		Importer:      helpers.NumericIdResourceImporter,
 		Schema: map[string]*schema.Schema{
 			"identifier": {
 				Description: "Unique identifier of the resource",
@@ -92,26 +92,7 @@ func ResourceECSRule() *schema.Resource {
 					},
 				},
 			},
-			"depends": {
-				Description: "Dependent rules",
-				Type:        schema.TypeList,
-				Optional:    true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"rule_id": {
-							Description: "Rule id of the dependent rule",
-							Type:        schema.TypeInt,
-							Required:    true,
-						},
-						"delay_in_sec": {
-							Description: "Number of seconds the rule should wait after warming up the dependent rule",
-							Type:        schema.TypeInt,
-							Optional:    true,
-							Default:     5,
-						},
-					},
-				},
-			},
+			"depends": getDependsSchema(),
 		},
 	}
 
@@ -6,8 +6,8 @@ import (
 
 func DataSourceECSRule() *schema.Resource {
 	resource := &schema.Resource{
-		Description: "Data source for retrieving a Harness Variable.",
-		ReadContext: resourceASRuleRead,
+		Description: "Data source for retrieving an AutoStopping rule for ECS services by name.",
+		ReadContext: dataSourceASRuleRead(ECS),
 
 		Schema: map[string]*schema.Schema{
 			"identifier": {
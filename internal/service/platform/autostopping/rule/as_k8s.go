@@ -0,0 +1,116 @@
+package as_rule
+
+import (
+	"context"
+
+	"github.com/harness/terraform-provider-harness/helpers"
+	"github.com/harness/terraform-provider-harness/internal"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceK8sRule() *schema.Resource {
+	resource := &schema.Resource{
+		Description: "Resource for creating an AutoStopping rule for Kubernetes workloads. The workloads are scaled down when idle and scaled back up when a request reaches them through the ingress.",
+
+		ReadContext:   resourceASRuleRead,
+		CreateContext: resourceK8sRuleCreateOrUpdate,
+		UpdateContext: resourceK8sRuleCreateOrUpdate,
+		DeleteContext: resourceASRuleDelete,
+		Importer:      helpers.NumericIdResourceImporter,
+		Schema: map[string]*schema.Schema{
+			"identifier": {
+				Description: "Unique identifier of the resource",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+			"name": {
+				Description: "Name of the rule",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"cloud_connector_id": {
+				Description: "Id of the cloud connector of the cloud account hosting the cluster",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"k8s_connector_id": {
+				Description: "Id of the Kubernetes connector of the cluster",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"namespace": {
+				Description: "Namespace of the workloads",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"idle_time_mins": {
+				Description: "Idle time in minutes. This is the time that the AutoStopping rule waits before scaling down the idle workloads.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     15,
+			},
+			"custom_domains": {
+				Description: "Custom URLs used to access the workloads",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"workload": {
+				Description: "Workloads managed by the rule",
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "Name of the workload",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"type": {
+							Description:  "Type of the workload. Valid values are `Deployment` and `StatefulSet`",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "Deployment",
+							ValidateFunc: validation.StringInSlice([]string{"Deployment", "StatefulSet"}, false),
+						},
+					},
+				},
+			},
+			"ingress": {
+				Description: "Ingress through which the workloads are accessed. Requests reaching the ingress wake the workloads up.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "Name of the ingress",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"controller_name": {
+							Description: "Name of the ingress controller serving the ingress",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"health":  getHealthCheckSchema(),
+			"depends": getDependsSchema(),
+		},
+	}
+
+	return resource
+}
+
+func resourceK8sRuleCreateOrUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
+	saveServiceRequestV2 := buildASRule(d, K8s, c.AccountId)
+	return resourceASRuleCreateOrUpdate(ctx, d, meta, saveServiceRequestV2)
+}
@@ -0,0 +1,62 @@
+package as_rule_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/harness/terraform-provider-harness/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestResourceK8sRule(t *testing.T) {
+	name := "terraform-rule-test-k8s"
+	resourceName := "harness_autostopping_rule_k8s.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.TestAccPreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testK8sRule(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "namespace", "default"),
+					resource.TestCheckResourceAttr(resourceName, "workload.0.type", "Deployment"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.0.name", "ingress"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testK8sRule(name string) string {
+	return fmt.Sprintf(`
+	resource "harness_autostopping_rule_k8s" "test" {
+		name = "%[1]s"
+		cloud_connector_id = "DoNotDelete_LightwingNonProd"
+		k8s_connector_id = "k8s_connector"
+		namespace = "default"
+		idle_time_mins = 10
+		workload {
+			name = "app"
+		}
+		ingress {
+			name = "ingress"
+			controller_name = "nginx"
+		}
+		health {
+			protocol = "http"
+			port = 80
+			path = "/"
+			timeout = 30
+			status_code_from = 200
+			status_code_to = 299
+		}
+	}
+`, name)
+}
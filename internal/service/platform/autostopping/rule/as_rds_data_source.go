@@ -6,8 +6,8 @@ import (
 
 func DataSourceRDSRule() *schema.Resource {
 	resource := &schema.Resource{
-		Description: "Data source for retrieving a Harness Variable.",
-		ReadContext: resourceASRuleRead,
+		Description: "Data source for retrieving an AutoStopping rule for RDS databases by name.",
+		ReadContext: dataSourceASRuleRead(Database),
 
 		Schema: map[string]*schema.Schema{
 			"identifier": {
@@ -33,7 +33,8 @@ func DataSourceRDSRule() *schema.Resource {
 			},
 			"database": {
 				Type:     schema.TypeList,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
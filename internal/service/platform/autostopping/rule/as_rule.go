@@ -3,8 +3,10 @@ package as_rule
 import (
 	"context"
 	"net/http"
+	"sort"
 	"strconv"
 
+	"github.com/antihax/optional"
 	"github.com/harness/harness-go-sdk/harness/nextgen"
 	"github.com/harness/terraform-provider-harness/helpers"
 	"github.com/harness/terraform-provider-harness/internal"
@@ -16,6 +18,12 @@ const (
 	Database = "database"
 	Instance = "instance"
 	ECS      = "containers"
+	K8s      = "k8s"
+)
+
+const (
+	FulfilmentOnDemand = "ondemand"
+	FulfilmentSpot     = "spot"
 )
 
 func resourceASRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -31,13 +39,63 @@ func resourceASRuleRead(ctx context.Context, d *schema.ResourceData, meta interf
 		return helpers.HandleReadApiError(err, d, httpResp)
 	}
 
-	if resp.Response != nil {
-		readASRule(d, resp.Response.Service.Id)
+	if resp.Response == nil || resp.Response.Service == nil {
+		d.SetId("")
+		d.MarkNewResource()
+		return nil
 	}
 
+	readASRule(d, resp.Response.Service, resp.Response.Deps)
+
 	return nil
 }
 
+// dataSourceASRuleRead reads the rule of the given kind with the name and cloud connector given in the configuration.
+func dataSourceASRuleRead(kind string) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
+
+		name := d.Get("name").(string)
+		cloudConnectorId := d.Get("cloud_connector_id").(string)
+		rules, httpResp, err := listASRules(ctx, c)
+		if err != nil {
+			return helpers.HandleApiError(err, d, httpResp)
+		}
+
+		for _, rule := range rules {
+			if rule.Kind == kind && rule.Name == name && rule.CloudAccountId == cloudConnectorId {
+				d.SetId(strconv.FormatInt(rule.Id, 10))
+				return resourceASRuleRead(ctx, d, meta)
+			}
+		}
+
+		return diag.Errorf("AutoStopping rule %s not found", name)
+	}
+}
+
+// listASRules returns all the AutoStopping rules of the account, reading every page of the list.
+func listASRules(ctx context.Context, c *nextgen.APIClient) ([]nextgen.Service, *http.Response, error) {
+	rules := []nextgen.Service{}
+	opts := &nextgen.CloudCostAutoStoppingRulesApiListAutoStoppingRulesOpts{
+		Limit: optional.NewInt32(helpers.ListPageSize),
+	}
+
+	httpResp, err := helpers.ListAllPages(func(page int32) (bool, *http.Response, error) {
+		opts.Page = optional.NewInt32(page)
+		resp, httpResp, err := c.CloudCostAutoStoppingRulesApi.ListAutoStoppingRules(ctx, c.AccountId, c.AccountId, opts)
+		if err != nil {
+			return false, httpResp, err
+		}
+
+		rules = append(rules, resp.Response...)
+		return helpers.IsFullPage(len(resp.Response)), httpResp, nil
+	})
+	if err != nil {
+		return nil, httpResp, err
+	}
+	return rules, httpResp, nil
+}
+
 func resourceASRuleCreateOrUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, rule nextgen.SaveServiceRequestV2) diag.Diagnostics {
 	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
 
@@ -57,11 +115,13 @@ func resourceASRuleCreateOrUpdate(ctx context.Context, d *schema.ResourceData, m
 		return helpers.HandleApiError(err, d, httpResp)
 	}
 
-	if resp.Response != nil {
-		readASRule(d, resp.Response.Id)
+	if resp.Response == nil {
+		return diag.Errorf("AutoStopping rule %s was not returned by the server", rule.Service.Name)
 	}
 
-	return nil
+	d.SetId(strconv.FormatInt(resp.Response.Id, 10))
+
+	return resourceASRuleRead(ctx, d, meta)
 }
 
 func resourceASRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -88,14 +148,9 @@ func buildASRule(d *schema.ResourceData, kind string, accountId string) nextgen.
 	if attr, ok := d.GetOk("cloud_connector_id"); ok {
 		serviceV2.CloudAccountId = attr.(string)
 	}
-	serviceV2.Fulfilment = "ondemand"
-	if attr, ok := d.GetOk("use_spot"); ok {
-		onDemand := attr.(bool)
-		if onDemand {
-			serviceV2.Fulfilment = "ondemand"
-		} else {
-			serviceV2.Fulfilment = "spot"
-		}
+	serviceV2.Fulfilment = FulfilmentOnDemand
+	if attr, ok := d.GetOk("use_spot"); ok && attr.(bool) {
+		serviceV2.Fulfilment = FulfilmentSpot
 	}
 	serviceV2.IdleTimeMins = 15
 	if attr, ok := d.GetOk("idle_time_mins"); ok {
@@ -166,6 +221,21 @@ func buildASRule(d *schema.ResourceData, kind string, accountId string) nextgen.
 		routingData.Instance.Filter = filter
 	}
 
+	scaleGroup := getScaleGroupConfig(d)
+	if scaleGroup != nil {
+		routingData.Instance = &nextgen.InstanceBasedRoutingDataV2{}
+		routingData.Instance.ScaleGroup = scaleGroup
+	}
+
+	k8s := getK8sConfig(d)
+	if k8s != nil {
+		routingData.K8s = k8s
+	}
+
+	if attr, ok := d.GetOk("health"); ok {
+		serviceV2.HealthCheck = getHealthCheckConfig(attr.([]interface{}))
+	}
+
 	serviceV2.Routing = routingData
 	deps := getDependencies(d)
 	saveServiceRequestV2 := &nextgen.SaveServiceRequestV2{
@@ -206,7 +276,7 @@ func getContainerConfig(d *schema.ResourceData) *nextgen.ContainerSvc {
 		}
 		containerSvc.TaskCount = 1
 		if attr, ok := databaseObj["task_count"]; ok {
-			desCount, ok := attr.(int64)
+			desCount, ok := attr.(int)
 			if ok {
 				containerSvc.TaskCount = float64(desCount)
 			}
@@ -215,6 +285,83 @@ func getContainerConfig(d *schema.ResourceData) *nextgen.ContainerSvc {
 	return containerSvc
 }
 
+func getScaleGroupConfig(d *schema.ResourceData) *nextgen.AsgMinimal {
+	var scaleGroup *nextgen.AsgMinimal
+	if attr, ok := d.GetOk("scale_group"); ok {
+		scaleGroup = &nextgen.AsgMinimal{}
+		scaleGroupObj := attr.([]interface{})[0].(map[string]interface{})
+		scaleGroup.Id = scaleGroupObj["id"].(string)
+		scaleGroup.Name = scaleGroupObj["name"].(string)
+		scaleGroup.Region = scaleGroupObj["region"].(string)
+		scaleGroup.Zone = scaleGroupObj["zone"].(string)
+		scaleGroup.Desired = scaleGroupObj["desired"].(int)
+		scaleGroup.Min = scaleGroupObj["min"].(int)
+		scaleGroup.Max = scaleGroupObj["max"].(int)
+		scaleGroup.OnDemand = scaleGroupObj["on_demand"].(int)
+		scaleGroup.Spot = scaleGroupObj["spot"].(int)
+		if scaleGroup.OnDemand == 0 && scaleGroup.Spot == 0 {
+			scaleGroup.OnDemand = scaleGroup.Desired
+		}
+	}
+	return scaleGroup
+}
+
+func getK8sConfig(d *schema.ResourceData) *nextgen.K8sBasedRoutingDataV2 {
+	attr, ok := d.GetOk("k8s_connector_id")
+	if !ok {
+		return nil
+	}
+
+	k8s := &nextgen.K8sBasedRoutingDataV2{
+		ConnectorId: attr.(string),
+		Namespace:   d.Get("namespace").(string),
+		Workloads:   []nextgen.K8sWorkload{},
+	}
+	for _, w := range d.Get("workload").([]interface{}) {
+		workload := w.(map[string]interface{})
+		k8s.Workloads = append(k8s.Workloads, nextgen.K8sWorkload{
+			Name:  workload["name"].(string),
+			Type_: workload["type"].(string),
+		})
+	}
+	if attr, ok := d.GetOk("ingress"); ok {
+		ingress := attr.([]interface{})[0].(map[string]interface{})
+		k8s.Ingress = &nextgen.K8sIngress{
+			Name:           ingress["name"].(string),
+			ControllerName: ingress["controller_name"].(string),
+		}
+	}
+	return k8s
+}
+
+func getHealthCheckConfig(health []interface{}) *nextgen.HealthCheck {
+	if len(health) == 0 || health[0] == nil {
+		return nil
+	}
+
+	healthCheck := &nextgen.HealthCheck{}
+	healthConfig := health[0].(map[string]interface{})
+	if attr, ok := healthConfig["protocol"]; ok {
+		healthCheck.Protocol = attr.(string)
+	}
+	if attr, ok := healthConfig["port"]; ok {
+		healthCheck.Port = attr.(int)
+	}
+	if attr, ok := healthConfig["path"]; ok {
+		healthCheck.Path = attr.(string)
+	}
+	if attr, ok := healthConfig["timeout"]; ok {
+		healthCheck.Timeout = attr.(int)
+	}
+	if attr, ok := healthConfig["status_code_from"]; ok {
+		healthCheck.StatusCodeFrom = attr.(int)
+	}
+	if attr, ok := healthConfig["status_code_to"]; ok {
+		healthCheck.StatusCodeTo = attr.(int)
+	}
+	return healthCheck
+}
+
 func getDependencies(d *schema.ResourceData) []nextgen.ServiceDep {
 	dependencies := d.Get("depends").([]interface{})
 	dependencyList := make([]nextgen.ServiceDep, 0)
@@ -275,26 +422,7 @@ func getRoutingConfigurations(d *schema.ResourceData) (*nextgen.HttpProxy, *next
 			httpProxy.Ports = portConfigsList
 		}
 		if attr, ok := httpRoutingObj["health"]; ok {
-			healthCheck = &nextgen.HealthCheck{}
-			healthConfig := attr.([]interface{})[0].(map[string]interface{})
-			if attr, ok := healthConfig["protocol"]; ok {
-				healthCheck.Protocol = attr.(string)
-			}
-			if attr, ok := healthConfig["port"]; ok {
-				healthCheck.Port = attr.(int)
-			}
-			if attr, ok := healthConfig["path"]; ok {
-				healthCheck.Path = attr.(string)
-			}
-			if attr, ok := healthConfig["timeout"]; ok {
-				healthCheck.Timeout = attr.(int)
-			}
-			if attr, ok := healthConfig["status_code_from"]; ok {
-				healthCheck.StatusCodeFrom = attr.(int)
-			}
-			if attr, ok := healthConfig["status_code_to"]; ok {
-				healthCheck.StatusCodeTo = attr.(int)
-			}
+			healthCheck = getHealthCheckConfig(attr.([]interface{}))
 		}
 	}
 
@@ -357,8 +485,230 @@ func getRoutingConfigurations(d *schema.ResourceData) (*nextgen.HttpProxy, *next
 	return httpProxy, tcpProxy, healthCheck
 }
 
-func readASRule(d *schema.ResourceData, id int64) {
-	identifier := strconv.Itoa(int(id))
+func readASRule(d *schema.ResourceData, service *nextgen.Service, deps []nextgen.ServiceDep) {
+	identifier := strconv.Itoa(int(service.Id))
 	d.SetId(identifier)
-	d.Set("identifier", identifier)
+	d.Set("identifier", float64(service.Id))
+	d.Set("name", service.Name)
+	d.Set("cloud_connector_id", service.CloudAccountId)
+	d.Set("idle_time_mins", service.IdleTimeMins)
+	d.Set("depends", flattenDependencies(deps))
+
+	routing := service.Routing
+	if routing == nil {
+		routing = &nextgen.RoutingDataV2{}
+	}
+
+	switch service.Kind {
+	case Instance:
+		d.Set("custom_domains", service.CustomDomains)
+		d.Set("http", flattenHttpRouting(routing.Http, service.HealthCheck, true))
+		d.Set("tcp", flattenTcpRouting(routing.Tcp, true))
+		if routing.Instance != nil && routing.Instance.ScaleGroup != nil {
+			d.Set("scale_group", flattenScaleGroup(routing.Instance.ScaleGroup))
+		} else {
+			d.Set("use_spot", service.Fulfilment == FulfilmentSpot)
+			d.Set("filter", flattenFilter(routing.Instance))
+		}
+	case Database:
+		d.Set("database", flattenDatabase(routing.Database))
+		d.Set("tcp", flattenTcpRouting(routing.Tcp, false))
+	case ECS:
+		d.Set("custom_domains", service.CustomDomains)
+		d.Set("container", flattenContainer(routing.ContainerSvc))
+		d.Set("http", flattenHttpRouting(routing.Http, nil, false))
+	case K8s:
+		d.Set("custom_domains", service.CustomDomains)
+		d.Set("health", flattenHealthCheck(service.HealthCheck))
+		if routing.K8s != nil {
+			d.Set("k8s_connector_id", routing.K8s.ConnectorId)
+			d.Set("namespace", routing.K8s.Namespace)
+			d.Set("workload", flattenK8sWorkloads(routing.K8s.Workloads))
+			d.Set("ingress", flattenK8sIngress(routing.K8s.Ingress))
+		}
+	}
+}
+
+func flattenDependencies(deps []nextgen.ServiceDep) []interface{} {
+	dependencies := make([]interface{}, 0)
+	for _, dep := range deps {
+		dependencies = append(dependencies, map[string]interface{}{
+			"rule_id":      int(dep.DepId),
+			"delay_in_sec": int(dep.DelaySecs),
+		})
+	}
+	return dependencies
+}
+
+func flattenFilter(instance *nextgen.InstanceBasedRoutingDataV2) []interface{} {
+	if instance == nil || instance.Filter == nil {
+		return nil
+	}
+	filter := instance.Filter
+
+	keys := make([]string, 0, len(filter.Tags))
+	for k := range filter.Tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	tags := make([]interface{}, 0, len(keys))
+	for _, k := range keys {
+		tags = append(tags, map[string]interface{}{
+			"key":   k,
+			"value": filter.Tags[k],
+		})
+	}
+
+	return []interface{}{map[string]interface{}{
+		"vm_ids":  filter.Ids,
+		"regions": filter.Regions,
+		"zones":   filter.Zones,
+		"tags":    tags,
+	}}
+}
+
+// flattenHttpRouting flattens the http routing of a rule. The port and health check configurations are only
+// part of the schema of the rules that take them.
+func flattenHttpRouting(httpProxy *nextgen.HttpProxy, healthCheck *nextgen.HealthCheck, withPorts bool) []interface{} {
+	if httpProxy == nil {
+		return nil
+	}
+
+	httpRouting := map[string]interface{}{}
+	if httpProxy.Proxy != nil {
+		httpRouting["proxy_id"] = httpProxy.Proxy.Id
+	}
+
+	if withPorts {
+		routing := make([]interface{}, 0)
+		for _, port := range httpProxy.Ports {
+			routing = append(routing, map[string]interface{}{
+				"source_protocol": port.Protocol,
+				"target_protocol": port.TargetProtocol,
+				"source_port":     port.Port,
+				"target_port":     port.TargetPort,
+				"action":          port.Action,
+			})
+		}
+		httpRouting["routing"] = routing
+
+		if healthCheck != nil {
+			httpRouting["health"] = flattenHealthCheck(healthCheck)
+		}
+	}
+
+	return []interface{}{httpRouting}
+}
+
+// flattenTcpRouting flattens the tcp routing of a rule. The ssh and rdp configurations are only part of the schema of
+// the rules that take them.
+func flattenTcpRouting(tcpProxy *nextgen.TcpProxy, withRemoteAccess bool) []interface{} {
+	if tcpProxy == nil {
+		return nil
+	}
+
+	tcp := map[string]interface{}{}
+	if tcpProxy.Proxy != nil {
+		tcp["proxy_id"] = tcpProxy.Proxy.Id
+	}
+
+	if withRemoteAccess {
+		if tcpProxy.SshConf != nil {
+			tcp["ssh"] = []interface{}{flattenTcpPort(*tcpProxy.SshConf)}
+		}
+		if tcpProxy.RdpConf != nil {
+			tcp["rdp"] = []interface{}{flattenTcpPort(*tcpProxy.RdpConf)}
+		}
+	}
+
+	forwardRules := make([]interface{}, 0)
+	for _, port := range tcpProxy.CustomPorts {
+		forwardRules = append(forwardRules, flattenTcpPort(port))
+	}
+	tcp["forward_rule"] = forwardRules
+
+	return []interface{}{tcp}
+}
+
+func flattenTcpPort(port nextgen.ServiceRoutingTcpPort) map[string]interface{} {
+	return map[string]interface{}{
+		"connect_on": port.Source,
+		"port":       port.Target,
+	}
+}
+
+func flattenDatabase(database *nextgen.RdsDatabase) []interface{} {
+	if database == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"id":     database.Id,
+		"region": database.Region,
+	}}
+}
+
+func flattenContainer(container *nextgen.ContainerSvc) []interface{} {
+	if container == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"cluster":    container.Cluster,
+		"region":     container.Region,
+		"service":    container.Service,
+		"task_count": int(container.TaskCount),
+	}}
+}
+
+func flattenHealthCheck(healthCheck *nextgen.HealthCheck) []interface{} {
+	if healthCheck == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"protocol":         healthCheck.Protocol,
+		"port":             healthCheck.Port,
+		"path":             healthCheck.Path,
+		"timeout":          healthCheck.Timeout,
+		"status_code_from": healthCheck.StatusCodeFrom,
+		"status_code_to":   healthCheck.StatusCodeTo,
+	}}
+}
+
+func flattenScaleGroup(scaleGroup *nextgen.AsgMinimal) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"id":        scaleGroup.Id,
+		"name":      scaleGroup.Name,
+		"region":    scaleGroup.Region,
+		"zone":      scaleGroup.Zone,
+		"desired":   scaleGroup.Desired,
+		"min":       scaleGroup.Min,
+		"max":       scaleGroup.Max,
+		"on_demand": scaleGroup.OnDemand,
+		"spot":      scaleGroup.Spot,
+	}}
+}
+
+func flattenK8sWorkloads(workloads []nextgen.K8sWorkload) []interface{} {
+	result := make([]interface{}, 0, len(workloads))
+	for _, workload := range workloads {
+		result = append(result, map[string]interface{}{
+			"name": workload.Name,
+			"type": workload.Type_,
+		})
+	}
+	return result
+}
+
+func flattenK8sIngress(ingress *nextgen.K8sIngress) []interface{} {
+	if ingress == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"name":            ingress.Name,
+		"controller_name": ingress.ControllerName,
+	}}
 }
@@ -0,0 +1,115 @@
+package as_rule
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/harness/terraform-provider-harness/helpers"
+	"github.com/harness/terraform-provider-harness/internal"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var ruleKinds = []string{Instance, Database, ECS, K8s}
+
+func DataSourceRuleList() *schema.Resource {
+	resource := &schema.Resource{
+		Description: "Data source for listing the AutoStopping rules of the account, for instance to import the rules created outside of Terraform.",
+
+		ReadContext: dataSourceRuleListRead,
+
+		Schema: map[string]*schema.Schema{
+			"cloud_connector_id": {
+				Description: "Filter the rules by the id of their cloud connector.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"kind": {
+				Description:  fmt.Sprintf("Filter the rules by the kind of resources they manage. Available values are %s.", strings.Join(ruleKinds, ", ")),
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(ruleKinds, false),
+			},
+			"rules": {
+				Description: "List of rules matching the filters.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identifier": {
+							Description: "Unique identifier of the rule.",
+							Type:        schema.TypeFloat,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the rule.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"kind": {
+							Description: "Kind of resources managed by the rule.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"cloud_connector_id": {
+							Description: "Id of the cloud connector.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"idle_time_mins": {
+							Description: "Idle time in minutes.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"custom_domains": {
+							Description: "Custom URLs used to access the resources.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return resource
+}
+
+func dataSourceRuleListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
+
+	list, httpResp, err := listASRules(ctx, c)
+	if err != nil {
+		return helpers.HandleApiError(err, d, httpResp)
+	}
+
+	cloudConnectorId := d.Get("cloud_connector_id").(string)
+	kind := d.Get("kind").(string)
+
+	rules := []interface{}{}
+	for _, rule := range list {
+		if cloudConnectorId != "" && rule.CloudAccountId != cloudConnectorId {
+			continue
+		}
+		if kind != "" && rule.Kind != kind {
+			continue
+		}
+
+		rules = append(rules, map[string]interface{}{
+			"identifier":         float64(rule.Id),
+			"name":               rule.Name,
+			"kind":               rule.Kind,
+			"cloud_connector_id": rule.CloudAccountId,
+			"idle_time_mins":     rule.IdleTimeMins,
+			"custom_domains":     rule.CustomDomains,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", c.AccountId, cloudConnectorId, kind))
+	d.Set("rules", rules)
+
+	return nil
+}
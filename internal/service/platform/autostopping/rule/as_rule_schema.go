@@ -0,0 +1,203 @@
+package as_rule
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// getHttpSchema returns the schema of the http routing of the rules accessed through a proxy.
+func getHttpSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Http routing configuration",
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"proxy_id": {
+					Description: "Id of the proxy",
+					Type:        schema.TypeString,
+					Required:    true,
+				},
+				"routing": {
+					Description: "Routing configuration used to access the instances",
+					Type:        schema.TypeList,
+					MinItems:    1,
+					Optional:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"source_protocol": {
+								Description: "Source protocol of the proxy can be http or https",
+								Type:        schema.TypeString,
+								Required:    true,
+							},
+							"target_protocol": {
+								Description: "Target protocol of the instance can be http or https",
+								Type:        schema.TypeString,
+								Required:    true,
+							},
+							"source_port": {
+								Description: "Port on the proxy",
+								Type:        schema.TypeInt,
+								Optional:    true,
+							},
+							"target_port": {
+								Description: "Port on the VM",
+								Type:        schema.TypeInt,
+								Optional:    true,
+							},
+							"action": {
+								Description: "Organization Identifier for the Entity",
+								Type:        schema.TypeString,
+								Optional:    true,
+							},
+						},
+					},
+				},
+				"health": getHealthCheckSchema(),
+			},
+		},
+	}
+}
+
+// getHealthCheckSchema returns the schema of the health check run against the resources of a rule.
+func getHealthCheckSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Health Check Details",
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"protocol": {
+					Description: "Protocol can be http or https",
+					Type:        schema.TypeString,
+					Required:    true,
+				},
+				"port": {
+					Description: "Health check port on the VM",
+					Type:        schema.TypeInt,
+					Required:    true,
+				},
+				"path": {
+					Description: "API path to use for health check",
+					Type:        schema.TypeString,
+					Optional:    true,
+				},
+				"timeout": {
+					Description: "Health check timeout",
+					Type:        schema.TypeInt,
+					Optional:    true,
+				},
+				"status_code_from": {
+					Description: "Lower limit for acceptable status code",
+					Type:        schema.TypeInt,
+					Optional:    true,
+				},
+				"status_code_to": {
+					Description: "Upper limit for acceptable status code",
+					Type:        schema.TypeInt,
+					Optional:    true,
+				},
+			},
+		},
+	}
+}
+
+// getTcpSchema returns the schema of the tcp routing of the rules accessed through a proxy.
+func getTcpSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "TCP routing configuration",
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"proxy_id": {
+					Description: "Id of the Proxy",
+					Type:        schema.TypeString,
+					Required:    true,
+				},
+				"ssh": {
+					Description: "SSH configuration",
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"connect_on": {
+								Description: "Port to listen on the proxy",
+								Type:        schema.TypeInt,
+								Optional:    true,
+							},
+							"port": {
+								Description: "Port to listen on the vm",
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Default:     22,
+							},
+						},
+					},
+				},
+				"rdp": {
+					Description: "RDP configuration",
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"connect_on": {
+								Description: "Port to listen on the proxy",
+								Type:        schema.TypeInt,
+								Optional:    true,
+							},
+							"port": {
+								Description: "Port to listen on the vm",
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Default:     3389,
+							},
+						},
+					},
+				},
+				"forward_rule": {
+					Description: "Additional tcp forwarding rules",
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"connect_on": {
+								Description: "Port to listen on the proxy",
+								Type:        schema.TypeInt,
+								Optional:    true,
+							},
+							"port": {
+								Description: "Port to listen on the vm",
+								Type:        schema.TypeInt,
+								Required:    true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// getDependsSchema returns the schema of the rules a rule depends on.
+func getDependsSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Dependent rules",
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"rule_id": {
+					Description: "Rule id of the dependent rule",
+					Type:        schema.TypeInt,
+					Required:    true,
+				},
+				"delay_in_sec": {
+					Description: "Number of seconds the rule should wait after warming up the dependent rule",
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Default:     5,
+				},
+			},
+		},
+	}
+}
@@ -6,9 +6,9 @@ import (
 
 func DataSourceVMRule() *schema.Resource {
 	resource := &schema.Resource{
-		Description: "Data source for retrieving a Harness Variable.",
+		Description: "Data source for retrieving an AutoStopping rule for VMs by name.",
 
-		ReadContext: resourceASRuleRead,
+		ReadContext: dataSourceASRuleRead(Instance),
 
 		Schema: map[string]*schema.Schema{
 			"identifier": {
@@ -48,7 +48,8 @@ func DataSourceVMRule() *schema.Resource {
 			},
 			"filter": {
 				Type:     schema.TypeList,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
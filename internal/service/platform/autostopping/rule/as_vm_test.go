@@ -26,6 +26,11 @@ func TestResourceVMRule(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "name", name),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
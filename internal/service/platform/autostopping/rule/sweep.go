@@ -0,0 +1,37 @@
+package as_rule
+
+import (
+	"fmt"
+
+	"github.com/harness/terraform-provider-harness/internal/sweep"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func init() {
+	resource.AddTestSweepers("harness_autostopping_rule", &resource.Sweeper{
+		Name: "harness_autostopping_rule",
+		F:    testSweepAutoStoppingRules,
+	})
+}
+
+func testSweepAutoStoppingRules(r string) error {
+	c, ctx := sweep.GetPlatformClientWithContext()
+
+	rules, _, err := listASRules(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	for _, rule := range rules {
+		// Only delete rules whose name starts with the sweep prefix
+		if !sweep.IsSweepable(rule.Name) {
+			continue
+		}
+
+		if _, err := c.CloudCostAutoStoppingRulesApi.DeleteAutoStoppingRule(ctx, float64(rule.Id), c.AccountId, c.AccountId); err != nil {
+			fmt.Printf("[ERROR] Failed to delete autostopping rule %s: %s", rule.Name, err)
+		}
+	}
+
+	return nil
+}
@@ -66,7 +66,7 @@ func ResourceVMRule() *schema.Resource {
 		UpdateContext: resourceScheduleUpdate,
 		DeleteContext: resourceScheduleDelete,
 		CreateContext: resourceScheduleCreate,
-		Importer:      helpers.MultiLevelResourceImporter,
+		Importer:      helpers.NumericIdResourceImporter,
 		Schema: map[string]*schema.Schema{
 			"identifier": {
 				Description: "Unique identifier of the schedule",
@@ -516,14 +516,14 @@ func setSchedule(d *schema.ResourceData, schedule *nextgen.FixedSchedule) diag.D
 	d.Set(scheduleTypeAttribute, scheduleType)
 	d.Set(timeZoneAttribute, schedule.Details.Timezone)
 	if schedDet.Period != nil {
-		startTime, err := time.Parse(dateTimeFormat, schedDet.Period.Start)
+		startTime, err := time.Parse(time.RFC3339, schedDet.Period.Start)
 		if err == nil {
-			d.Set(startingFromAttribute, startTime)
+			d.Set(startingFromAttribute, startTime.Format(dateTimeFormat))
 		}
 		if schedDet.Period.End != nil {
-			endTime, err := time.Parse(dateTimeFormat, *schedDet.Period.End)
+			endTime, err := time.Parse(time.RFC3339, *schedDet.Period.End)
 			if err == nil {
-				d.Set(endingOnAttribute, endTime)
+				d.Set(endingOnAttribute, endTime.Format(dateTimeFormat))
 			}
 		}
 	}
@@ -552,5 +552,16 @@ func setSchedule(d *schema.ResourceData, schedule *nextgen.FixedSchedule) diag.D
 		}
 		d.Set(repetitionAttribute, []interface{}{periodicity})
 	}
+	ruleIDs := []float64{}
+	for _, res := range schedule.Resources {
+		if res.Type_ != scheduleResTypeASrule {
+			continue
+		}
+		ruleID, err := strconv.ParseFloat(res.Id, 64)
+		if err == nil {
+			ruleIDs = append(ruleIDs, ruleID)
+		}
+	}
+	d.Set(rulesAttribute, ruleIDs)
 	return diags
 }
@@ -1,20 +1,23 @@
 package schedule
 
 import (
+	"context"
 	"fmt"
+	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func DataSourceFixedSchedule() *schema.Resource {
 	resource := &schema.Resource{
 		Description: "Data source for retrieving a fixed schedule for Harness AutoStopping rule",
-		ReadContext: resourceScheduleRead,
+		ReadContext: dataSourceScheduleRead,
 		Schema: map[string]*schema.Schema{
 			"identifier": {
 				Description: "Unique identifier of the schedule",
 				Type:        schema.TypeFloat,
-				Computed:    true,
+				Required:    true,
 			},
 			nameAttribute: {
 				Description: "Name of the schedule",
@@ -24,7 +27,8 @@ func DataSourceFixedSchedule() *schema.Resource {
 			scheduleTypeAttribute: {
 				Description: fmt.Sprintf("Type of the schedule. Valid values are `%s` and `%s`", uptimeSchedule, downtimeSchedule),
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 			},
 			timeZoneAttribute: {
 				Description: "Time zone in which schedule needs to be executed",
@@ -81,3 +85,21 @@ func DataSourceFixedSchedule() *schema.Resource {
 
 	return resource
 }
+
+// dataSourceScheduleRead reads the schedule with the identifier given in the configuration. When the configuration also
+// sets the type of the schedule, the schedule must be of that type.
+func dataSourceScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	expectedType := d.Get(scheduleTypeAttribute).(string)
+
+	d.SetId(strconv.Itoa(int(d.Get("identifier").(float64))))
+	diags := readSchedule(ctx, d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	if actualType := d.Get(scheduleTypeAttribute).(string); expectedType != "" && actualType != expectedType {
+		return diag.Errorf("schedule %s is a %s schedule, not a %s schedule", d.Id(), actualType, expectedType)
+	}
+
+	return diags
+}
@@ -70,12 +70,6 @@ func getReadConnectorOpts(d *schema.ResourceData) *nextgen.ConnectorsApiGetConne
 		connOpts.ProjectIdentifier = optional.NewString(attr.(string))
 	}
 
-	if attr, ok := d.GetOk("git_sync"); ok {
-		opts := attr.([]interface{})[0].(map[string]interface{})
-		connOpts.Branch = optional.NewString(opts["branch"].(string))
-		connOpts.RepoIdentifier = optional.NewString(opts["repo_id"].(string))
-	}
-
 	return connOpts
 }
 
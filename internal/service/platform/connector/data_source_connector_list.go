@@ -0,0 +1,21 @@
+package connector
+
+import (
+	"github.com/harness/terraform-provider-harness/helpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceConnectorList() *schema.Resource {
+	resource := &schema.Resource{
+		Description: "Data source for listing the Harness connectors of an account, organization or project. Every page of results is fetched.",
+
+		ReadContext: dataSourceConnectorReadList,
+
+		Schema: map[string]*schema.Schema{},
+	}
+
+	helpers.SetOptionalOrgAndProjectLevelDataSourceSchema(resource.Schema)
+	setConnectorListSchema(resource.Schema)
+
+	return resource
+}
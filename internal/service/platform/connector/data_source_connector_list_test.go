@@ -0,0 +1,56 @@
+package connector_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/harness/harness-go-sdk/harness/utils"
+	"github.com/harness/terraform-provider-harness/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceConnectorList(t *testing.T) {
+
+	var (
+		name         = fmt.Sprintf("%s_%s", t.Name(), utils.RandStringBytes(4))
+		resourceName = "data.harness_platform_connector_list.test"
+	)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.TestAccPreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceConnectorListConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "connectors.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "connectors.0.identifier", name),
+					resource.TestCheckResourceAttr(resourceName, "connectors.0.description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "connectors.0.type", "K8sCluster"),
+					resource.TestCheckResourceAttr(resourceName, "connectors.0.tags.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceConnectorListConfig(name string) string {
+	return fmt.Sprintf(`
+		resource "harness_platform_connector_kubernetes" "test" {
+			identifier = "%[1]s"
+			name = "%[1]s"
+			description = "test"
+			tags = ["foo:bar"]
+
+			inherit_from_delegate {
+				delegate_selectors = ["harness-delegate"]
+			}
+		}
+
+		data "harness_platform_connector_list" "test" {
+			search_term = harness_platform_connector_kubernetes.test.identifier
+			types = ["K8sCluster"]
+			filter_tags = ["foo:bar"]
+		}
+	`, name)
+}
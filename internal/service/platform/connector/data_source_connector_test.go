@@ -1,52 +1,99 @@
 package connector_test
 
-// import (
-// 	"fmt"
-// 	"testing"
-
-// 	"github.com/harness/harness-go-sdk/harness/nextgen"
-// 	"github.com/harness/harness-go-sdk/harness/utils"
-// 	"github.com/harness/terraform-provider-harness/internal/acctest"
-// 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-// )
-
-// func TestAccDataSourceConnector(t *testing.T) {
-
-// 	var (
-// 		name         = fmt.Sprintf("%s_%s", t.Name(), utils.RandStringBytes(4))
-// 		resourceName = "data.harness_platform_connector.test"
-// 	)
-
-// 	resource.UnitTest(t, resource.TestCase{
-// 		PreCheck:          func() { acctest.TestAccPreCheck(t) },
-// 		ProviderFactories: acctest.ProviderFactories,
-// 		Steps: []resource.TestStep{
-// 			{
-// 				Config: testAccDataSourceConnector(name),
-// 				Check: resource.ComposeTestCheckFunc(
-// 					resource.TestCheckResourceAttr(resourceName, "name", name),
-// 					resource.TestCheckResourceAttr(resourceName, "type", nextgen.ConnectorTypes.K8sCluster.String()),
-// 				),
-// 			},
-// 		},
-// 	})
-// }
-
-// func testAccDataSourceConnector(name string) string {
-// 	return fmt.Sprintf(`
-// 		resource "harness_platform_connector" "test" {
-// 			identifier = "%[1]s"
-// 			name = "%[1]s"
-
-// 			k8s_cluster {
-// 				inherit_from_delegate {
-// 					delegate_selectors = ["harness-delegate"]
-// 				}
-// 			}
-// 		}
-
-// 		data "harness_platform_connector" "test" {
-// 			identifier = harness_platform_connector.test.identifier
-// 		}
-// 	`, name)
-// }
+import (
+	"fmt"
+	"testing"
+
+	"github.com/harness/harness-go-sdk/harness/nextgen"
+	"github.com/harness/harness-go-sdk/harness/utils"
+	"github.com/harness/terraform-provider-harness/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceConnector(t *testing.T) {
+
+	var (
+		name         = fmt.Sprintf("%s_%s", t.Name(), utils.RandStringBytes(4))
+		resourceName = "data.harness_platform_connector.test"
+	)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.TestAccPreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceConnector(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", name),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "type", nextgen.ConnectorTypes.K8sCluster.String()),
+					resource.TestCheckResourceAttrSet(resourceName, "spec"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceConnector_list(t *testing.T) {
+
+	var (
+		name         = fmt.Sprintf("%s_%s", t.Name(), utils.RandStringBytes(4))
+		resourceName = "data.harness_platform_connector.test"
+	)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.TestAccPreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceConnectorList(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "connectors.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "connectors.0.identifier", name),
+					resource.TestCheckResourceAttr(resourceName, "connectors.0.type", nextgen.ConnectorTypes.K8sCluster.String()),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceConnector(name string) string {
+	return fmt.Sprintf(`
+		resource "harness_platform_connector_kubernetes" "test" {
+			identifier = "%[1]s"
+			name = "%[1]s"
+			description = "test"
+			tags = ["foo:bar"]
+
+			inherit_from_delegate {
+				delegate_selectors = ["harness-delegate"]
+			}
+		}
+
+		data "harness_platform_connector" "test" {
+			identifier = harness_platform_connector_kubernetes.test.identifier
+		}
+	`, name)
+}
+
+func testAccDataSourceConnectorList(name string) string {
+	return fmt.Sprintf(`
+		resource "harness_platform_connector_kubernetes" "test" {
+			identifier = "%[1]s"
+			name = "%[1]s"
+			description = "test"
+			tags = ["foo:bar"]
+
+			inherit_from_delegate {
+				delegate_selectors = ["harness-delegate"]
+			}
+		}
+
+		data "harness_platform_connector" "test" {
+			search_term = harness_platform_connector_kubernetes.test.identifier
+			types = ["K8sCluster"]
+		}
+	`, name)
+}
@@ -0,0 +1,113 @@
+package connector
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/antihax/optional"
+	openapi_client_nextgen "github.com/harness/harness-openapi-go-client/nextgen"
+	"github.com/harness/terraform-provider-harness/internal/sweep"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func init() {
+	resource.AddTestSweepers("harness_platform_connector", &resource.Sweeper{
+		Name: "harness_platform_connector",
+		F:    testSweepConnectors,
+		Dependencies: []string{
+			"harness_platform_pipeline",
+			"harness_platform_template",
+			"harness_platform_service",
+			"harness_platform_environment",
+			"harness_platform_gitops_agent",
+		},
+	})
+}
+
+func testSweepConnectors(r string) error {
+	c, ctx := sweep.GetClientWithContext()
+
+	scopes, err := sweep.ListScopes()
+	if err != nil {
+		return err
+	}
+
+	for _, scope := range scopes {
+		for page := int32(0); ; page++ {
+			connectors, err := listConnectors(ctx, c, scope, page)
+			if err != nil {
+				return err
+			}
+
+			for _, connector := range connectors {
+				if connector.Connector == nil || connector.HarnessManaged {
+					continue
+				}
+
+				// Only delete connectors whose identifier or name starts with the sweep prefix
+				if !sweep.IsSweepable(connector.Connector.Slug, connector.Connector.Name) {
+					continue
+				}
+
+				if err := deleteConnector(ctx, c, scope, connector.Connector.Slug); err != nil {
+					fmt.Printf("[ERROR] Failed to delete connector %s: %s", connector.Connector.Slug, err)
+				}
+			}
+
+			if len(connectors) < sweep.PageSize {
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+func listConnectors(ctx context.Context, c *openapi_client_nextgen.APIClient, scope sweep.Scope, page int32) ([]openapi_client_nextgen.ConnectorResponse, error) {
+	var resp []openapi_client_nextgen.ConnectorResponse
+	var err error
+
+	switch {
+	case scope.Project != "":
+		resp, _, err = c.ProjectConnectorApi.GetProjectScopedConnectors(ctx, scope.Org, scope.Project, &openapi_client_nextgen.ProjectConnectorApiGetProjectScopedConnectorsOpts{
+			HarnessAccount: optional.NewString(c.AccountId),
+			Page:           optional.NewInt32(page),
+			Limit:          optional.NewInt32(sweep.PageSize),
+		})
+	case scope.Org != "":
+		resp, _, err = c.OrgConnectorApi.GetOrgScopedConnectors(ctx, scope.Org, &openapi_client_nextgen.OrgConnectorApiGetOrgScopedConnectorsOpts{
+			HarnessAccount: optional.NewString(c.AccountId),
+			Page:           optional.NewInt32(page),
+			Limit:          optional.NewInt32(sweep.PageSize),
+		})
+	default:
+		resp, _, err = c.AccountConnectorApi.GetAccountScopedConnectors(ctx, &openapi_client_nextgen.AccountConnectorApiGetAccountScopedConnectorsOpts{
+			HarnessAccount: optional.NewString(c.AccountId),
+			Page:           optional.NewInt32(page),
+			Limit:          optional.NewInt32(sweep.PageSize),
+		})
+	}
+
+	return resp, err
+}
+
+func deleteConnector(ctx context.Context, c *openapi_client_nextgen.APIClient, scope sweep.Scope, id string) error {
+	var err error
+
+	switch {
+	case scope.Project != "":
+		_, err = c.ProjectConnectorApi.DeleteProjectScopedConnector(ctx, scope.Org, scope.Project, id, &openapi_client_nextgen.ProjectConnectorApiDeleteProjectScopedConnectorOpts{
+			HarnessAccount: optional.NewString(c.AccountId),
+		})
+	case scope.Org != "":
+		_, err = c.OrgConnectorApi.DeleteOrgScopedConnector(ctx, scope.Org, id, &openapi_client_nextgen.OrgConnectorApiDeleteOrgScopedConnectorOpts{
+			HarnessAccount: optional.NewString(c.AccountId),
+		})
+	default:
+		_, err = c.AccountConnectorApi.DeleteAccountScopedConnector(ctx, id, &openapi_client_nextgen.AccountConnectorApiDeleteAccountScopedConnectorOpts{
+			HarnessAccount: optional.NewString(c.AccountId),
+		})
+	}
+
+	return err
+}
@@ -52,11 +52,12 @@ func ResourceDelegateToken() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{"ACTIVE", "REVOKED"}, false),
 			},
 			"value": {
-				Description: "Value of the delegate token. Encoded in base64.",
+				Description: "Value of the delegate token. Encoded in base64. Empty when `value_secret` is set.",
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
 			},
+			"value_secret": helpers.GetValueSecretSchema("value"),
 			"created_at": {
 				Description: "Time when the delegate token is created. This is an epoch timestamp.",
 				Type:        schema.TypeInt,
@@ -105,7 +106,7 @@ func resourceDelegateTokenCreateOrUpdate(ctx context.Context, d *schema.Resource
 
 	delegateToken := buildDelegateToken(d)
 
-	if delegateToken.Value == "" {
+	if delegateToken.Value == "" && d.Id() == "" {
 		resp, httpResp, err = c.DelegateTokenResourceApi.CreateDelegateToken(ctx, c.AccountId, delegateToken.Name, &nextgen.DelegateTokenResourceApiCreateDelegateTokenOpts{
 			OrgIdentifier:     helpers.BuildField(d, "org_id"),
 			ProjectIdentifier: helpers.BuildField(d, "project_id"),
@@ -120,6 +121,12 @@ func resourceDelegateTokenCreateOrUpdate(ctx context.Context, d *schema.Resource
 
 	readDelegateToken(d, resp.Resource)
 
+	if helpers.HasValueSecret(d) {
+		if httpResp, err = helpers.StoreValueInSecret(ctx, c, d, resp.Resource.Value); err != nil {
+			return helpers.HandleApiError(err, d, httpResp)
+		}
+	}
+
 	return nil
 }
 
@@ -141,6 +148,10 @@ func resourceDelegateTokenDelete(ctx context.Context, d *schema.ResourceData, me
 		return helpers.HandleApiError(err, d, httpResp)
 	}
 
+	if httpResp, err = helpers.DeleteValueSecret(ctx, c, d); err != nil {
+		return helpers.HandleApiError(err, d, httpResp)
+	}
+
 	readDelegateToken(d, resp.Resource)
 
 	return nil
@@ -180,7 +191,9 @@ func readDelegateToken(d *schema.ResourceData, delegateTokenDetails *nextgen.Del
 	d.Set("token_status", delegateTokenDetails.Status)
 	d.Set("created_at", delegateTokenDetails.CreatedAt)
 	d.Set("created_by", readCreatedByData(delegateTokenDetails.CreatedByNgUser.Type_, delegateTokenDetails.CreatedByNgUser.Name, delegateTokenDetails.CreatedByNgUser.Jwtclaims))
-	d.Set("value", delegateTokenDetails.Value)
+	if !helpers.HasValueSecret(d) {
+		d.Set("value", delegateTokenDetails.Value)
+	}
 }
 
 func readCreatedByData(userType string, name_ string, details map[string]string) map[string]string {
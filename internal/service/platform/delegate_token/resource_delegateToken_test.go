@@ -82,6 +82,31 @@ func TestAccResourceDelegateTokenProjectLevel(t *testing.T) {
 	})
 }
 
+func TestAccResourceDelegateToken_valueSecret(t *testing.T) {
+	name := utils.RandStringBytes(5)
+	account_id := os.Getenv("HARNESS_ACCOUNT_ID")
+	secretId := fmt.Sprintf("%s_value", name)
+
+	resourceName := "harness_platform_delegatetoken.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.TestAccPreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testDelegateTokenDestroy(resourceName),
+		Steps: []resource.TestStep{
+			{
+				Config: tesAccResourceDelegateTokenValueSecret(name, account_id, secretId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "value", ""),
+					resource.TestCheckResourceAttr(resourceName, "value_secret.0.identifier", secretId),
+					resource.TestCheckResourceAttr(resourceName, "value_secret.0.secret_ref", "account."+secretId),
+				),
+			},
+		},
+	})
+}
+
 func tesAccResourceDelegateToken(name string, accountId string) string {
 	return fmt.Sprintf(`
 		resource "harness_platform_delegatetoken" "test" {			
@@ -91,6 +116,19 @@ func tesAccResourceDelegateToken(name string, accountId string) string {
 		`, name, accountId)
 }
 
+func tesAccResourceDelegateTokenValueSecret(name string, accountId string, secretId string) string {
+	return fmt.Sprintf(`
+	resource "harness_platform_delegatetoken" "test" {
+		name = "%[1]s"
+		account_id = "%[2]s"
+
+		value_secret {
+			identifier = "%[3]s"
+		}
+	}
+	`, name, accountId, secretId)
+}
+
 func tesAccResourceDelegateTokenOrgLevel(name string, accountId string, org_id string) string {
 	return fmt.Sprintf(`
 		resource "harness_platform_organization" "test" {
@@ -2,34 +2,83 @@ package environment
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strings"
 
+	"github.com/antihax/optional"
 	"github.com/harness/harness-go-sdk/harness/nextgen"
 	"github.com/harness/terraform-provider-harness/helpers"
 	"github.com/harness/terraform-provider-harness/internal"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func DataSourceEnvironmentList() *schema.Resource {
 	resource := &schema.Resource{
-		Description: "Data source for retrieving a Harness environment List.",
+		Description: "Data source for retrieving a Harness environment List. Every page of results is fetched.",
 
 		ReadContext: dataSourceEnvironmentListRead,
 
 		Schema: map[string]*schema.Schema{
+			"type": {
+				Description:  fmt.Sprintf("Filter the environments by type. Available values are %s.", strings.Join(nextgen.EnvironmentTypeValues, ", ")),
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(nextgen.EnvironmentTypeValues, false),
+			},
 			"environments": {
-				Type:     schema.TypeList,
-				Computed: true,
+				Description: "List of environments matching the filters.",
+				Type:        schema.TypeList,
+				Computed:    true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"identifier": {
-							Type:     schema.TypeString,
-							Computed: true,
+							Description: "Unique identifier of the environment.",
+							Type:        schema.TypeString,
+							Computed:    true,
 						},
 						"name": {
-							Type:     schema.TypeString,
-							Computed: true,
+							Description: "Name of the environment.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "Description of the environment.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"org_id": {
+							Description: "Unique identifier of the organization.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"project_id": {
+							Description: "Unique identifier of the project.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"tags": {
+							Description: "Tags associated with the environment.",
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"type": {
+							Description: "The type of environment.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"color": {
+							Description: "Color of the environment.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"yaml": {
+							Description: "Environment YAML.",
+							Type:        schema.TypeString,
+							Computed:    true,
 						},
 					},
 				},
@@ -38,6 +87,7 @@ func DataSourceEnvironmentList() *schema.Resource {
 	}
 
 	helpers.SetOptionalOrgAndProjectLevelDataSourceSchema(resource.Schema)
+	helpers.SetListDataSourceFilterSchema(resource.Schema)
 
 	return resource
 }
@@ -45,37 +95,57 @@ func DataSourceEnvironmentList() *schema.Resource {
 func dataSourceEnvironmentListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
 
-	var err error
-	var httpResp *http.Response
-
-	var resp nextgen.ResponseDtoPageResponseEnvironmentResponse
-	resp, httpResp, err = c.EnvironmentsApi.GetEnvironmentList(ctx, c.AccountId, &nextgen.EnvironmentsApiGetEnvironmentListOpts{
+	opts := &nextgen.EnvironmentsApiGetEnvironmentListOpts{
 		OrgIdentifier:     helpers.BuildField(d, "org_id"),
 		ProjectIdentifier: helpers.BuildField(d, "project_id"),
-	})
+		SearchTerm:        helpers.BuildField(d, "search_term"),
+		Size:              optional.NewInt32(helpers.ListPageSize),
+	}
+	envType := d.Get("type").(string)
+
+	environments := []interface{}{}
+	httpResp, err := helpers.ListAllPages(func(page int32) (bool, *http.Response, error) {
+		opts.Page = optional.NewInt32(page)
 
-	var output = resp.Data.Content
-	var environments []map[string]interface{}
-	for _, v := range output {
-		newEnvironment := map[string]interface{}{
-			"identifier": v.Environment.Identifier,
-			"name":       v.Environment.Name,
+		resp, httpResp, err := c.EnvironmentsApi.GetEnvironmentList(ctx, c.AccountId, opts)
+		if err != nil {
+			return false, httpResp, err
 		}
 
-		environments = append(environments, newEnvironment)
-	}
+		if resp.Data == nil {
+			return false, httpResp, nil
+		}
 
+		for _, v := range resp.Data.Content {
+			env := v.Environment
+			if env == nil || !helpers.MatchesFilterTags(d, env.Tags) {
+				continue
+			}
+
+			if envType != "" && env.Type_.String() != envType {
+				continue
+			}
+
+			environments = append(environments, map[string]interface{}{
+				"identifier":  env.Identifier,
+				"name":        env.Name,
+				"description": env.Description,
+				"org_id":      env.OrgIdentifier,
+				"project_id":  env.ProjectIdentifier,
+				"tags":        helpers.FlattenTags(env.Tags),
+				"type":        env.Type_.String(),
+				"color":       env.Color,
+				"yaml":        env.Yaml,
+			})
+		}
+
+		return helpers.HasMorePages(page, resp.Data.TotalPages), httpResp, nil
+	})
 	if err != nil {
 		return helpers.HandleApiError(err, d, httpResp)
 	}
 
-	if environments == nil {
-		d.SetId("")
-		d.MarkNewResource()
-		return nil
-	}
-
-	d.SetId(resp.CorrelationId)
+	d.SetId(helpers.ListDataSourceId(c.AccountId, d, "org_id", "project_id", "type", "search_term", "filter_tags"))
 	d.Set("environments", environments)
 
 	return nil
@@ -22,6 +22,7 @@ func TestAccDataSourceEnvironmentList(t *testing.T) {
 			{
 				Config: testAccDataSourceEnvironmentList(id, name),
 				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "environments.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "environments.0.identifier", id),
 					resource.TestCheckResourceAttr(resourceName, "environments.0.name", name),
 				),
@@ -43,6 +44,7 @@ func TestAccDataSourceEnvironmentListAtOrgLevel(t *testing.T) {
 			{
 				Config: testAccDataSourceEnvironmentListOrgLevel(id, name),
 				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "environments.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "environments.0.identifier", id),
 					resource.TestCheckResourceAttr(resourceName, "environments.0.name", name),
 				),
@@ -51,6 +53,69 @@ func TestAccDataSourceEnvironmentListAtOrgLevel(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceEnvironmentList_filters(t *testing.T) {
+
+	id := fmt.Sprintf("%s_%s", t.Name(), utils.RandStringBytes(6))
+	name := id
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.TestAccPreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceEnvironmentListFilters(id, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.harness_platform_environment_list.pre_production", "environments.#", "1"),
+					resource.TestCheckResourceAttr("data.harness_platform_environment_list.pre_production", "environments.0.identifier", id),
+					resource.TestCheckResourceAttr("data.harness_platform_environment_list.pre_production", "environments.0.type", "PreProduction"),
+					resource.TestCheckResourceAttr("data.harness_platform_environment_list.pre_production", "environments.0.color", "#0063F7"),
+					resource.TestCheckResourceAttr("data.harness_platform_environment_list.production", "environments.#", "0"),
+					resource.TestCheckResourceAttr("data.harness_platform_environment_list.tagged", "environments.#", "1"),
+					resource.TestCheckResourceAttr("data.harness_platform_environment_list.untagged", "environments.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceEnvironmentListFilters(id string, name string) string {
+	return fmt.Sprintf(`
+		resource "harness_platform_organization" "test" {
+			identifier = "%[1]s"
+			name = "%[2]s"
+		}
+
+		resource "harness_platform_environment" "test" {
+			identifier = "%[1]s"
+			org_id = harness_platform_organization.test.id
+			name = "%[2]s"
+			color = "#0063F7"
+			type = "PreProduction"
+			tags = ["foo:bar"]
+		}
+
+		data "harness_platform_environment_list" "pre_production" {
+			org_id = harness_platform_environment.test.org_id
+			type = "PreProduction"
+		}
+
+		data "harness_platform_environment_list" "production" {
+			org_id = harness_platform_environment.test.org_id
+			type = "Production"
+		}
+
+		data "harness_platform_environment_list" "tagged" {
+			org_id = harness_platform_environment.test.org_id
+			filter_tags = ["foo:bar"]
+		}
+
+		data "harness_platform_environment_list" "untagged" {
+			org_id = harness_platform_environment.test.org_id
+			filter_tags = ["foo:baz"]
+		}
+`, id, name)
+}
+
 func testAccDataSourceEnvironmentList(id string, name string) string {
 	return fmt.Sprintf(`
 		resource "harness_platform_organization" "test" {
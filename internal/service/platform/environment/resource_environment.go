@@ -23,6 +23,7 @@ func ResourceEnvironment() *schema.Resource {
 		UpdateContext: resourceEnvironmentCreateOrUpdate,
 		DeleteContext: resourceEnvironmentDelete,
 		CreateContext: resourceEnvironmentCreateOrUpdate,
+		CustomizeDiff: helpers.ValidateYamlDiff("environment", "yaml", helpers.YamlScopeArguments),
 		Importer:      helpers.MultiLevelResourceImporter,
 
 		Schema: map[string]*schema.Schema{
@@ -54,6 +55,7 @@ func ResourceEnvironment() *schema.Resource {
 	}
 
 	helpers.SetMultiLevelResourceSchema(resource.Schema)
+	helpers.SetGitExperienceSchema(resource.Schema)
 
 	return resource
 }
@@ -61,20 +63,28 @@ func ResourceEnvironment() *schema.Resource {
 func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
 
-	resp, httpResp, err := c.EnvironmentsApi.GetEnvironmentV2(ctx, d.Id(), c.AccountId, &nextgen.EnvironmentsApiGetEnvironmentV2Opts{
-		OrgIdentifier:     helpers.BuildField(d, "org_id"),
-		ProjectIdentifier: helpers.BuildField(d, "project_id"),
-	})
-
+	resp, httpResp, err := getEnvironment(ctx, c, d, d.Id())
 	if err != nil {
 		return helpers.HandleReadApiError(err, d, httpResp)
 	}
 
 	readEnvironment(d, resp.Data.Environment)
+	readEnvironmentGitDetails(d, resp.Data.Environment)
 
 	return nil
 }
 
+func getEnvironment(ctx context.Context, c *nextgen.APIClient, d *schema.ResourceData, id string) (nextgen.ResponseDtoEnvironmentResponse, *http.Response, error) {
+	git := helpers.BuildGitReadOptions(d)
+
+	return c.EnvironmentsApi.GetEnvironmentV2(ctx, id, c.AccountId, &nextgen.EnvironmentsApiGetEnvironmentV2Opts{
+		OrgIdentifier:     helpers.BuildField(d, "org_id"),
+		ProjectIdentifier: helpers.BuildField(d, "project_id"),
+		Branch:            git.BranchName,
+		RepoName:          git.RepoName,
+	})
+}
+
 func resourceEnvironmentCreateOrUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
 
@@ -84,13 +94,48 @@ func resourceEnvironmentCreateOrUpdate(ctx context.Context, d *schema.ResourceDa
 	id := d.Id()
 	env := buildEnvironment(d)
 
-	if id == "" {
+	git := helpers.BuildGitOptions(d)
+
+	if id == "" && helpers.IsImportFromGit(d) {
+		gitImport := helpers.BuildGitImportOptions(d)
+		_, httpResp, err = c.EnvironmentsApi.ImportEnvironment(ctx, c.AccountId, &nextgen.EnvironmentsApiImportEnvironmentOpts{
+			OrgIdentifier:         helpers.BuildField(d, "org_id"),
+			ProjectIdentifier:     helpers.BuildField(d, "project_id"),
+			EnvironmentIdentifier: optional.NewString(env.Identifier),
+			ConnectorRef:          gitImport.ConnectorRef,
+			RepoName:              gitImport.RepoName,
+			Branch:                gitImport.BranchName,
+			FilePath:              gitImport.FilePath,
+			IsForceImport:         gitImport.IsForceImport,
+		})
+		if err == nil {
+			// The import only returns the identifier of the environment.
+			resp, httpResp, err = getEnvironment(ctx, c, d, env.Identifier)
+		}
+	} else if id == "" {
 		resp, httpResp, err = c.EnvironmentsApi.CreateEnvironmentV2(ctx, c.AccountId, &nextgen.EnvironmentsApiCreateEnvironmentV2Opts{
-			Body: optional.NewInterface(env),
+			Body:         optional.NewInterface(env),
+			StoreType:    git.StoreType,
+			ConnectorRef: git.ConnectorRef,
+			RepoName:     git.RepoName,
+			FilePath:     git.FilePath,
+			BranchName:   git.BranchName,
+			BaseBranch:   git.BaseBranch,
+			IsNewBranch:  git.IsNewBranch,
+			CommitMsg:    git.CommitMessage,
 		})
 	} else {
 		resp, httpResp, err = c.EnvironmentsApi.UpdateEnvironmentV2(ctx, c.AccountId, &nextgen.EnvironmentsApiUpdateEnvironmentV2Opts{
-			Body: optional.NewInterface(env),
+			Body:         optional.NewInterface(env),
+			ConnectorRef: git.ConnectorRef,
+			RepoName:     git.RepoName,
+			FilePath:     git.FilePath,
+			BranchName:   git.BranchName,
+			BaseBranch:   git.BaseBranch,
+			IsNewBranch:  git.IsNewBranch,
+			CommitMsg:    git.CommitMessage,
+			LastObjectId: git.LastObjectId,
+			LastCommitId: git.LastCommitId,
 		})
 	}
 
@@ -99,6 +144,7 @@ func resourceEnvironmentCreateOrUpdate(ctx context.Context, d *schema.ResourceDa
 	}
 
 	readEnvironment(d, resp.Data.Environment)
+	readEnvironmentGitDetails(d, resp.Data.Environment)
 
 	return nil
 }
@@ -147,3 +193,19 @@ func readEnvironment(d *schema.ResourceData, env *nextgen.EnvironmentResponseDet
 		d.Set("yaml", env.Yaml)
 	}
 }
+
+func readEnvironmentGitDetails(d *schema.ResourceData, env *nextgen.EnvironmentResponseDetails) {
+	if env.EntityGitDetails == nil {
+		return
+	}
+
+	helpers.SetGitDetails(d, &helpers.GitDetails{
+		StoreType:    env.StoreType,
+		ConnectorRef: env.ConnectorRef,
+		BranchName:   env.EntityGitDetails.BranchName,
+		FilePath:     env.EntityGitDetails.FilePath,
+		RepoName:     env.EntityGitDetails.RepoName,
+		LastCommitId: env.EntityGitDetails.CommitId,
+		LastObjectId: env.EntityGitDetails.ObjectId,
+	})
+}
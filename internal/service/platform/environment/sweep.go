@@ -0,0 +1,60 @@
+package environment
+
+import (
+	"fmt"
+
+	"github.com/antihax/optional"
+	openapi_client_nextgen "github.com/harness/harness-openapi-go-client/nextgen"
+	"github.com/harness/terraform-provider-harness/internal/sweep"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func init() {
+	resource.AddTestSweepers("harness_platform_environment", &resource.Sweeper{
+		Name:         "harness_platform_environment",
+		F:            testSweepEnvironments,
+		Dependencies: []string{"harness_platform_pipeline"},
+	})
+}
+
+func testSweepEnvironments(r string) error {
+	c, ctx := sweep.GetClientWithContext()
+
+	projects, err := sweep.ListProjectScopes()
+	if err != nil {
+		return err
+	}
+
+	for _, project := range projects {
+		for page := int32(0); ; page++ {
+			environments, _, err := c.ProjectEnvironmentsApi.GetEnvironments(ctx, project.Org, project.Project, &openapi_client_nextgen.ProjectEnvironmentsApiGetEnvironmentsOpts{
+				HarnessAccount: optional.NewString(c.AccountId),
+				Page:           optional.NewInt32(page),
+				Limit:          optional.NewInt32(sweep.PageSize),
+			})
+			if err != nil {
+				return err
+			}
+
+			for _, env := range environments {
+				// Only delete environments whose identifier or name starts with the sweep prefix
+				if env.Environment == nil || !sweep.IsSweepable(env.Environment.Slug, env.Environment.Name) {
+					continue
+				}
+
+				_, err := c.ProjectEnvironmentsApi.DeleteEnvironment(ctx, project.Org, project.Project, env.Environment.Slug, &openapi_client_nextgen.ProjectEnvironmentsApiDeleteEnvironmentOpts{
+					HarnessAccount: optional.NewString(c.AccountId),
+				})
+				if err != nil {
+					fmt.Printf("[ERROR] Failed to delete environment %s: %s", env.Environment.Slug, err)
+				}
+			}
+
+			if len(environments) < sweep.PageSize {
+				break
+			}
+		}
+	}
+
+	return nil
+}
@@ -20,7 +20,11 @@ func ResourceEnvironmentServiceOverrides() *schema.Resource {
 		UpdateContext: resourceEnvironmentServiceOverridesCreateOrUpdate,
 		DeleteContext: resourceEnvironmentServiceOverridesDelete,
 		CreateContext: resourceEnvironmentServiceOverridesCreateOrUpdate,
-		Importer:      helpers.ServiceOverrideResourceImporter,
+		CustomizeDiff: helpers.ValidateYamlDiff("service_overrides", "yaml", map[string]string{
+			"environmentRef": "env_id",
+			"serviceRef":     "service_id",
+		}),
+		Importer: helpers.ServiceOverrideResourceImporter,
 
 		Schema: map[string]*schema.Schema{
 			"identifier": {
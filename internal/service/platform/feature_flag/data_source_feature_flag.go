@@ -0,0 +1,336 @@
+package feature_flag
+
+import (
+	"context"
+	"strings"
+
+	"github.com/antihax/optional"
+	"github.com/harness/harness-go-sdk/harness/nextgen"
+	"github.com/harness/terraform-provider-harness/helpers"
+	"github.com/harness/terraform-provider-harness/internal"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceFeatureFlag() *schema.Resource {
+	resource := &schema.Resource{
+		Description: "Data source for retrieving a Feature Flag and, optionally, its state in an environment.",
+
+		ReadContext: dataSourceFeatureFlagRead,
+
+		Schema: map[string]*schema.Schema{
+			"identifier": {
+				Description: "Identifier of the Feature Flag",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"org_id": {
+				Description: "Organization Identifier",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"project_id": {
+				Description: "Project Identifier",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"environment_id": {
+				Description: "Identifier of the environment to read the state of the flag in.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"name": {
+				Description: "Name of the Feature Flag",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"description": {
+				Description: "Description of the Feature Flag",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"kind": {
+				Description: "The type of data the flag represents.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"owner": {
+				Description: "The owner of the flag",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"permanent": {
+				Description: "Whether or not the flag is permanent.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"default_on_variation": {
+				Description: "Which of the variations new environments serve when the flag is on.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"default_off_variation": {
+				Description: "Which of the variations new environments serve when the flag is off.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"variation": {
+				Description: "The variations of the flag.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identifier": {
+							Description: "The identifier of the variation",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The user friendly name of the variation",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "The description of the variation",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"value": {
+							Description: "The value of the variation",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"prerequisite": {
+				Description: "Flags that must serve one of the given variations for this flag to be evaluated.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"flag_id": {
+							Description: "Identifier of the prerequisite flag.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"variations": {
+							Description: "Identifiers of the variations of the prerequisite flag.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"environment": {
+				Description: "State of the flag in the environment given by `environment_id`.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"state": {
+							Description: "State of the flag in the environment.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"default_on_variation": {
+							Description: "Variation served to the targets that match no rule when the flag is on, unless it is rolled out by percentage.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"default_on_distribution": {
+							Description: "Percentage rollout served to the targets that match no rule when the flag is on.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        getDistributionDataSourceSchema(),
+						},
+						"default_off_variation": {
+							Description: "Variation served to all the targets when the flag is off.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"target": {
+							Description: "Variations served to specific targets and target groups.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"variation": {
+										Description: "Identifier of the variation.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"targets": {
+										Description: "Identifiers of the targets served the variation.",
+										Type:        schema.TypeList,
+										Computed:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+									"target_groups": {
+										Description: "Identifiers of the target groups served the variation.",
+										Type:        schema.TypeList,
+										Computed:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"rule": {
+							Description: "Serving rules of the flag, in the order they are evaluated.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Description: "Identifier of the rule.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"clause": {
+										Description: "Conditions a target must all match for the rule to apply.",
+										Type:        schema.TypeList,
+										Computed:    true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"attribute": {
+													Description: "Attribute of the target compared against the values.",
+													Type:        schema.TypeString,
+													Computed:    true,
+												},
+												"op": {
+													Description: "Operator of the comparison.",
+													Type:        schema.TypeString,
+													Computed:    true,
+												},
+												"values": {
+													Description: "Values the attribute is compared against.",
+													Type:        schema.TypeList,
+													Computed:    true,
+													Elem:        &schema.Schema{Type: schema.TypeString},
+												},
+												"negate": {
+													Description: "Whether the comparison is negated.",
+													Type:        schema.TypeBool,
+													Computed:    true,
+												},
+											},
+										},
+									},
+									"serve_variation": {
+										Description: "Variation served to the targets that match the rule.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"serve_distribution": {
+										Description: "Percentage rollout served to the targets that match the rule.",
+										Type:        schema.TypeList,
+										Computed:    true,
+										Elem:        getDistributionDataSourceSchema(),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return resource
+}
+
+func getDistributionDataSourceSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"bucket_by": {
+				Description: "Attribute of the targets used to bucket them.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"variation": {
+				Description: "Variations of the rollout.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"variation": {
+							Description: "Identifier of the variation.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"weight": {
+							Description: "Percentage of the targets served the variation.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceFeatureFlagRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
+
+	id := d.Get("identifier").(string)
+	opts := &nextgen.FeatureFlagsApiGetFeatureFlagOpts{
+		EnvironmentIdentifier: optional.EmptyString(),
+	}
+	if env := d.Get("environment_id").(string); env != "" {
+		opts.EnvironmentIdentifier = optional.NewString(env)
+	}
+
+	flag, httpResp, err := c.FeatureFlagsApi.GetFeatureFlag(ctx, id, c.AccountId, d.Get("org_id").(string), d.Get("project_id").(string), opts)
+	if err != nil {
+		return helpers.HandleApiError(err, d, httpResp)
+	}
+
+	d.SetId(flag.Identifier)
+	d.Set("name", flag.Name)
+	d.Set("description", flag.Description)
+	d.Set("kind", flag.Kind)
+	d.Set("owner", strings.Join(flag.Owner, ","))
+	d.Set("permanent", flag.Permanent)
+	d.Set("default_on_variation", flag.DefaultOnVariation)
+	d.Set("default_off_variation", flag.DefaultOffVariation)
+	d.Set("variation", expandVariations(flag.Variations))
+	d.Set("prerequisite", flattenPrerequisites(flag.Prerequisites))
+	d.Set("environment", flattenFeatureFlagEnvProperties(flag.EnvProperties))
+
+	return nil
+}
+
+func flattenFeatureFlagEnvProperties(env *nextgen.FeatureEnvProperties) []interface{} {
+	if env == nil || env.Environment == "" {
+		return nil
+	}
+
+	properties := map[string]interface{}{
+		"state":                 string(env.State),
+		"default_off_variation": env.OffVariation,
+		"rule":                  flattenServingRules(env.Rules),
+	}
+	if env.DefaultServe != nil && env.DefaultServe.Distribution != nil {
+		properties["default_on_distribution"] = flattenServeDistribution(env.DefaultServe.Distribution)
+	} else if env.DefaultServe != nil {
+		properties["default_on_variation"] = env.DefaultServe.Variation
+	}
+
+	var targets []interface{}
+	for _, variationMap := range env.VariationMap {
+		var identifiers []string
+		for _, t := range variationMap.Targets {
+			identifiers = append(identifiers, t.Identifier)
+		}
+		targets = append(targets, map[string]interface{}{
+			"variation":     variationMap.Variation,
+			"targets":       identifiers,
+			"target_groups": variationMap.TargetSegments,
+		})
+	}
+	properties["target"] = targets
+
+	return []interface{}{properties}
+}
@@ -0,0 +1,145 @@
+package feature_flag
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/antihax/optional"
+	"github.com/harness/harness-go-sdk/harness/nextgen"
+	"github.com/harness/terraform-provider-harness/helpers"
+	"github.com/harness/terraform-provider-harness/internal"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceFeatureFlagEvaluation() *schema.Resource {
+	resource := &schema.Resource{
+		Description: "Data source for previewing the variation of a Feature Flag served to a target in an environment. " +
+			"The flag is evaluated like the Feature Flags SDKs do, from its current state, target groups and prerequisites.",
+
+		ReadContext: dataSourceFeatureFlagEvaluationRead,
+
+		Schema: map[string]*schema.Schema{
+			"flag_id": {
+				Description: "Identifier of the Feature Flag.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"org_id": {
+				Description: "Organization Identifier",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"project_id": {
+				Description: "Project Identifier",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"environment_id": {
+				Description: "Identifier of the environment to evaluate the flag in.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"target_id": {
+				Description: "Identifier of the target to evaluate the flag for. The target doesn't need to exist.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"target_name": {
+				Description: "Name of the target.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"target_attributes": {
+				Description: "Attributes of the target.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"variation": {
+				Description: "Identifier of the variation served to the target.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"value": {
+				Description: "Value of the variation served to the target.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"reason": {
+				Description: fmt.Sprintf("Why the variation is served. One of `%s` when the flag is off, `%s` when a prerequisite isn't met, `%s` or `%s` when the target or one of its target groups is served a specific variation, `%s` when a serving rule matches and `%s` otherwise.",
+					EvaluationReasonOff, EvaluationReasonPrerequisite, EvaluationReasonTarget, EvaluationReasonTargetGroup, EvaluationReasonRule, EvaluationReasonDefault),
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rule_id": {
+				Description: "Identifier of the serving rule that matched, when the reason is `rule`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+
+	return resource
+}
+
+func dataSourceFeatureFlagEvaluationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
+
+	orgId := d.Get("org_id").(string)
+	projectId := d.Get("project_id").(string)
+	envId := d.Get("environment_id").(string)
+
+	getFlag := func(identifier string) (*nextgen.Feature, error) {
+		flag, _, err := c.FeatureFlagsApi.GetFeatureFlag(ctx, identifier, c.AccountId, orgId, projectId, &nextgen.FeatureFlagsApiGetFeatureFlagOpts{
+			EnvironmentIdentifier: optional.NewString(envId),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &flag, nil
+	}
+
+	getSegment := func(identifier string) (*nextgen.Segment, error) {
+		segment, _, err := c.TargetGroupsApi.GetSegment(ctx, c.AccountId, orgId, identifier, projectId, envId)
+		if err != nil {
+			return nil, err
+		}
+		return &segment, nil
+	}
+
+	flagId := d.Get("flag_id").(string)
+	flag, httpResp, err := c.FeatureFlagsApi.GetFeatureFlag(ctx, flagId, c.AccountId, orgId, projectId, &nextgen.FeatureFlagsApiGetFeatureFlagOpts{
+		EnvironmentIdentifier: optional.NewString(envId),
+	})
+	if err != nil {
+		return helpers.HandleApiError(err, d, httpResp)
+	}
+
+	target := &featureFlagTarget{
+		Identifier: d.Get("target_id").(string),
+		Name:       d.Get("target_name").(string),
+		Attributes: map[string]string{},
+	}
+	for k, v := range d.Get("target_attributes").(map[string]interface{}) {
+		target.Attributes[k] = v.(string)
+	}
+
+	evaluation, err := newFeatureFlagEvaluator(getFlag, getSegment).evaluate(&flag, target)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", flagId, envId, target.Identifier))
+	d.Set("variation", evaluation.Variation)
+	d.Set("reason", evaluation.Reason)
+	d.Set("rule_id", evaluation.RuleId)
+	d.Set("value", "")
+	for _, v := range flag.Variations {
+		if v.Identifier == evaluation.Variation {
+			d.Set("value", v.Value)
+		}
+	}
+
+	return nil
+}
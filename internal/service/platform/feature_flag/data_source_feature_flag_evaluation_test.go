@@ -0,0 +1,69 @@
+package feature_flag_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/harness/harness-go-sdk/harness/utils"
+	"github.com/harness/terraform-provider-harness/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceFeatureFlagEvaluation(t *testing.T) {
+
+	name := t.Name()
+	id := fmt.Sprintf("%s_%s", name, utils.RandStringBytes(5))
+	ruleName := "data.harness_platform_feature_flag_evaluation.rule"
+	defaultName := "data.harness_platform_feature_flag_evaluation.default"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.TestAccPreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceFeatureFlagEvaluation(id, name, "on"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(ruleName, "variation", "Enabled"),
+					resource.TestCheckResourceAttr(ruleName, "value", "true"),
+					resource.TestCheckResourceAttr(ruleName, "reason", "rule"),
+					resource.TestCheckResourceAttrSet(ruleName, "rule_id"),
+					// target1 falls in the 75th bucket, outside of the 20% rollout of Enabled.
+					resource.TestCheckResourceAttr(defaultName, "variation", "Disabled"),
+					resource.TestCheckResourceAttr(defaultName, "reason", "default"),
+				),
+			},
+			{
+				Config: testAccDataSourceFeatureFlagEvaluation(id, name, "off"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(ruleName, "variation", "Disabled"),
+					resource.TestCheckResourceAttr(ruleName, "reason", "off"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceFeatureFlagEvaluation(id string, name string, state string) string {
+	return fmt.Sprintf(`
+		%s
+
+		data "harness_platform_feature_flag_evaluation" "rule" {
+			flag_id = harness_platform_feature_flag_environment.test.flag_id
+			org_id = harness_platform_feature_flag_environment.test.org_id
+			project_id = harness_platform_feature_flag_environment.test.project_id
+			environment_id = harness_platform_feature_flag_environment.test.environment_id
+			target_id = "jane"
+			target_attributes = {
+				email = "jane@example.com"
+			}
+		}
+
+		data "harness_platform_feature_flag_evaluation" "default" {
+			flag_id = harness_platform_feature_flag_environment.test.flag_id
+			org_id = harness_platform_feature_flag_environment.test.org_id
+			project_id = harness_platform_feature_flag_environment.test.project_id
+			environment_id = harness_platform_feature_flag_environment.test.environment_id
+			target_id = "target1"
+		}
+`, testAccResourceFeatureFlagEnvironment(id, name, state))
+}
@@ -0,0 +1,49 @@
+package feature_flag_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/harness/harness-go-sdk/harness/utils"
+	"github.com/harness/terraform-provider-harness/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceFeatureFlag(t *testing.T) {
+
+	name := t.Name()
+	id := fmt.Sprintf("%s_%s", name, utils.RandStringBytes(5))
+	resourceName := "data.harness_platform_feature_flag.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.TestAccPreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceFeatureFlag(id, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "identifier", id),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "kind", "boolean"),
+					resource.TestCheckResourceAttr(resourceName, "variation.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "environment.0.state", "on"),
+					resource.TestCheckResourceAttr(resourceName, "environment.0.default_off_variation", "Disabled"),
+					resource.TestCheckResourceAttr(resourceName, "environment.0.rule.0.serve_variation", "Enabled"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceFeatureFlag(id string, name string) string {
+	return fmt.Sprintf(`
+		%s
+
+		data "harness_platform_feature_flag" "test" {
+			identifier = harness_platform_feature_flag_environment.test.flag_id
+			org_id = harness_platform_feature_flag_environment.test.org_id
+			project_id = harness_platform_feature_flag_environment.test.project_id
+			environment_id = harness_platform_feature_flag_environment.test.environment_id
+		}
+`, testAccResourceFeatureFlagEnvironment(id, name, "on"))
+}
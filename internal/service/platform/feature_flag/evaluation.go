@@ -0,0 +1,352 @@
+package feature_flag
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/harness/harness-go-sdk/harness/nextgen"
+)
+
+// Reasons for the variation served by a flag, see featureFlagEvaluator.evaluate.
+const (
+	EvaluationReasonOff          = "off"
+	EvaluationReasonPrerequisite = "prerequisite"
+	EvaluationReasonTarget       = "target"
+	EvaluationReasonTargetGroup  = "target_group"
+	EvaluationReasonRule         = "rule"
+	EvaluationReasonDefault      = "default"
+)
+
+// Operators of the clauses of serving rules and target groups.
+const (
+	ClauseOpSegmentMatch   = "segmentMatch"
+	ClauseOpIn             = "in"
+	ClauseOpEqual          = "equal"
+	ClauseOpEqualSensitive = "equal_sensitive"
+	ClauseOpStartsWith     = "starts_with"
+	ClauseOpEndsWith       = "ends_with"
+	ClauseOpContains       = "contains"
+	ClauseOpMatch          = "match"
+)
+
+// maxPrerequisiteDepth bounds the chain of prerequisites followed when evaluating a flag.
+const maxPrerequisiteDepth = 10
+
+// featureFlagTarget is the target a flag is evaluated for.
+type featureFlagTarget struct {
+	Identifier string
+	Name       string
+	Attributes map[string]string
+}
+
+// featureFlagEvaluation is the outcome of evaluating a flag for a target.
+type featureFlagEvaluation struct {
+	Variation string
+	Reason    string
+	RuleId    string
+}
+
+// featureFlagEvaluator evaluates flags the way the Feature Flags SDKs do. Flags referenced as prerequisites and
+// target groups referenced by the flags are looked up on demand and cached for the duration of the evaluation.
+type featureFlagEvaluator struct {
+	getFlag    func(identifier string) (*nextgen.Feature, error)
+	getSegment func(identifier string) (*nextgen.Segment, error)
+
+	flags    map[string]*nextgen.Feature
+	segments map[string]*nextgen.Segment
+}
+
+func newFeatureFlagEvaluator(getFlag func(string) (*nextgen.Feature, error), getSegment func(string) (*nextgen.Segment, error)) *featureFlagEvaluator {
+	return &featureFlagEvaluator{
+		getFlag:    getFlag,
+		getSegment: getSegment,
+		flags:      map[string]*nextgen.Feature{},
+		segments:   map[string]*nextgen.Segment{},
+	}
+}
+
+// evaluate returns the variation the flag serves to the target. The flag serves, in order:
+//   - its off variation when it is off or when one of its prerequisites doesn't serve one of the expected variations,
+//   - the variation the target, or one of the target groups it belongs to, is explicitly assigned,
+//   - the variation of the first serving rule, by priority, whose clauses all match the target,
+//   - its default on variation or rollout.
+func (e *featureFlagEvaluator) evaluate(flag *nextgen.Feature, target *featureFlagTarget) (*featureFlagEvaluation, error) {
+	return e.evaluateWithDepth(flag, target, 0)
+}
+
+func (e *featureFlagEvaluator) evaluateWithDepth(flag *nextgen.Feature, target *featureFlagTarget, depth int) (*featureFlagEvaluation, error) {
+	env := flag.EnvProperties
+	if env == nil {
+		return nil, fmt.Errorf("flag %s has no state in the environment", flag.Identifier)
+	}
+
+	if env.State != FeatureStateOn {
+		return &featureFlagEvaluation{Variation: env.OffVariation, Reason: EvaluationReasonOff}, nil
+	}
+
+	for _, prerequisite := range flag.Prerequisites {
+		if depth >= maxPrerequisiteDepth {
+			return nil, fmt.Errorf("prerequisites of flag %s are nested more than %d levels deep", flag.Identifier, maxPrerequisiteDepth)
+		}
+
+		parent, err := e.flag(prerequisite.Feature)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := e.evaluateWithDepth(parent, target, depth+1)
+		if err != nil {
+			return nil, err
+		}
+
+		if !containsString(prerequisite.Variations, result.Variation) {
+			return &featureFlagEvaluation{Variation: env.OffVariation, Reason: EvaluationReasonPrerequisite}, nil
+		}
+	}
+
+	for _, variationMap := range env.VariationMap {
+		for _, t := range variationMap.Targets {
+			if t.Identifier == target.Identifier {
+				return &featureFlagEvaluation{Variation: variationMap.Variation, Reason: EvaluationReasonTarget}, nil
+			}
+		}
+	}
+
+	for _, variationMap := range env.VariationMap {
+		included, err := e.isTargetInSegments(variationMap.TargetSegments, target)
+		if err != nil {
+			return nil, err
+		}
+		if included {
+			return &featureFlagEvaluation{Variation: variationMap.Variation, Reason: EvaluationReasonTargetGroup}, nil
+		}
+	}
+
+	for _, rule := range sortServingRules(env.Rules) {
+		matched, err := e.matchClauses(rule.Clauses, target)
+		if err != nil {
+			return nil, err
+		}
+		if matched && rule.Serve != nil {
+			return &featureFlagEvaluation{
+				Variation: serveVariation(rule.Serve, target),
+				Reason:    EvaluationReasonRule,
+				RuleId:    rule.RuleId,
+			}, nil
+		}
+	}
+
+	variation := flag.DefaultOnVariation
+	if env.DefaultServe != nil {
+		variation = serveVariation(env.DefaultServe, target)
+	}
+	return &featureFlagEvaluation{Variation: variation, Reason: EvaluationReasonDefault}, nil
+}
+
+// matchClauses returns whether the target matches all the clauses of a serving rule.
+func (e *featureFlagEvaluator) matchClauses(clauses []nextgen.Clause, target *featureFlagTarget) (bool, error) {
+	if len(clauses) == 0 {
+		return false, nil
+	}
+
+	for _, clause := range clauses {
+		matched, err := e.matchClause(clause, target)
+		if err != nil || !matched {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func (e *featureFlagEvaluator) matchClause(clause nextgen.Clause, target *featureFlagTarget) (bool, error) {
+	if clause.Op == ClauseOpSegmentMatch {
+		included, err := e.isTargetInSegments(clause.Values, target)
+		return included != clause.Negate, err
+	}
+
+	return matchAttributeClause(clause, target) != clause.Negate, nil
+}
+
+// isTargetInSegments returns whether the target belongs to one of the target groups. A target belongs to a group
+// unless it is excluded from it, when it is included in it or when it matches any of the rules of the group.
+func (e *featureFlagEvaluator) isTargetInSegments(identifiers []string, target *featureFlagTarget) (bool, error) {
+	for _, identifier := range identifiers {
+		segment, err := e.segment(identifier)
+		if err != nil {
+			return false, err
+		}
+
+		if containsTarget(segment.Excluded, target.Identifier) {
+			continue
+		}
+
+		if containsTarget(segment.Included, target.Identifier) {
+			return true, nil
+		}
+
+		for _, clause := range segment.Rules {
+			if matchAttributeClause(clause, target) != clause.Negate {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func (e *featureFlagEvaluator) flag(identifier string) (*nextgen.Feature, error) {
+	if flag, ok := e.flags[identifier]; ok {
+		return flag, nil
+	}
+
+	flag, err := e.getFlag(identifier)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read prerequisite flag %s: %w", identifier, err)
+	}
+	e.flags[identifier] = flag
+	return flag, nil
+}
+
+func (e *featureFlagEvaluator) segment(identifier string) (*nextgen.Segment, error) {
+	if segment, ok := e.segments[identifier]; ok {
+		return segment, nil
+	}
+
+	segment, err := e.getSegment(identifier)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read target group %s: %w", identifier, err)
+	}
+	e.segments[identifier] = segment
+	return segment, nil
+}
+
+// matchAttributeClause returns whether the attribute of the target named by the clause matches its values.
+func matchAttributeClause(clause nextgen.Clause, target *featureFlagTarget) bool {
+	value := target.attribute(clause.Attribute)
+	if value == "" || len(clause.Values) == 0 {
+		return false
+	}
+
+	switch clause.Op {
+	case ClauseOpIn:
+		return containsString(clause.Values, value)
+	case ClauseOpEqual:
+		return strings.EqualFold(value, clause.Values[0])
+	case ClauseOpEqualSensitive:
+		return value == clause.Values[0]
+	case ClauseOpStartsWith:
+		return strings.HasPrefix(value, clause.Values[0])
+	case ClauseOpEndsWith:
+		return strings.HasSuffix(value, clause.Values[0])
+	case ClauseOpContains:
+		return strings.Contains(value, clause.Values[0])
+	case ClauseOpMatch:
+		matched, err := regexp.MatchString(clause.Values[0], value)
+		return err == nil && matched
+	default:
+		return false
+	}
+}
+
+// serveVariation returns the variation served to the target, bucketing the target when the variations are rolled
+// out by percentage.
+func serveVariation(serve *nextgen.Serve, target *featureFlagTarget) string {
+	if serve.Distribution == nil {
+		return serve.Variation
+	}
+
+	bucketBy := serve.Distribution.BucketBy
+	value := target.attribute(bucketBy)
+	if value == "" {
+		bucketBy = "identifier"
+		value = target.Identifier
+	}
+	bucket := int(murmur3Hash32([]byte(bucketBy+":"+value), 0)%100) + 1
+
+	variation := ""
+	total := 0
+	for _, v := range serve.Distribution.Variations {
+		variation = v.Variation
+		total += int(v.Weight)
+		if total > 0 && bucket <= total {
+			return v.Variation
+		}
+	}
+	return variation
+}
+
+// attribute returns the value of an attribute of the target. identifier and name refer to the target itself.
+func (t *featureFlagTarget) attribute(name string) string {
+	switch name {
+	case "identifier":
+		return t.Identifier
+	case "name":
+		return t.Name
+	default:
+		return t.Attributes[name]
+	}
+}
+
+func containsTarget(targets []nextgen.Target, identifier string) bool {
+	for _, t := range targets {
+		if t.Identifier == identifier {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// murmur3Hash32 is the 32-bit MurmurHash3 the Feature Flags SDKs use to bucket targets in percentage rollouts.
+func murmur3Hash32(data []byte, seed uint32) uint32 {
+	const (
+		c1 = 0xcc9e2d51
+		c2 = 0x1b873593
+	)
+
+	h := seed
+	n := len(data) / 4
+	for i := 0; i < n; i++ {
+		k := uint32(data[i*4]) | uint32(data[i*4+1])<<8 | uint32(data[i*4+2])<<16 | uint32(data[i*4+3])<<24
+		k *= c1
+		k = k<<15 | k>>17
+		k *= c2
+
+		h ^= k
+		h = h<<13 | h>>19
+		h = h*5 + 0xe6546b64
+	}
+
+	var k uint32
+	tail := data[n*4:]
+	switch len(tail) {
+	case 3:
+		k ^= uint32(tail[2]) << 16
+		fallthrough
+	case 2:
+		k ^= uint32(tail[1]) << 8
+		fallthrough
+	case 1:
+		k ^= uint32(tail[0])
+		k *= c1
+		k = k<<15 | k>>17
+		k *= c2
+		h ^= k
+	}
+
+	h ^= uint32(len(data))
+	h ^= h >> 16
+	h *= 0x85ebca6b
+	h ^= h >> 13
+	h *= 0xc2b2ae35
+	h ^= h >> 16
+	return h
+}
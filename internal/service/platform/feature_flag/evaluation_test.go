@@ -0,0 +1,323 @@
+package feature_flag
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/harness/harness-go-sdk/harness/nextgen"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMurmur3Hash32(t *testing.T) {
+	cases := []struct {
+		data string
+		seed uint32
+		hash uint32
+	}{
+		{"", 0, 0},
+		{"", 1, 0x514e28b7},
+		{"", 0xffffffff, 0x81f16f39},
+		{"\x00\x00\x00\x00", 0, 0x2362f9de},
+		{"a", 0x9747b28c, 0x7fa09ea6},
+		{"aa", 0x9747b28c, 0x5d211726},
+		{"aaa", 0x9747b28c, 0x283e0130},
+		{"aaaa", 0x9747b28c, 0x5a97808a},
+		{"Hello, world!", 0x9747b28c, 0x24884cba},
+		{"The quick brown fox jumps over the lazy dog", 0x9747b28c, 0x2fa826cd},
+		{"hello", 0, 613153351},
+	}
+
+	for _, c := range cases {
+		require.Equal(t, c.hash, murmur3Hash32([]byte(c.data), c.seed), "murmur3(%q, %#x)", c.data, c.seed)
+	}
+}
+
+func TestServeVariation(t *testing.T) {
+	rollout := func(bucketBy string, weights ...int32) *nextgen.Serve {
+		distribution := &nextgen.Distribution{BucketBy: bucketBy}
+		for i, w := range weights {
+			distribution.Variations = append(distribution.Variations, nextgen.WeightedVariation{Variation: fmt.Sprintf("v%d", i+1), Weight: w})
+		}
+		return &nextgen.Serve{Distribution: distribution}
+	}
+
+	cases := []struct {
+		name      string
+		serve     *nextgen.Serve
+		target    featureFlagTarget
+		variation string
+	}{
+		{"fixed variation", &nextgen.Serve{Variation: "v1"}, featureFlagTarget{Identifier: "target1"}, "v1"},
+		{"first bucket", rollout("identifier", 50, 50), featureFlagTarget{Identifier: "target103"}, "v1"},
+		{"last bucket of the first variation", rollout("identifier", 50, 50), featureFlagTarget{Identifier: "target31"}, "v1"},
+		{"first bucket of the second variation", rollout("identifier", 50, 50), featureFlagTarget{Identifier: "target112"}, "v2"},
+		{"last bucket", rollout("identifier", 50, 50), featureFlagTarget{Identifier: "target233"}, "v2"},
+		{"uneven weights lower boundary", rollout("identifier", 30, 70), featureFlagTarget{Identifier: "target36"}, "v1"},
+		{"uneven weights upper boundary", rollout("identifier", 30, 70), featureFlagTarget{Identifier: "target128"}, "v2"},
+		{"zero weight is skipped", rollout("identifier", 0, 100), featureFlagTarget{Identifier: "target103"}, "v2"},
+		{"weights below 100 serve the last variation", rollout("identifier", 10, 10), featureFlagTarget{Identifier: "target233"}, "v2"},
+		{"bucket by attribute", rollout("country", 66, 34), featureFlagTarget{Identifier: "target233", Attributes: map[string]string{"country": "fr"}}, "v1"},
+		{"bucket by attribute upper bucket", rollout("country", 66, 34), featureFlagTarget{Identifier: "target103", Attributes: map[string]string{"country": "de"}}, "v2"},
+		{"missing attribute buckets by identifier", rollout("country", 50, 50), featureFlagTarget{Identifier: "target112"}, "v2"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			require.Equal(t, c.variation, serveVariation(c.serve, &c.target))
+		})
+	}
+}
+
+func TestMatchAttributeClause(t *testing.T) {
+	target := &featureFlagTarget{Identifier: "alice", Name: "Alice", Attributes: map[string]string{"email": "alice@example.com"}}
+
+	cases := []struct {
+		op      string
+		attr    string
+		values  []string
+		matched bool
+	}{
+		{ClauseOpIn, "identifier", []string{"bob", "alice"}, true},
+		{ClauseOpIn, "identifier", []string{"bob"}, false},
+		{ClauseOpEqual, "name", []string{"alice"}, true},
+		{ClauseOpEqualSensitive, "name", []string{"alice"}, false},
+		{ClauseOpEqualSensitive, "name", []string{"Alice"}, true},
+		{ClauseOpStartsWith, "email", []string{"alice@"}, true},
+		{ClauseOpEndsWith, "email", []string{"@example.com"}, true},
+		{ClauseOpEndsWith, "email", []string{"@example.org"}, false},
+		{ClauseOpContains, "email", []string{"example"}, true},
+		{ClauseOpMatch, "email", []string{"^[a-z]+@example\\.com$"}, true},
+		{ClauseOpMatch, "email", []string{"("}, false},
+		{ClauseOpIn, "country", []string{""}, false},
+		{ClauseOpIn, "identifier", nil, false},
+		{"unknown", "identifier", []string{"alice"}, false},
+	}
+
+	for _, c := range cases {
+		clause := nextgen.Clause{Op: c.op, Attribute: c.attr, Values: c.values}
+		require.Equal(t, c.matched, matchAttributeClause(clause, target), "%s %s %v", c.op, c.attr, c.values)
+	}
+}
+
+func TestFeatureFlagEvaluate(t *testing.T) {
+	segments := map[string]*nextgen.Segment{
+		"beta": {
+			Identifier: "beta",
+			Included:   []nextgen.Target{{Identifier: "bob"}},
+			Excluded:   []nextgen.Target{{Identifier: "carol"}},
+			Rules:      []nextgen.Clause{{Op: ClauseOpEndsWith, Attribute: "email", Values: []string{"@example.com"}}},
+		},
+	}
+
+	// newFlag returns a flag that is on and serves `default` unless the case changes it.
+	newFlag := func(identifier string, change func(*nextgen.Feature)) *nextgen.Feature {
+		flag := &nextgen.Feature{
+			Identifier:         identifier,
+			DefaultOnVariation: "default",
+			EnvProperties: &nextgen.FeatureEnvProperties{
+				State:        FeatureStateOn,
+				OffVariation: "off",
+			},
+		}
+		if change != nil {
+			change(flag)
+		}
+		return flag
+	}
+
+	parentOn := newFlag("parent_on", func(f *nextgen.Feature) { f.DefaultOnVariation = "true" })
+	parentOff := newFlag("parent_off", func(f *nextgen.Feature) { f.EnvProperties.State = FeatureStateOff })
+	flags := map[string]*nextgen.Feature{
+		parentOn.Identifier:  parentOn,
+		parentOff.Identifier: parentOff,
+		"loop": newFlag("loop", func(f *nextgen.Feature) {
+			f.Prerequisites = []nextgen.Prerequisite{{Feature: "loop", Variations: []string{"default"}}}
+		}),
+	}
+
+	targetMap := []nextgen.VariationMap{{Variation: "targeted", Targets: []nextgen.TargetMap{{Identifier: "alice"}}}}
+	groupMap := []nextgen.VariationMap{{Variation: "grouped", TargetSegments: []string{"beta"}}}
+	rules := []nextgen.ServingRule{
+		{RuleId: "second", Priority: 2, Clauses: []nextgen.Clause{{Op: ClauseOpIn, Attribute: "identifier", Values: []string{"alice", "dave"}}}, Serve: &nextgen.Serve{Variation: "rule2"}},
+		{RuleId: "first", Priority: 1, Clauses: []nextgen.Clause{{Op: ClauseOpIn, Attribute: "identifier", Values: []string{"alice"}}}, Serve: &nextgen.Serve{Variation: "rule1"}},
+	}
+
+	cases := []struct {
+		name      string
+		flag      *nextgen.Feature
+		target    featureFlagTarget
+		variation string
+		reason    string
+		ruleId    string
+	}{
+		{
+			name: "off flag serves the off variation before anything else",
+			flag: newFlag("flag", func(f *nextgen.Feature) {
+				f.EnvProperties.State = FeatureStateOff
+				f.EnvProperties.VariationMap = targetMap
+				f.EnvProperties.Rules = rules
+			}),
+			target:    featureFlagTarget{Identifier: "alice"},
+			variation: "off",
+			reason:    EvaluationReasonOff,
+		},
+		{
+			name: "unmet prerequisite serves the off variation before the target map",
+			flag: newFlag("flag", func(f *nextgen.Feature) {
+				f.Prerequisites = []nextgen.Prerequisite{{Feature: "parent_off", Variations: []string{"true"}}}
+				f.EnvProperties.VariationMap = targetMap
+			}),
+			target:    featureFlagTarget{Identifier: "alice"},
+			variation: "off",
+			reason:    EvaluationReasonPrerequisite,
+		},
+		{
+			name: "met prerequisite continues with the target map",
+			flag: newFlag("flag", func(f *nextgen.Feature) {
+				f.Prerequisites = []nextgen.Prerequisite{{Feature: "parent_on", Variations: []string{"true"}}}
+				f.EnvProperties.VariationMap = targetMap
+			}),
+			target:    featureFlagTarget{Identifier: "alice"},
+			variation: "targeted",
+			reason:    EvaluationReasonTarget,
+		},
+		{
+			name: "target map before target groups and rules",
+			flag: newFlag("flag", func(f *nextgen.Feature) {
+				f.EnvProperties.VariationMap = append(append([]nextgen.VariationMap{}, groupMap...), targetMap...)
+				f.EnvProperties.Rules = rules
+			}),
+			target:    featureFlagTarget{Identifier: "alice", Attributes: map[string]string{"email": "alice@example.com"}},
+			variation: "targeted",
+			reason:    EvaluationReasonTarget,
+		},
+		{
+			name: "included target group before rules",
+			flag: newFlag("flag", func(f *nextgen.Feature) {
+				f.EnvProperties.VariationMap = groupMap
+				f.EnvProperties.Rules = rules
+			}),
+			target:    featureFlagTarget{Identifier: "bob"},
+			variation: "grouped",
+			reason:    EvaluationReasonTargetGroup,
+		},
+		{
+			name:      "target group rule",
+			flag:      newFlag("flag", func(f *nextgen.Feature) { f.EnvProperties.VariationMap = groupMap }),
+			target:    featureFlagTarget{Identifier: "erin", Attributes: map[string]string{"email": "erin@example.com"}},
+			variation: "grouped",
+			reason:    EvaluationReasonTargetGroup,
+		},
+		{
+			name:      "target excluded from the group",
+			flag:      newFlag("flag", func(f *nextgen.Feature) { f.EnvProperties.VariationMap = groupMap }),
+			target:    featureFlagTarget{Identifier: "carol", Attributes: map[string]string{"email": "carol@example.com"}},
+			variation: "default",
+			reason:    EvaluationReasonDefault,
+		},
+		{
+			name:      "rules by priority",
+			flag:      newFlag("flag", func(f *nextgen.Feature) { f.EnvProperties.Rules = rules }),
+			target:    featureFlagTarget{Identifier: "alice"},
+			variation: "rule1",
+			reason:    EvaluationReasonRule,
+			ruleId:    "first",
+		},
+		{
+			name:      "next rule when the first doesn't match",
+			flag:      newFlag("flag", func(f *nextgen.Feature) { f.EnvProperties.Rules = rules }),
+			target:    featureFlagTarget{Identifier: "dave"},
+			variation: "rule2",
+			reason:    EvaluationReasonRule,
+			ruleId:    "second",
+		},
+		{
+			name: "rule matching all clauses",
+			flag: newFlag("flag", func(f *nextgen.Feature) {
+				f.EnvProperties.Rules = []nextgen.ServingRule{{
+					RuleId: "all",
+					Clauses: []nextgen.Clause{
+						{Op: ClauseOpIn, Attribute: "identifier", Values: []string{"dave"}},
+						{Op: ClauseOpSegmentMatch, Values: []string{"beta"}, Negate: true},
+					},
+					Serve: &nextgen.Serve{Variation: "rule"},
+				}}
+			}),
+			target:    featureFlagTarget{Identifier: "dave"},
+			variation: "rule",
+			reason:    EvaluationReasonRule,
+			ruleId:    "all",
+		},
+		{
+			name: "rule not matching one of its clauses",
+			flag: newFlag("flag", func(f *nextgen.Feature) {
+				f.EnvProperties.Rules = []nextgen.ServingRule{{
+					RuleId: "all",
+					Clauses: []nextgen.Clause{
+						{Op: ClauseOpIn, Attribute: "identifier", Values: []string{"bob"}},
+						{Op: ClauseOpSegmentMatch, Values: []string{"beta"}, Negate: true},
+					},
+					Serve: &nextgen.Serve{Variation: "rule"},
+				}}
+			}),
+			target:    featureFlagTarget{Identifier: "bob"},
+			variation: "default",
+			reason:    EvaluationReasonDefault,
+		},
+		{
+			name:      "default on variation",
+			flag:      newFlag("flag", nil),
+			target:    featureFlagTarget{Identifier: "alice"},
+			variation: "default",
+			reason:    EvaluationReasonDefault,
+		},
+		{
+			name:      "default serve",
+			flag:      newFlag("flag", func(f *nextgen.Feature) { f.EnvProperties.DefaultServe = &nextgen.Serve{Variation: "served"} }),
+			target:    featureFlagTarget{Identifier: "alice"},
+			variation: "served",
+			reason:    EvaluationReasonDefault,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			result, err := testFeatureFlagEvaluator(flags, segments).evaluate(c.flag, &c.target)
+			require.NoError(t, err)
+			require.Equal(t, &featureFlagEvaluation{Variation: c.variation, Reason: c.reason, RuleId: c.ruleId}, result)
+		})
+	}
+
+	t.Run("prerequisite loop", func(t *testing.T) {
+		_, err := testFeatureFlagEvaluator(flags, segments).evaluate(flags["loop"], &featureFlagTarget{Identifier: "alice"})
+		require.EqualError(t, err, fmt.Sprintf("prerequisites of flag loop are nested more than %d levels deep", maxPrerequisiteDepth))
+	})
+
+	t.Run("unknown prerequisite", func(t *testing.T) {
+		flag := newFlag("flag", func(f *nextgen.Feature) { f.Prerequisites = []nextgen.Prerequisite{{Feature: "missing"}} })
+		_, err := testFeatureFlagEvaluator(flags, segments).evaluate(flag, &featureFlagTarget{Identifier: "alice"})
+		require.EqualError(t, err, "failed to read prerequisite flag missing: not found")
+	})
+
+	t.Run("no state in the environment", func(t *testing.T) {
+		_, err := testFeatureFlagEvaluator(flags, segments).evaluate(&nextgen.Feature{Identifier: "flag"}, &featureFlagTarget{Identifier: "alice"})
+		require.EqualError(t, err, "flag flag has no state in the environment")
+	})
+}
+
+func testFeatureFlagEvaluator(flags map[string]*nextgen.Feature, segments map[string]*nextgen.Segment) *featureFlagEvaluator {
+	return newFeatureFlagEvaluator(
+		func(identifier string) (*nextgen.Feature, error) {
+			if flag, ok := flags[identifier]; ok {
+				return flag, nil
+			}
+			return nil, fmt.Errorf("not found")
+		},
+		func(identifier string) (*nextgen.Segment, error) {
+			if segment, ok := segments[identifier]; ok {
+				return segment, nil
+			}
+			return nil, fmt.Errorf("not found")
+		},
+	)
+}
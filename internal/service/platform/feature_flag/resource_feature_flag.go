@@ -117,6 +117,27 @@ func ResourceFeatureFlag() *schema.Resource {
 					},
 				},
 			},
+			"prerequisite": {
+				Description: "Flags that must serve one of the given variations for this flag to be evaluated. Prerequisites apply to all the environments.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"flag_id": {
+							Description: "Identifier of the prerequisite flag.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"variations": {
+							Description: "Identifiers of the variations of the prerequisite flag.",
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 			"environment": {
 				Description: "Environment Identifier",
 				Type:        schema.TypeList,
@@ -248,6 +269,8 @@ const (
 	AddTag                         = "addTag"
 	RemoveTag                      = "removeTag"
 	RemoveRule                     = "removeRule"
+	AddPrerequisite                = "addPrerequisite"
+	RemovePrerequisite             = "removePrerequisite"
 	RemoveTarget                   = "removeTargetsToVariationTargetMap"
 	GroupName                      = "group_name"
 	DistributionVar                = "distribution"
@@ -284,7 +307,7 @@ type Variation struct {
 
 // Distribution is the distribution for the feature flag
 type Distribution struct {
-	BuckedBy   *string      `json:"buckedBy,omitempty"`
+	BuckedBy   *string      `json:"bucketBy,omitempty"`
 	Variations []*Variation `json:"variations,omitempty"`
 }
 
@@ -314,8 +337,14 @@ type Parameter struct {
 
 // Instruction defines the instruction for the feature flag
 type Instruction struct {
-	Kind       *string    `json:"kind,omitempty"`
-	Parameters *Parameter `json:"parameters,omitempty"`
+	Kind       *string     `json:"kind,omitempty"`
+	Parameters interface{} `json:"parameters,omitempty"`
+}
+
+// PrerequisiteParameters are the parameters of the instructions adding or removing a prerequisite of a flag.
+type PrerequisiteParameters struct {
+	Feature    string   `json:"feature"`
+	Variations []string `json:"variations,omitempty"`
 }
 
 type FFOpts struct {
@@ -370,6 +399,14 @@ func resourceFeatureFlagUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	if prerequisiteOpts := buildFFPrerequisitePatchOpts(d); prerequisiteOpts != nil {
+		_, httpResp, err := c.FeatureFlagsApi.PatchFeature(ctx, c.AccountId, qp.OrganizationId, qp.ProjectId, id, prerequisiteOpts)
+
+		if err != nil {
+			return helpers.HandleApiError(err, d, httpResp)
+		}
+	}
+
 	readOpts := buildFFReadOpts(d)
 
 	resp, httpResp, err := c.FeatureFlagsApi.GetFeatureFlag(ctx, id, c.AccountId, qp.OrganizationId, qp.ProjectId, readOpts)
@@ -445,6 +482,14 @@ func resourceFeatureFlagCreate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	if prerequisiteOpts := buildFFPrerequisitePatchOpts(d); prerequisiteOpts != nil {
+		_, httpResp, err = c.FeatureFlagsApi.PatchFeature(ctx, c.AccountId, qp.OrganizationId, qp.ProjectId, id, prerequisiteOpts)
+
+		if err != nil {
+			return helpers.HandleApiError(err, d, httpResp)
+		}
+	}
+
 	resp, httpResp, err = c.FeatureFlagsApi.GetFeatureFlag(ctx, id, c.AccountId, qp.OrganizationId, qp.ProjectId, readOpts)
 
 	if err != nil {
@@ -465,7 +510,12 @@ func resourceFeatureFlagDelete(ctx context.Context, d *schema.ResourceData, meta
 	}
 	qp := buildFFQueryParameters(d)
 
-	httpResp, err := c.FeatureFlagsApi.DeleteFeatureFlag(ctx, d.Id(), c.AccountId, qp.OrganizationId, qp.ProjectId, &nextgen.FeatureFlagsApiDeleteFeatureFlagOpts{CommitMsg: optional.EmptyString()})
+	commitMsg := optional.EmptyString()
+	if msg := getFFCommitMsg(d); msg != "" {
+		commitMsg = optional.NewString(msg)
+	}
+
+	httpResp, err := c.FeatureFlagsApi.DeleteFeatureFlag(ctx, d.Id(), c.AccountId, qp.OrganizationId, qp.ProjectId, &nextgen.FeatureFlagsApiDeleteFeatureFlagOpts{CommitMsg: commitMsg})
 	if err != nil {
 		return helpers.HandleApiError(err, d, httpResp)
 	}
@@ -486,6 +536,56 @@ func readFeatureFlag(d *schema.ResourceData, flag *nextgen.Feature, qp *FFQueryP
 	d.Set("owner", strings.Join(flag.Owner, ","))
 	d.Set("org_id", qp.OrganizationId)
 	d.Set("variation", expandVariations(flag.Variations))
+	d.Set("prerequisite", flattenPrerequisites(flag.Prerequisites))
+}
+
+func flattenPrerequisites(prerequisites []nextgen.Prerequisite) []interface{} {
+	var result []interface{}
+	for _, prerequisite := range prerequisites {
+		result = append(result, map[string]interface{}{
+			"flag_id":    prerequisite.Feature,
+			"variations": prerequisite.Variations,
+		})
+	}
+
+	return result
+}
+
+// buildFFPrerequisitePatchOpts returns the patch replacing the prerequisites of the flag, nil when they didn't change.
+func buildFFPrerequisitePatchOpts(d *schema.ResourceData) *nextgen.FeatureFlagsApiPatchFeatureOpts {
+	if !d.HasChange("prerequisite") {
+		return nil
+	}
+
+	var instructions []*Instruction
+
+	old, new := d.GetChange("prerequisite")
+	for _, p := range old.([]interface{}) {
+		prerequisite := p.(map[string]interface{})
+		instructions = append(instructions, &Instruction{
+			Kind:       aws.String(RemovePrerequisite),
+			Parameters: &PrerequisiteParameters{Feature: prerequisite["flag_id"].(string)},
+		})
+	}
+	for _, p := range new.([]interface{}) {
+		prerequisite := p.(map[string]interface{})
+		instructions = append(instructions, &Instruction{
+			Kind: aws.String(AddPrerequisite),
+			Parameters: &PrerequisiteParameters{
+				Feature:    prerequisite["flag_id"].(string),
+				Variations: helpers.ExpandField(prerequisite["variations"].([]interface{})),
+			},
+		})
+	}
+
+	if len(instructions) == 0 {
+		return nil
+	}
+
+	return &nextgen.FeatureFlagsApiPatchFeatureOpts{
+		Body:                  optional.NewInterface(&FFInstructionsPatchOpts{Instructions: instructions}),
+		EnvironmentIdentifier: optional.EmptyString(),
+	}
 }
 
 func expandVariations(variations []nextgen.Variation) []interface{} {
@@ -502,6 +602,18 @@ func expandVariations(variations []nextgen.Variation) []interface{} {
 	return result
 }
 
+// getFFCommitMsg returns the commit message of the git sync operations, if the flag is synced to git. Flags are
+// synced to the repository configured for the project, so unlike the entities using helpers.GitDetails only the
+// commit message is set per flag.
+func getFFCommitMsg(d *schema.ResourceData) string {
+	for _, v := range d.Get("git_details").(*schema.Set).List() {
+		if details, ok := v.(map[string]interface{}); ok {
+			return details["commit_msg"].(string)
+		}
+	}
+	return ""
+}
+
 func buildFFQueryParameters(d *schema.ResourceData) *FFQueryParameters {
 	return &FFQueryParameters{
 		Identifier:     d.Get("identifier").(string),
@@ -532,6 +644,8 @@ func buildFFCreateOpts(d *schema.ResourceData) *nextgen.FeatureFlagsApiCreateFea
 		opts.Archived = archived.(bool)
 	}
 
+	opts.GitDetails = nextgen.GitDetails{CommitMsg: getFFCommitMsg(d)}
+
 	var variations []nextgen.Variation
 	variationsData := d.Get("variation").([]interface{})
 	for _, variationData := range variationsData {
@@ -573,6 +687,8 @@ func buildFFPatchOpts(d *schema.ResourceData) *nextgen.FeatureFlagsApiPatchFeatu
 		opts.Archived = archived.(bool)
 	}
 
+	opts.GitDetails = nextgen.GitDetails{CommitMsg: getFFCommitMsg(d)}
+
 	var variations []nextgen.Variation
 	variationsData := d.Get("variation").([]interface{})
 	for _, variationData := range variationsData {
@@ -0,0 +1,513 @@
+package feature_flag
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/antihax/optional"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/harness/harness-go-sdk/harness/nextgen"
+	"github.com/harness/terraform-provider-harness/helpers"
+	"github.com/harness/terraform-provider-harness/internal"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	SetFeatureFlagState = "setFeatureFlagState"
+	SetOffVariation     = "setOffVariation"
+	UpdateDefaultServe  = "updateDefaultServe"
+
+	FeatureStateOn  = "on"
+	FeatureStateOff = "off"
+)
+
+// FeatureStateParameters are the parameters of the instruction turning a flag on or off.
+type FeatureStateParameters struct {
+	State string `json:"state"`
+}
+
+// OffVariationParameters are the parameters of the instruction setting the variation served when a flag is off.
+type OffVariationParameters struct {
+	Variation string `json:"variation"`
+}
+
+// DefaultServeParameters are the parameters of the instruction setting the variation, or the percentage rollout,
+// served when a flag is on and no rule matches.
+type DefaultServeParameters struct {
+	Variation  *string      `json:"variation,omitempty"`
+	BucketBy   *string      `json:"bucketBy,omitempty"`
+	Variations []*Variation `json:"variations,omitempty"`
+}
+
+// ServingRuleParameters are the parameters of the instruction adding a serving rule.
+type ServingRuleParameters struct {
+	Priority int               `json:"priority"`
+	Serve    *Serve            `json:"serve"`
+	Clauses  []*nextgen.Clause `json:"clauses"`
+}
+
+// RemoveRuleParameters are the parameters of the instruction removing a serving rule.
+type RemoveRuleParameters struct {
+	RuleID string `json:"ruleID"`
+}
+
+// FFInstructionsPatchOpts is the body of the patch requests only made of instructions.
+type FFInstructionsPatchOpts struct {
+	Instructions []*Instruction `json:"instructions"`
+}
+
+func ResourceFeatureFlagEnvironment() *schema.Resource {
+	resource := &schema.Resource{
+		Description: "Resource for managing the state and the serving rules of a Feature Flag in an environment. The rules of the environment must not also be managed with the `environment` block of `harness_platform_feature_flag`.",
+
+		ReadContext:   resourceFeatureFlagEnvironmentRead,
+		CreateContext: resourceFeatureFlagEnvironmentCreateOrUpdate,
+		UpdateContext: resourceFeatureFlagEnvironmentCreateOrUpdate,
+		DeleteContext: resourceFeatureFlagEnvironmentDelete,
+		CustomizeDiff: resourceFeatureFlagEnvironmentCustomizeDiff,
+		Importer:      helpers.FeatureFlagEnvironmentResourceImporter,
+
+		Schema: map[string]*schema.Schema{
+			"flag_id": {
+				Description: "Identifier of the Feature Flag.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"environment_id": {
+				Description: "Identifier of the Environment.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"org_id": {
+				Description: "Organization Identifier",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"project_id": {
+				Description: "Project Identifier",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"state": {
+				Description:  fmt.Sprintf("State of the flag in the environment. Valid values are `%s`, `%s`.", FeatureStateOn, FeatureStateOff),
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      FeatureStateOff,
+				ValidateFunc: validation.StringInSlice([]string{FeatureStateOn, FeatureStateOff}, false),
+			},
+			"default_on_variation": {
+				Description:   "Identifier of the variation served when the flag is on and no rule matches. Defaults to the `default_on_variation` of the flag.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"default_on_distribution"},
+			},
+			"default_on_distribution": {
+				Description:   "Percentage rollout served when the flag is on and no rule matches.",
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"default_on_variation"},
+				Elem:          getDistributionSchema(),
+			},
+			"default_off_variation": {
+				Description: "Identifier of the variation served when the flag is off. Defaults to the `default_off_variation` of the flag.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"rule": {
+				Description: "Serving rules of the flag, evaluated in order. The first matching rule serves its variation.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "Identifier of the rule.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"clause": {
+							Description: "Conditions of the rule, the rule matches when all of them match.",
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attribute": {
+										Description: "Attribute of the target to evaluate. Not used by the `segmentMatch` operator.",
+										Type:        schema.TypeString,
+										Optional:    true,
+									},
+									"op": {
+										Description: "Operator of the clause, e.g. `equal`, `equal_sensitive`, `in`, `starts_with`, `ends_with`, `contains` or `segmentMatch` to match the targets of target groups.",
+										Type:        schema.TypeString,
+										Required:    true,
+									},
+									"values": {
+										Description: "Values compared to the attribute, or identifiers of the target groups for the `segmentMatch` operator.",
+										Type:        schema.TypeList,
+										Required:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+									"negate": {
+										Description: "Match when the condition is false.",
+										Type:        schema.TypeBool,
+										Optional:    true,
+									},
+								},
+							},
+						},
+						"serve_variation": {
+							Description: "Identifier of the variation served when the rule matches.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"serve_distribution": {
+							Description: "Percentage rollout served when the rule matches.",
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Elem:        getDistributionSchema(),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return resource
+}
+
+func getDistributionSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"bucket_by": {
+				Description: "Attribute of the target used to assign it to a variation.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     BuckedBy,
+			},
+			"variation": {
+				Description: "Weights of the variations. The weights must add up to 100.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"variation": {
+							Description: "Identifier of the variation.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"weight": {
+							Description:  "Percentage of the targets served the variation.",
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceFeatureFlagEnvironmentCustomizeDiff validates what the schema can't: each rule serves either a variation
+// or a percentage rollout, and the weights of the rollouts add up to 100.
+func resourceFeatureFlagEnvironmentCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := validateDistribution("default_on_distribution", d.Get("default_on_distribution").([]interface{})); err != nil {
+		return err
+	}
+
+	for i, r := range d.Get("rule").([]interface{}) {
+		rule := r.(map[string]interface{})
+		distribution := rule["serve_distribution"].([]interface{})
+		if (rule["serve_variation"].(string) == "") == (len(distribution) == 0) {
+			return fmt.Errorf("rule.%d: exactly one of serve_variation or serve_distribution must be set", i)
+		}
+		if err := validateDistribution(fmt.Sprintf("rule.%d.serve_distribution", i), distribution); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateDistribution(key string, config []interface{}) error {
+	if len(config) == 0 || config[0] == nil {
+		return nil
+	}
+
+	total := 0
+	for _, v := range config[0].(map[string]interface{})["variation"].([]interface{}) {
+		total += v.(map[string]interface{})[Weight].(int)
+	}
+	if total != 100 {
+		return fmt.Errorf("%s: the weights of the variations add up to %d instead of 100", key, total)
+	}
+	return nil
+}
+
+func resourceFeatureFlagEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
+
+	flag, httpResp, err := getFeatureFlagEnvironment(ctx, c, d)
+	if err != nil {
+		return helpers.HandleReadApiError(err, d, httpResp)
+	}
+
+	readFeatureFlagEnvironment(d, &flag)
+
+	return nil
+}
+
+func resourceFeatureFlagEnvironmentCreateOrUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
+
+	// The rules are replaced as a whole, the current rules are read to remove the ones that were added outside of
+	// Terraform since the last refresh.
+	current, httpResp, err := getFeatureFlagEnvironment(ctx, c, d)
+	if err != nil {
+		return helpers.HandleApiError(err, d, httpResp)
+	}
+
+	instructions := buildFeatureFlagEnvironmentInstructions(d, &current)
+	if len(instructions) > 0 {
+		_, httpResp, err = c.FeatureFlagsApi.PatchFeature(ctx, c.AccountId, d.Get("org_id").(string), d.Get("project_id").(string), d.Get("flag_id").(string), &nextgen.FeatureFlagsApiPatchFeatureOpts{
+			Body:                  optional.NewInterface(&FFInstructionsPatchOpts{Instructions: instructions}),
+			EnvironmentIdentifier: optional.NewString(d.Get("environment_id").(string)),
+		})
+		if err != nil {
+			return helpers.HandleApiError(err, d, httpResp)
+		}
+	}
+
+	flag, httpResp, err := getFeatureFlagEnvironment(ctx, c, d)
+	if err != nil {
+		return helpers.HandleApiError(err, d, httpResp)
+	}
+
+	readFeatureFlagEnvironment(d, &flag)
+
+	return nil
+}
+
+// resourceFeatureFlagEnvironmentDelete turns the flag off and removes its rules, the configuration of a flag in an
+// environment can't be deleted.
+func resourceFeatureFlagEnvironmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
+
+	instructions := []*Instruction{
+		{Kind: aws.String(SetFeatureFlagState), Parameters: &FeatureStateParameters{State: FeatureStateOff}},
+	}
+	for _, rule := range d.Get("rule").([]interface{}) {
+		if id := rule.(map[string]interface{})["id"].(string); id != "" {
+			instructions = append(instructions, &Instruction{Kind: aws.String(RemoveRule), Parameters: &RemoveRuleParameters{RuleID: id}})
+		}
+	}
+
+	_, httpResp, err := c.FeatureFlagsApi.PatchFeature(ctx, c.AccountId, d.Get("org_id").(string), d.Get("project_id").(string), d.Get("flag_id").(string), &nextgen.FeatureFlagsApiPatchFeatureOpts{
+		Body:                  optional.NewInterface(&FFInstructionsPatchOpts{Instructions: instructions}),
+		EnvironmentIdentifier: optional.NewString(d.Get("environment_id").(string)),
+	})
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			return nil
+		}
+		return helpers.HandleApiError(err, d, httpResp)
+	}
+
+	return nil
+}
+
+func getFeatureFlagEnvironment(ctx context.Context, c *nextgen.APIClient, d *schema.ResourceData) (nextgen.Feature, *http.Response, error) {
+	return c.FeatureFlagsApi.GetFeatureFlag(ctx, d.Get("flag_id").(string), c.AccountId, d.Get("org_id").(string), d.Get("project_id").(string), &nextgen.FeatureFlagsApiGetFeatureFlagOpts{
+		EnvironmentIdentifier: optional.NewString(d.Get("environment_id").(string)),
+	})
+}
+
+// buildFeatureFlagEnvironmentInstructions returns the instructions applying the configuration. Only the changed
+// settings are patched, all of them on creation.
+func buildFeatureFlagEnvironmentInstructions(d *schema.ResourceData, current *nextgen.Feature) []*Instruction {
+	isNew := d.Id() == ""
+	var instructions []*Instruction
+
+	if isNew || d.HasChange("state") {
+		instructions = append(instructions, &Instruction{
+			Kind:       aws.String(SetFeatureFlagState),
+			Parameters: &FeatureStateParameters{State: d.Get("state").(string)},
+		})
+	}
+
+	if attr, ok := d.GetOk("default_off_variation"); ok && (isNew || d.HasChange("default_off_variation")) {
+		instructions = append(instructions, &Instruction{
+			Kind:       aws.String(SetOffVariation),
+			Parameters: &OffVariationParameters{Variation: attr.(string)},
+		})
+	}
+
+	if isNew || d.HasChanges("default_on_variation", "default_on_distribution") {
+		if distribution := expandServeDistribution(d.Get("default_on_distribution").([]interface{})); distribution != nil {
+			instructions = append(instructions, &Instruction{
+				Kind:       aws.String(UpdateDefaultServe),
+				Parameters: &DefaultServeParameters{BucketBy: distribution.BuckedBy, Variations: distribution.Variations},
+			})
+		} else if attr, ok := d.GetOk("default_on_variation"); ok {
+			instructions = append(instructions, &Instruction{
+				Kind:       aws.String(UpdateDefaultServe),
+				Parameters: &DefaultServeParameters{Variation: aws.String(attr.(string))},
+			})
+		}
+	}
+
+	if isNew || d.HasChange("rule") {
+		if current.EnvProperties != nil {
+			for _, rule := range current.EnvProperties.Rules {
+				instructions = append(instructions, &Instruction{
+					Kind:       aws.String(RemoveRule),
+					Parameters: &RemoveRuleParameters{RuleID: rule.RuleId},
+				})
+			}
+		}
+
+		for i, rule := range d.Get("rule").([]interface{}) {
+			instructions = append(instructions, &Instruction{
+				Kind:       aws.String(AddRule),
+				Parameters: expandServingRule(rule.(map[string]interface{}), i+1),
+			})
+		}
+	}
+
+	return instructions
+}
+
+func expandServingRule(rule map[string]interface{}, priority int) *ServingRuleParameters {
+	params := &ServingRuleParameters{
+		Priority: priority,
+		Serve:    &Serve{},
+	}
+
+	if distribution := expandServeDistribution(rule["serve_distribution"].([]interface{})); distribution != nil {
+		params.Serve.Distribution = distribution
+	} else {
+		params.Serve.Variation = aws.String(rule["serve_variation"].(string))
+	}
+
+	for _, c := range rule["clause"].([]interface{}) {
+		clause := c.(map[string]interface{})
+		params.Clauses = append(params.Clauses, &nextgen.Clause{
+			Attribute: clause["attribute"].(string),
+			Op:        clause["op"].(string),
+			Values:    helpers.ExpandField(clause["values"].([]interface{})),
+			Negate:    clause["negate"].(bool),
+		})
+	}
+
+	return params
+}
+
+func expandServeDistribution(config []interface{}) *Distribution {
+	if len(config) == 0 || config[0] == nil {
+		return nil
+	}
+
+	d := config[0].(map[string]interface{})
+	distribution := &Distribution{
+		BuckedBy: aws.String(d["bucket_by"].(string)),
+	}
+	for _, v := range d["variation"].([]interface{}) {
+		variation := v.(map[string]interface{})
+		distribution.Variations = append(distribution.Variations, &Variation{
+			Variation: aws.String(variation[VariationVar].(string)),
+			Weight:    aws.Int(variation[Weight].(int)),
+		})
+	}
+	return distribution
+}
+
+func readFeatureFlagEnvironment(d *schema.ResourceData, flag *nextgen.Feature) {
+	env := flag.EnvProperties
+	if env == nil {
+		d.SetId("")
+		return
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", flag.Identifier, env.Environment))
+	d.Set("flag_id", flag.Identifier)
+	d.Set("environment_id", env.Environment)
+	d.Set("state", string(env.State))
+	d.Set("default_off_variation", env.OffVariation)
+
+	if env.DefaultServe != nil && env.DefaultServe.Distribution != nil {
+		d.Set("default_on_variation", "")
+		d.Set("default_on_distribution", flattenServeDistribution(env.DefaultServe.Distribution))
+	} else if env.DefaultServe != nil {
+		d.Set("default_on_variation", env.DefaultServe.Variation)
+		d.Set("default_on_distribution", nil)
+	}
+
+	d.Set("rule", flattenServingRules(env.Rules))
+}
+
+// flattenServingRules flattens the serving rules of a flag in an environment in the order they are evaluated.
+func flattenServingRules(servingRules []nextgen.ServingRule) []interface{} {
+	rules := sortServingRules(servingRules)
+
+	var flattened []interface{}
+	for _, rule := range rules {
+		var clauses []interface{}
+		for _, clause := range rule.Clauses {
+			clauses = append(clauses, map[string]interface{}{
+				"attribute": clause.Attribute,
+				"op":        clause.Op,
+				"values":    clause.Values,
+				"negate":    clause.Negate,
+			})
+		}
+
+		r := map[string]interface{}{
+			"id":     rule.RuleId,
+			"clause": clauses,
+		}
+		if rule.Serve != nil && rule.Serve.Distribution != nil {
+			r["serve_distribution"] = flattenServeDistribution(rule.Serve.Distribution)
+		} else if rule.Serve != nil {
+			r["serve_variation"] = rule.Serve.Variation
+		}
+		flattened = append(flattened, r)
+	}
+	return flattened
+}
+
+// sortServingRules returns a copy of the serving rules sorted by priority.
+func sortServingRules(servingRules []nextgen.ServingRule) []nextgen.ServingRule {
+	rules := append([]nextgen.ServingRule{}, servingRules...)
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rules[i].Priority < rules[j].Priority
+	})
+	return rules
+}
+
+func flattenServeDistribution(distribution *nextgen.Distribution) []interface{} {
+	var variations []interface{}
+	for _, v := range distribution.Variations {
+		variations = append(variations, map[string]interface{}{
+			"variation": v.Variation,
+			"weight":    int(v.Weight),
+		})
+	}
+
+	return []interface{}{map[string]interface{}{
+		"bucket_by": distribution.BucketBy,
+		"variation": variations,
+	}}
+}
@@ -0,0 +1,135 @@
+package feature_flag_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/harness/harness-go-sdk/harness/utils"
+	"github.com/harness/terraform-provider-harness/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceFeatureFlagEnvironment(t *testing.T) {
+
+	name := t.Name()
+	id := fmt.Sprintf("%s_%s", name, utils.RandStringBytes(5))
+	resourceName := "harness_platform_feature_flag_environment.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.TestAccPreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceFeatureFlagEnvironment(id, name, "on"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%[1]s/%[1]s", id)),
+					resource.TestCheckResourceAttr(resourceName, "state", "on"),
+					resource.TestCheckResourceAttr(resourceName, "default_off_variation", "Disabled"),
+					resource.TestCheckResourceAttr(resourceName, "default_on_distribution.0.variation.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.clause.0.attribute", "email"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.serve_variation", "Enabled"),
+				),
+			},
+			{
+				Config: testAccResourceFeatureFlagEnvironment(id, name, "off"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "state", "off"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccFeatureFlagEnvironmentImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccFeatureFlagEnvironmentImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		primary := s.RootModule().Resources[resourceName].Primary
+		return fmt.Sprintf("%s/%s/%s/%s", primary.Attributes["org_id"], primary.Attributes["project_id"], primary.Attributes["flag_id"], primary.Attributes["environment_id"]), nil
+	}
+}
+
+func testAccResourceFeatureFlagEnvironment(id string, name string, state string) string {
+	return fmt.Sprintf(`
+		resource "harness_platform_organization" "test" {
+			identifier = "%[1]s"
+			name = "%[2]s"
+		}
+
+		resource "harness_platform_project" "test" {
+			identifier = "%[1]s"
+			name = "%[2]s"
+			org_id = harness_platform_organization.test.id
+			color = "#0063F7"
+		}
+
+		resource "harness_platform_environment" "test" {
+			identifier = "%[1]s"
+			name = "%[2]s"
+			org_id = harness_platform_project.test.org_id
+			project_id = harness_platform_project.test.id
+			type = "PreProduction"
+		}
+
+		resource "harness_platform_feature_flag" "test" {
+			identifier = "%[1]s"
+			org_id = harness_platform_project.test.org_id
+			project_id = harness_platform_project.test.id
+			name = "%[2]s"
+			kind       = "boolean"
+			permanent  = false
+
+			default_on_variation  = "Enabled"
+			default_off_variation = "Disabled"
+
+			variation {
+				identifier  = "Enabled"
+				name        = "Enabled"
+				description = "The feature is enabled"
+				value       = "true"
+			}
+
+			variation {
+				identifier  = "Disabled"
+				name        = "Disabled"
+				description = "The feature is disabled"
+				value       = "false"
+			}
+		}
+
+		resource "harness_platform_feature_flag_environment" "test" {
+			org_id = harness_platform_project.test.org_id
+			project_id = harness_platform_project.test.id
+			flag_id = harness_platform_feature_flag.test.identifier
+			environment_id = harness_platform_environment.test.id
+
+			state = "%[3]s"
+			default_off_variation = "Disabled"
+
+			default_on_distribution {
+				variation {
+					variation = "Enabled"
+					weight = 20
+				}
+				variation {
+					variation = "Disabled"
+					weight = 80
+				}
+			}
+
+			rule {
+				clause {
+					attribute = "email"
+					op = "ends_with"
+					values = ["@example.com"]
+				}
+				serve_variation = "Enabled"
+			}
+		}
+`, id, name, state)
+}
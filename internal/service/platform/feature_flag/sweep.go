@@ -0,0 +1,60 @@
+package feature_flag
+
+import (
+	"fmt"
+
+	"github.com/antihax/optional"
+	"github.com/harness/harness-go-sdk/harness/nextgen"
+	"github.com/harness/terraform-provider-harness/internal/sweep"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func init() {
+	resource.AddTestSweepers("harness_platform_feature_flag", &resource.Sweeper{
+		Name: "harness_platform_feature_flag",
+		F:    testSweepFeatureFlags,
+	})
+}
+
+func testSweepFeatureFlags(r string) error {
+	c, ctx := sweep.GetPlatformClientWithContext()
+
+	projects, err := sweep.ListProjectScopes()
+	if err != nil {
+		return err
+	}
+
+	for _, project := range projects {
+		for page := int32(0); ; page++ {
+			resp, _, err := c.FeatureFlagsApi.GetAllFeatures(ctx, c.AccountId, project.Org, project.Project, &nextgen.FeatureFlagsApiGetAllFeaturesOpts{
+				PageNumber: optional.NewInt32(page),
+				PageSize:   optional.NewInt32(sweep.PageSize),
+			})
+			if err != nil {
+				// Projects without the feature flag module enabled can't be listed.
+				fmt.Printf("[ERROR] Failed to list feature flags of project %s/%s: %s", project.Org, project.Project, err)
+				break
+			}
+
+			for _, flag := range resp.Features {
+				// Only delete feature flags whose identifier or name starts with the sweep prefix
+				if !sweep.IsSweepable(flag.Identifier, flag.Name) {
+					continue
+				}
+
+				_, err := c.FeatureFlagsApi.DeleteFeatureFlag(ctx, flag.Identifier, c.AccountId, project.Org, project.Project, &nextgen.FeatureFlagsApiDeleteFeatureFlagOpts{
+					CommitMsg: optional.EmptyString(),
+				})
+				if err != nil {
+					fmt.Printf("[ERROR] Failed to delete feature flag %s: %s", flag.Identifier, err)
+				}
+			}
+
+			if len(resp.Features) < sweep.PageSize {
+				break
+			}
+		}
+	}
+
+	return nil
+}
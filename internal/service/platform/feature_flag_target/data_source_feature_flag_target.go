@@ -0,0 +1,77 @@
+package feature_flag_target
+
+import (
+	"context"
+
+	"github.com/harness/terraform-provider-harness/helpers"
+	"github.com/harness/terraform-provider-harness/internal"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceFeatureFlagTarget() *schema.Resource {
+	resource := &schema.Resource{
+		Description: "Data source for retrieving a Feature Flag Target.",
+
+		ReadContext: dataSourceFeatureFlagTargetRead,
+
+		Schema: map[string]*schema.Schema{
+			"identifier": {
+				Description: "Identifier of the Feature Flag Target",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"org_id": {
+				Description: "Organization Identifier",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"project_id": {
+				Description: "Project Identifier",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"environment": {
+				Description: "Environment Identifier",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"account_id": {
+				Description: "Account Identifier",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "Target Name",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"attributes": {
+				Description: "Attributes",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+
+	return resource
+}
+
+func dataSourceFeatureFlagTargetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
+
+	qp := buildFFTargetQueryParameters(d)
+	qp.AccountID = c.AccountId
+
+	resp, httpResp, err := c.TargetsApi.GetTarget(ctx, qp.Identifier, c.AccountId, qp.OrganizationID, qp.ProjectID, qp.Environment)
+	if err != nil {
+		return helpers.HandleApiError(err, d, httpResp)
+	}
+
+	readFeatureFlagTarget(d, &resp, *qp)
+
+	return nil
+}
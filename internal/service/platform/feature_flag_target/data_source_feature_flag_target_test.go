@@ -0,0 +1,46 @@
+package feature_flag_target_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/harness/harness-go-sdk/harness/utils"
+	"github.com/harness/terraform-provider-harness/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceFeatureFlagTarget(t *testing.T) {
+
+	name := t.Name()
+	id := fmt.Sprintf("%s_%s", name, utils.RandStringBytes(5))
+	resourceName := "data.harness_platform_feature_flag_target.test"
+	environmentId := fmt.Sprintf("%s_%s", "env", utils.RandStringBytes(5))
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.TestAccPreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceFeatureFlagTarget(id, name, environmentId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "identifier", id),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "attributes.foo", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceFeatureFlagTarget(id string, name string, environmentId string) string {
+	return fmt.Sprintf(`
+		%s
+
+		data "harness_platform_feature_flag_target" "test" {
+			identifier = harness_platform_feature_flag_target.target.identifier
+			org_id = harness_platform_feature_flag_target.target.org_id
+			project_id = harness_platform_feature_flag_target.target.project_id
+			environment = harness_platform_feature_flag_target.target.environment
+		}
+`, testAccResourceFeatureFlagTarget(id, name, name, environmentId, "qa"))
+}
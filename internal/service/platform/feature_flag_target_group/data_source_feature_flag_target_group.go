@@ -0,0 +1,142 @@
+package featureflagtargetgroup
+
+import (
+	"context"
+
+	"github.com/harness/harness-go-sdk/harness/nextgen"
+	"github.com/harness/terraform-provider-harness/helpers"
+	"github.com/harness/terraform-provider-harness/internal"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceFeatureFlagTargetGroup ...
+func DataSourceFeatureFlagTargetGroup() *schema.Resource {
+	resource := &schema.Resource{
+		Description: "Data source for retrieving a Harness Feature Flag Target Group.",
+
+		ReadContext: dataSourceFeatureFlagTargetGroupRead,
+
+		Schema: map[string]*schema.Schema{
+			"identifier": {
+				Description: "The unique identifier of the feature flag target group.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"org_id": {
+				Description: "Organization Identifier",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"project_id": {
+				Description: "Project Identifier",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"environment": {
+				Description: "Environment Identifier",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"account_id": {
+				Description: "Account Identifier",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "The name of the feature flag target group.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"included": {
+				Description: "A list of targets included in the target group",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"excluded": {
+				Description: "A list of targets excluded from the target group",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"rule": {
+				Description: "The list of rules used to include targets in the target group.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute": {
+							Description: "The attribute to use in the clause.  This can be any target attribute",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"negate": {
+							Description: "Is the operation negated?",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"op": {
+							Description: "The type of operation such as equals, starts_with, contains",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"values": {
+							Description: "The values that are compared against the operator",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return resource
+}
+
+func dataSourceFeatureFlagTargetGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
+
+	qp := buildFFTargetGroupQueryParameters(d)
+
+	segment, httpResp, err := c.TargetGroupsApi.GetSegment(ctx, c.AccountId, qp.OrgID, qp.Identifier, qp.Project, qp.Environment)
+	if err != nil {
+		return helpers.HandleApiError(err, d, httpResp)
+	}
+
+	d.SetId(segment.Identifier)
+	d.Set("account_id", c.AccountId)
+	d.Set("name", segment.Name)
+	d.Set("included", flattenSegmentTargets(segment.Included))
+	d.Set("excluded", flattenSegmentTargets(segment.Excluded))
+
+	var rules []interface{}
+	for _, clause := range segment.Rules {
+		rules = append(rules, map[string]interface{}{
+			"attribute": clause.Attribute,
+			"negate":    clause.Negate,
+			"op":        clause.Op,
+			"values":    clause.Values,
+		})
+	}
+	d.Set("rule", rules)
+
+	return nil
+}
+
+// flattenSegmentTargets returns the identifiers of the targets included in or excluded from a target group.
+func flattenSegmentTargets(targets []nextgen.Target) []string {
+	var identifiers []string
+	for _, t := range targets {
+		identifiers = append(identifiers, t.Identifier)
+	}
+	return identifiers
+}
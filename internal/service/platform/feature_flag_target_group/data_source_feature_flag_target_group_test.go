@@ -0,0 +1,47 @@
+package featureflagtargetgroup_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/harness/harness-go-sdk/harness/utils"
+	"github.com/harness/terraform-provider-harness/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceFeatureFlagTargetGroup(t *testing.T) {
+
+	name := t.Name()
+	id := fmt.Sprintf("%s_%s", name, utils.RandStringBytes(5))
+	resourceName := "data.harness_platform_feature_flag_target_group.test"
+	environmentId := fmt.Sprintf("%s_%s", "env", utils.RandStringBytes(5))
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.TestAccPreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceFeatureFlagTargetGroup(id, name, environmentId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "identifier", id),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.attribute", "identifier"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.values.0", id),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceFeatureFlagTargetGroup(id string, name string, environmentId string) string {
+	return fmt.Sprintf(`
+		%s
+
+		data "harness_platform_feature_flag_target_group" "test" {
+			identifier = harness_platform_feature_flag_target_group.test.identifier
+			org_id = harness_platform_feature_flag_target_group.test.org_id
+			project_id = harness_platform_feature_flag_target_group.test.project_id
+			environment = harness_platform_feature_flag_target_group.test.environment
+		}
+`, testAccResourceFeatureFlagTarget(id, name, name, environmentId, "qa"))
+}
@@ -41,11 +41,12 @@ func ResourceFFApiKey() *schema.Resource {
 				ForceNew:    true,
 			},
 			"api_key": {
-				Description: "The value of the SDK API Key",
+				Description: "The value of the SDK API Key. Empty when `value_secret` is set.",
 				Type:        schema.TypeString,
 				Computed:    true,
 				Sensitive:   true,
 			},
+			"value_secret": helpers.GetValueSecretSchema("api_key"),
 			"expired_at": {
 				Description: "Expiration datetime of the SDK API Key",
 				Type:        schema.TypeInt,
@@ -145,6 +146,12 @@ func resourceFFApiKeyCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	readFFApiKey(d, &resp, qp)
 
+	if helpers.HasValueSecret(d) {
+		if httpResp, err = helpers.StoreValueInSecret(ctx, c, d, resp.ApiKey); err != nil {
+			return helpers.HandleApiError(err, d, httpResp)
+		}
+	}
+
 	return nil
 }
 
@@ -162,6 +169,10 @@ func resourceFFApiKeyDelete(ctx context.Context, d *schema.ResourceData, meta in
 		return helpers.HandleApiError(err, d, httpResp)
 	}
 
+	if httpResp, err = helpers.DeleteValueSecret(ctx, c, d); err != nil {
+		return helpers.HandleApiError(err, d, httpResp)
+	}
+
 	return nil
 }
 
@@ -169,7 +180,7 @@ func readFFApiKey(d *schema.ResourceData, apiKey *nextgen.CfApiKey, qp *ApiKeyQu
 	d.SetId(apiKey.Identifier)
 	d.Set("identifier", apiKey.Identifier)
 	d.Set("name", apiKey.Name)
-	if d.IsNewResource() {
+	if d.IsNewResource() && !helpers.HasValueSecret(d) {
 		d.Set("api_key", apiKey.ApiKey)
 	}
 	d.Set("type", apiKey.Type_)
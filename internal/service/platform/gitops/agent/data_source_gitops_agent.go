@@ -2,6 +2,8 @@ package agent
 
 import (
 	"context"
+	"time"
+
 	"github.com/antihax/optional"
 	hh "github.com/harness/harness-go-sdk/harness/helpers"
 	"github.com/harness/harness-go-sdk/harness/nextgen"
@@ -17,6 +19,10 @@ func DataSourceGitopsAgent() *schema.Resource {
 
 		ReadContext: dataSourceGitopsAgentRead,
 
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"account_id": {
 				Description: "Account identifier of the GitOps agent.",
@@ -95,6 +101,26 @@ func DataSourceGitopsAgent() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"wait_until_connected": {
+				Description: "Wait until the GitOps agent installed in the cluster has connected to Harness, up to the read timeout. Useful to depend on the resources installing the agent.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"connection_status": {
+				Description: "Connection status of the GitOps agent, e.g. `CONNECTED` or `DISCONNECTED`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"health_status": {
+				Description: "Health status of the GitOps agent, e.g. `HEALTHY` or `UNHEALTHY`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"last_heartbeat": {
+				Description: "Time of the last heartbeat received from the GitOps agent, in RFC3339 format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 		},
 	}
 	return resource
@@ -121,6 +147,14 @@ func dataSourceGitopsAgentRead(ctx context.Context, d *schema.ResourceData, meta
 		d.MarkNewResource()
 		return nil
 	}
+
+	if d.Get("wait_until_connected").(bool) && (resp.Health == nil || resp.Health.ConnectionStatus == nil || *resp.Health.ConnectionStatus != agentConnected) {
+		agent, err := waitForGitopsAgentConnected(ctx, c, d, d.Timeout(schema.TimeoutRead))
+		if err != nil {
+			return diag.Errorf("error waiting for GitOps agent %s to connect: %s", agentIdentifier, err)
+		}
+		resp = *agent
+	}
 	readAgent(d, &resp)
 	return nil
 }
@@ -36,6 +36,27 @@ func TestAccDataSourceGitopsAgent(t *testing.T) {
 
 }
 
+func TestAccDataSourceGitopsAgentWaitUntilConnected(t *testing.T) {
+	agentId := os.Getenv("HARNESS_TEST_GITOPS_AGENT_ID")
+	accountId := os.Getenv("HARNESS_ACCOUNT_ID")
+	resourceName := "data.harness_platform_gitops_agent.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.TestAccPreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGitopsAgentWaitUntilConnected(agentId, accountId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "identifier", agentId),
+					resource.TestCheckResourceAttr(resourceName, "connection_status", "CONNECTED"),
+					resource.TestCheckResourceAttrSet(resourceName, "health_status"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_heartbeat"),
+				),
+			},
+		},
+	})
+}
+
 // FLAMINGO
 func TestAccDataSourceGitopsAgentFlamingo(t *testing.T) {
 	id := fmt.Sprintf("%s_%s", t.Name(), utils.RandStringBytes(5))
@@ -133,3 +154,17 @@ func testAccDataSourceGitopsAgentFlamingo(agentId string, name string, accountId
 		}
 		`, agentId, name, accountId, agentName)
 }
+
+func testAccDataSourceGitopsAgentWaitUntilConnected(agentId string, accountId string) string {
+	return fmt.Sprintf(`
+		data "harness_platform_gitops_agent" "test" {
+			identifier = "%[1]s"
+			account_id = "%[2]s"
+			wait_until_connected = true
+
+			timeouts {
+				read = "2m"
+			}
+		}
+		`, agentId, accountId)
+}
@@ -3,6 +3,7 @@ package agent
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/antihax/optional"
 	hh "github.com/harness/harness-go-sdk/harness/helpers"
@@ -10,9 +11,16 @@ import (
 	"github.com/harness/terraform-provider-harness/helpers"
 	"github.com/harness/terraform-provider-harness/internal"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+const (
+	agentConnected       = "CONNECTED"
+	agentDisconnected    = "DISCONNECTED"
+	agentConnectionUnset = "CONNECTED_STATUS_UNSET"
+)
+
 func ResourceGitopsAgent() *schema.Resource {
 	resource := &schema.Resource{
 		Description: "Resource for managing a Harness GitOps Agent.",
@@ -23,6 +31,13 @@ func ResourceGitopsAgent() *schema.Resource {
 		DeleteContext: resourceGitopsAgentDelete,
 		Importer:      helpers.MultiLevelResourceImporter,
 
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if d.Id() != "" && d.HasChange("token_rotation_trigger") {
+				return d.SetNewComputed("agent_token")
+			}
+			return nil
+		},
+
 		Schema: map[string]*schema.Schema{
 			"account_id": {
 				Description: "Account identifier of the GitOps agent.",
@@ -100,6 +115,26 @@ func ResourceGitopsAgent() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
+			"token_rotation_trigger": {
+				Description: "Arbitrary value, e.g. a date, regenerating the agent token whenever it changes. The agent must be redeployed with the new token.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"connection_status": {
+				Description: "Connection status of the GitOps agent, e.g. `CONNECTED` or `DISCONNECTED`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"health_status": {
+				Description: "Health status of the GitOps agent, e.g. `HEALTHY` or `UNHEALTHY`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"last_heartbeat": {
+				Description: "Time of the last heartbeat received from the GitOps agent, in RFC3339 format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 		},
 	}
 	return resource
@@ -166,6 +201,17 @@ func resourceGitopsAgentUpdate(ctx context.Context, d *schema.ResourceData, meta
 	if err != nil {
 		return helpers.HandleApiError(err, d, httpResp)
 	}
+
+	if d.HasChange("token_rotation_trigger") {
+		resp, httpResp, err = c.AgentApi.AgentServiceForServerRegenerateCredentials(ctx, agentIdentifier, &nextgen.AgentsApiAgentServiceForServerRegenerateCredentialsOpts{
+			AccountIdentifier: optional.NewString(c.AccountId),
+			OrgIdentifier:     optional.NewString(d.Get("org_id").(string)),
+			ProjectIdentifier: optional.NewString(d.Get("project_id").(string)),
+		})
+		if err != nil {
+			return helpers.HandleApiError(err, d, httpResp)
+		}
+	}
 	// Soft delete lookup error handling
 	// https://harness.atlassian.net/browse/PL-23765
 	if &resp == nil {
@@ -273,4 +319,54 @@ func readAgent(d *schema.ResourceData, agent *nextgen.V1Agent) {
 	if agent.Credentials != nil && agent.Credentials.PrivateKey != "" {
 		d.Set("agent_token", agent.Credentials.PrivateKey)
 	}
+	readAgentHealth(d, agent)
+}
+
+func readAgentHealth(d *schema.ResourceData, agent *nextgen.V1Agent) {
+	connectionStatus, healthStatus, lastHeartbeat := "", "", ""
+	if agent.Health != nil {
+		if agent.Health.ConnectionStatus != nil {
+			connectionStatus = string(*agent.Health.ConnectionStatus)
+		}
+		if agent.Health.HarnessGitopsAgent != nil && agent.Health.HarnessGitopsAgent.Status != nil {
+			healthStatus = string(*agent.Health.HarnessGitopsAgent.Status)
+		}
+		if !agent.Health.LastHeartbeat.IsZero() {
+			lastHeartbeat = agent.Health.LastHeartbeat.Format(time.RFC3339)
+		}
+	}
+	d.Set("connection_status", connectionStatus)
+	d.Set("health_status", healthStatus)
+	d.Set("last_heartbeat", lastHeartbeat)
+}
+
+// waitForGitopsAgentConnected waits until the agent installed in the cluster has connected to Harness.
+func waitForGitopsAgentConnected(ctx context.Context, c *nextgen.APIClient, d *schema.ResourceData, timeout time.Duration) (*nextgen.V1Agent, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"", agentConnectionUnset, agentDisconnected},
+		Target:     []string{agentConnected},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			resp, _, err := c.AgentApi.AgentServiceForServerGet(ctx, d.Get("identifier").(string), c.AccountId, &nextgen.AgentsApiAgentServiceForServerGetOpts{
+				OrgIdentifier:     optional.NewString(d.Get("org_id").(string)),
+				ProjectIdentifier: optional.NewString(d.Get("project_id").(string)),
+			})
+			if err != nil {
+				return nil, "", err
+			}
+			if resp.Health == nil || resp.Health.ConnectionStatus == nil {
+				return resp, "", nil
+			}
+			return resp, string(*resp.Health.ConnectionStatus), nil
+		},
+	}
+
+	agent, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := agent.(nextgen.V1Agent)
+	return &resp, nil
 }
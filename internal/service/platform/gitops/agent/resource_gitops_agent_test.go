@@ -209,6 +209,43 @@ func TestAccResourceGitopsAgentNS(t *testing.T) {
 
 }
 
+func TestAccResourceGitopsAgentTokenRotation(t *testing.T) {
+	id := fmt.Sprintf("%s_%s", t.Name(), utils.RandStringBytes(5))
+	id = strings.ReplaceAll(id, "_", "")
+	accountId := os.Getenv("HARNESS_ACCOUNT_ID")
+	resourceName := "harness_platform_gitops_agent.test"
+	var agentToken string
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.TestAccPreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccResourceGitopsAgentDestroy(resourceName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceGitopsAgentTokenRotation(id, accountId, id, "2023-01-01"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "agent_token"),
+					func(state *terraform.State) error {
+						agentToken = acctest.TestAccGetResource(resourceName, state).Primary.Attributes["agent_token"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccResourceGitopsAgentTokenRotation(id, accountId, id, "2023-02-01"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "agent_token"),
+					func(state *terraform.State) error {
+						if acctest.TestAccGetResource(resourceName, state).Primary.Attributes["agent_token"] == agentToken {
+							return fmt.Errorf("agent token was not rotated")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testAccGetAgent(resourceName string, state *terraform.State) (*nextgen.V1Agent, error) {
 	r := acctest.TestAccGetResource(resourceName, state)
 	c, ctx := acctest.TestAccGetPlatformClientWithContext()
@@ -329,3 +366,20 @@ func testAccResourceGitopsAgentFluxProjectLevel(agentId string, accountId string
 		}
 		`, agentId, accountId, agentName, namespace)
 }
+
+func testAccResourceGitopsAgentTokenRotation(agentId string, accountId string, agentName string, trigger string) string {
+	return fmt.Sprintf(`
+		resource "harness_platform_gitops_agent" "test" {
+			identifier = "%[1]s"
+			account_id = "%[2]s"
+			name = "%[3]s"
+			type = "MANAGED_ARGO_PROVIDER"
+			metadata {
+				namespace = "terraform-test"
+				high_availability = false
+			}
+			operator = "ARGO"
+			token_rotation_trigger = "%[4]s"
+		}
+		`, agentId, accountId, agentName, trigger)
+}
@@ -0,0 +1,66 @@
+package agent
+
+import (
+	"fmt"
+
+	"github.com/antihax/optional"
+	"github.com/harness/harness-go-sdk/harness/nextgen"
+	"github.com/harness/terraform-provider-harness/internal/sweep"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func init() {
+	resource.AddTestSweepers("harness_platform_gitops_agent", &resource.Sweeper{
+		Name: "harness_platform_gitops_agent",
+		F:    testSweepGitopsAgents,
+	})
+}
+
+// testSweepGitopsAgents deletes the test agents together with the clusters, repositories and
+// applications registered on them.
+func testSweepGitopsAgents(r string) error {
+	c, ctx := sweep.GetPlatformClientWithContext()
+
+	scopes, err := sweep.ListScopes()
+	if err != nil {
+		return err
+	}
+
+	for _, scope := range scopes {
+		for page := int32(0); ; page++ {
+			resp, _, err := c.AgentApi.AgentServiceForServerList(ctx, c.AccountId, &nextgen.AgentsApiAgentServiceForServerListOpts{
+				OrgIdentifier:     optional.NewString(scope.Org),
+				ProjectIdentifier: optional.NewString(scope.Project),
+				PageIndex:         optional.NewInt32(page),
+				PageSize:          optional.NewInt32(sweep.PageSize),
+			})
+			if err != nil {
+				return err
+			}
+
+			for _, agent := range resp.Content {
+				// Only delete agents whose identifier or name starts with the sweep prefix. Agents of a
+				// parent scope are listed as well, so skip those and delete them when their own scope
+				// is swept.
+				if !sweep.IsSweepable(agent.Identifier, agent.Name) || agent.OrgIdentifier != scope.Org || agent.ProjectIdentifier != scope.Project {
+					continue
+				}
+
+				_, _, err := c.AgentApi.AgentServiceForServerDelete(ctx, agent.Identifier, &nextgen.AgentsApiAgentServiceForServerDeleteOpts{
+					AccountIdentifier: optional.NewString(c.AccountId),
+					OrgIdentifier:     optional.NewString(scope.Org),
+					ProjectIdentifier: optional.NewString(scope.Project),
+				})
+				if err != nil {
+					fmt.Printf("[ERROR] Failed to delete gitops agent %s: %s", agent.Identifier, err)
+				}
+			}
+
+			if len(resp.Content) < sweep.PageSize {
+				break
+			}
+		}
+	}
+
+	return nil
+}
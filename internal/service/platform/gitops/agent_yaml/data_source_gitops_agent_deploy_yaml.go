@@ -46,6 +46,21 @@ func DataSourceGitopsAgentDeployYaml() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"helm_values": {
+				Description: "The values YAML to install the GitOps agent with the Harness GitOps agent Helm chart, as an alternative to the deployment manifest.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"high_availability": {
+				Description: "Generate the manifest and values of a GitOps agent deployed in HA mode.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"is_namespaced": {
+				Description: "Generate the manifest and values of a namespaced GitOps agent, only managing the resources of its namespace.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
 			"ca_data": {
 				Description: "CA data of the GitOps agent, base64 encoded content of ca chain.",
 				Type:        schema.TypeString,
@@ -89,46 +104,8 @@ func dataSourceGitopsAgentDeployYamlRead(ctx context.Context, d *schema.Resource
 	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
 	agentIdentifier := d.Get("identifier").(string)
 
-	resp, httpResp, err := c.AgentApi.AgentServiceForServerPostDeployYaml(ctx, func() nextgen.V1AgentYamlQuery {
-		var yamlQuery nextgen.V1AgentYamlQuery
-		if attr, ok := d.GetOk("account_id"); ok {
-			yamlQuery.AccountIdentifier = attr.(string)
-		}
-		if attr, ok := d.GetOk("project_id"); ok {
-			yamlQuery.ProjectIdentifier = attr.(string)
-		}
-		if attr, ok := d.GetOk("org_id"); ok {
-			yamlQuery.OrgIdentifier = attr.(string)
-		}
-		if attr, ok := d.GetOk("namespace"); ok {
-			yamlQuery.Namespace = attr.(string)
-		}
-		if attr, ok := d.GetOk("ca_data"); ok {
-			yamlQuery.CaData = attr.(string)
-		}
-
-		if attr, ok := d.GetOk("proxy"); ok {
-			proxy := attr.([]interface{})
-			if attr != nil && len(proxy) > 0 {
-				p := proxy[0].(map[string]interface{})
-				var v1Proxy nextgen.V1Proxy
-				if p["http"] != nil {
-					v1Proxy.Http = p["http"].(string)
-				}
-				if p["https"] != nil {
-					v1Proxy.Https = p["https"].(string)
-				}
-				if p["username"] != nil {
-					v1Proxy.Username = p["username"].(string)
-				}
-				if p["password"] != nil {
-					v1Proxy.Password = p["password"].(string)
-				}
-				yamlQuery.Proxy = &v1Proxy
-			}
-		}
-		return yamlQuery
-	}(), agentIdentifier)
+	yamlQuery := buildAgentYamlQuery(d)
+	resp, httpResp, err := c.AgentApi.AgentServiceForServerPostDeployYaml(ctx, yamlQuery, agentIdentifier)
 
 	if err != nil {
 		return helpers.HandleApiError(err, d, httpResp)
@@ -141,10 +118,64 @@ func dataSourceGitopsAgentDeployYamlRead(ctx context.Context, d *schema.Resource
 		d.MarkNewResource()
 		return nil
 	}
+
+	helmValues, httpResp, err := c.AgentApi.AgentServiceForServerPostHelmOverrides(ctx, yamlQuery, agentIdentifier)
+	if err != nil {
+		return helpers.HandleApiError(err, d, httpResp)
+	}
+
 	readAgentYaml(agentIdentifier, d, resp)
+	d.Set("helm_values", helmValues)
 	return nil
 }
 
+func buildAgentYamlQuery(d *schema.ResourceData) nextgen.V1AgentYamlQuery {
+	var yamlQuery nextgen.V1AgentYamlQuery
+	if attr, ok := d.GetOk("account_id"); ok {
+		yamlQuery.AccountIdentifier = attr.(string)
+	}
+	if attr, ok := d.GetOk("project_id"); ok {
+		yamlQuery.ProjectIdentifier = attr.(string)
+	}
+	if attr, ok := d.GetOk("org_id"); ok {
+		yamlQuery.OrgIdentifier = attr.(string)
+	}
+	if attr, ok := d.GetOk("namespace"); ok {
+		yamlQuery.Namespace = attr.(string)
+	}
+	if attr, ok := d.GetOk("high_availability"); ok {
+		yamlQuery.HighAvailability = attr.(bool)
+	}
+	if attr, ok := d.GetOk("is_namespaced"); ok {
+		yamlQuery.IsNamespaced = attr.(bool)
+	}
+	if attr, ok := d.GetOk("ca_data"); ok {
+		yamlQuery.CaData = attr.(string)
+	}
+
+	if attr, ok := d.GetOk("proxy"); ok {
+		proxy := attr.([]interface{})
+		if attr != nil && len(proxy) > 0 {
+			p := proxy[0].(map[string]interface{})
+			var v1Proxy nextgen.V1Proxy
+			if p["http"] != nil {
+				v1Proxy.Http = p["http"].(string)
+			}
+			if p["https"] != nil {
+				v1Proxy.Https = p["https"].(string)
+			}
+			if p["username"] != nil {
+				v1Proxy.Username = p["username"].(string)
+			}
+			if p["password"] != nil {
+				v1Proxy.Password = p["password"].(string)
+			}
+			yamlQuery.Proxy = &v1Proxy
+		}
+	}
+	return yamlQuery
+}
+
 func readAgentYaml(agentIdentifier string, d *schema.ResourceData, yaml string) {
 	d.SetId(agentIdentifier)
 	d.Set("yaml", yaml)
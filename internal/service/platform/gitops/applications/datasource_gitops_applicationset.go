@@ -0,0 +1,87 @@
+package applications
+
+import (
+	"context"
+
+	"github.com/harness/harness-go-sdk/harness/nextgen"
+	"github.com/harness/terraform-provider-harness/helpers"
+	"github.com/harness/terraform-provider-harness/internal"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceGitopsApplicationSet() *schema.Resource {
+	resource := &schema.Resource{
+		Description: "Datasource for fetching a Harness GitOps ApplicationSet.",
+		ReadContext: dataSourceGitopsApplicationSetRead,
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Description: "Organization identifier of the GitOps ApplicationSet.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"project_id": {
+				Description: "Project identifier of the GitOps ApplicationSet.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"agent_id": {
+				Description: "Agent identifier of the GitOps ApplicationSet.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"identifier": {
+				Description: "Identifier of the GitOps ApplicationSet.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"applicationset": computedSchema(ResourceGitopsApplicationSet().Schema["applicationset"]),
+		},
+	}
+
+	return resource
+}
+
+func dataSourceGitopsApplicationSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
+
+	resp, httpResp, err := c.ApplicationSetApiService.AgentApplicationSetServiceGet(ctx, d.Get("agent_id").(string), d.Get("identifier").(string), c.AccountId, d.Get("org_id").(string), d.Get("project_id").(string), &nextgen.ApplicationSetApiAgentApplicationSetServiceGetOpts{})
+	if err != nil {
+		return helpers.HandleApiError(err, d, httpResp)
+	}
+
+	setApplicationSet(d, &resp)
+	return nil
+}
+
+// computedSchema returns a copy of the given resource schema where every attribute, including the nested ones, is
+// read-only.
+func computedSchema(s *schema.Schema) *schema.Schema {
+	result := &schema.Schema{
+		Description: s.Description,
+		Type:        s.Type,
+		Computed:    true,
+	}
+
+	switch elem := s.Elem.(type) {
+	case *schema.Resource:
+		nested := map[string]*schema.Schema{}
+		for key, value := range elem.Schema {
+			nested[key] = computedSchema(value)
+		}
+		result.Elem = &schema.Resource{Schema: nested}
+	case *schema.Schema:
+		result.Elem = elemSchema(elem)
+	}
+
+	return result
+}
+
+// elemSchema copies the element schema of a list or a map, which must only set its type.
+func elemSchema(s *schema.Schema) *schema.Schema {
+	result := &schema.Schema{Type: s.Type}
+	if elem, ok := s.Elem.(*schema.Schema); ok {
+		result.Elem = elemSchema(elem)
+	}
+	return result
+}
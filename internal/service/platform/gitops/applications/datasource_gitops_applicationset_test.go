@@ -0,0 +1,47 @@
+package applications_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/harness/harness-go-sdk/harness/utils"
+	"github.com/harness/terraform-provider-harness/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGitopsApplicationSet(t *testing.T) {
+	id := strings.ToLower(fmt.Sprintf("%s%s", t.Name(), utils.RandStringBytes(5)))
+	id = strings.ReplaceAll(id, "_", "")
+	agentId := os.Getenv("HARNESS_TEST_GITOPS_AGENT_ID")
+	repo := os.Getenv("HARNESS_TEST_GITOPS_REPO")
+	resourceName := "data.harness_platform_gitops_applicationset.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.TestAccPreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGitopsApplicationSet(id, agentId, repo),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", id),
+					resource.TestCheckResourceAttr(resourceName, "applicationset.0.metadata.0.name", id),
+					resource.TestCheckResourceAttr(resourceName, "applicationset.0.spec.0.generator.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGitopsApplicationSet(id string, agentId string, repo string) string {
+	return fmt.Sprintf(`
+		%s
+
+		data "harness_platform_gitops_applicationset" "test" {
+			org_id = harness_platform_gitops_applicationset.test.org_id
+			project_id = harness_platform_gitops_applicationset.test.project_id
+			agent_id = harness_platform_gitops_applicationset.test.agent_id
+			identifier = harness_platform_gitops_applicationset.test.identifier
+		}
+		`, testAccResourceGitopsApplicationSet(id, agentId, repo, "test"))
+}
@@ -0,0 +1,400 @@
+package applications
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/antihax/optional"
+	"github.com/harness/harness-go-sdk/harness/nextgen"
+	"github.com/harness/terraform-provider-harness/helpers"
+	"github.com/harness/terraform-provider-harness/internal"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// States reported while waiting for a sync of a GitOps application.
+const (
+	applicationSyncPending = "Pending"
+	applicationSyncDone    = "Done"
+)
+
+// Sync and health statuses reported by the GitOps agent.
+const (
+	applicationSyncStatusSynced    = "Synced"
+	applicationHealthStatusHealthy = "Healthy"
+)
+
+// Phases of the sync operation of a GitOps application that mean the operation is over without succeeding.
+var applicationSyncFailedPhases = []string{"Failed", "Error"}
+
+func ResourceGitopsApplicationSync() *schema.Resource {
+	resource := &schema.Resource{
+		Description: "Resource for syncing a Harness GitOps application. A sync is triggered when the resource is created and whenever an argument that forces replacement changes, e.g. `triggers`. Destroying the resource only removes it from the state.",
+
+		CreateContext: resourceGitopsApplicationSyncCreate,
+		ReadContext:   resourceGitopsApplicationSyncRead,
+		UpdateContext: resourceGitopsApplicationSyncRead,
+		DeleteContext: resourceGitopsApplicationSyncDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Description: "Organization identifier of the GitOps application.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"project_id": {
+				Description: "Project identifier of the GitOps application.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"agent_id": {
+				Description: "Agent identifier of the GitOps application.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"application_id": {
+				Description: "Identifier of the GitOps application to sync.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"revision": {
+				Description: "Revision to sync the application to. Defaults to the target revision of the application.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"prune": {
+				Description: "Delete the resources that are no longer defined in the source.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"dry_run": {
+				Description: "Only preview the sync without applying any change. The sync and health statuses are not waited for.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"sync_options": {
+				Description: "Options of the sync, e.g. `CreateNamespace=true` or `ApplyOutOfSyncOnly=true`.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"resource": {
+				Description: "Resources of the application to sync. All the resources are synced when none is given.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group": {
+							Description: "API group of the resource.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+						},
+						"kind": {
+							Description: "Kind of the resource.",
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+						},
+						"name": {
+							Description: "Name of the resource.",
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+						},
+						"namespace": {
+							Description: "Namespace of the resource.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+						},
+					},
+				},
+			},
+			"triggers": {
+				Description: "Arbitrary map of values that, when changed, syncs the application again.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"wait_for_sync": {
+				Description: "Wait for the application to be synced. The maximum wait is controlled by the `create` timeout.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"wait_for_healthy": {
+				Description: "Wait for the application to be healthy. The maximum wait is controlled by the `create` timeout.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"sync_status": {
+				Description: "Sync status of the application, e.g. `Synced` or `OutOfSync`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"health_status": {
+				Description: "Health status of the application, e.g. `Healthy`, `Progressing` or `Degraded`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"deployed_revision": {
+				Description: "Revision the application is synced to.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"operation_phase": {
+				Description: "Phase of the last sync operation of the application, e.g. `Running`, `Succeeded` or `Failed`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"operation_message": {
+				Description: "Message of the last sync operation of the application.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+
+	return resource
+}
+
+func resourceGitopsApplicationSyncCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
+
+	agentId := d.Get("agent_id").(string)
+	appName := d.Get("application_id").(string)
+	orgId := d.Get("org_id").(string)
+	projectId := d.Get("project_id").(string)
+
+	// The operation state of the application still describes the previous sync until the agent starts the one
+	// triggered here, so its start time is recorded to tell them apart.
+	app, httpResp, err := getGitopsApplication(ctx, c, d)
+	if err != nil {
+		return helpers.HandleApiError(err, d, httpResp)
+	}
+	if app == nil {
+		return diag.Errorf("GitOps application %s not found", appName)
+	}
+	previousStartedAt, err := getApplicationOperationStartedAt(app)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, httpResp, err = c.ApplicationsApiService.AgentApplicationServiceSync(ctx, buildApplicationSyncRequest(d), agentId, appName, &nextgen.ApplicationsApiAgentApplicationServiceSyncOpts{
+		AccountIdentifier: optional.NewString(c.AccountId),
+		OrgIdentifier:     optional.NewString(orgId),
+		ProjectIdentifier: optional.NewString(projectId),
+	})
+	if err != nil {
+		return helpers.HandleApiError(err, d, httpResp)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", agentId, appName))
+	log.Printf("[INFO] Triggered a sync of GitOps application %s", appName)
+
+	waitForSync := d.Get("wait_for_sync").(bool)
+	waitForHealthy := d.Get("wait_for_healthy").(bool)
+	if !d.Get("dry_run").(bool) && (waitForSync || waitForHealthy) {
+		if err := waitForGitopsApplicationSync(ctx, c, d, previousStartedAt, waitForSync, waitForHealthy, d.Timeout(schema.TimeoutCreate)); err != nil {
+			diags := resourceGitopsApplicationSyncRead(ctx, d, meta)
+			return append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Error waiting for the sync of GitOps application %s", appName),
+				Detail:   err.Error(),
+			})
+		}
+	}
+
+	return resourceGitopsApplicationSyncRead(ctx, d, meta)
+}
+
+func resourceGitopsApplicationSyncRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
+
+	app, httpResp, err := getGitopsApplication(ctx, c, d)
+	if err != nil {
+		return helpers.HandleReadApiError(err, d, httpResp)
+	}
+
+	if app == nil {
+		d.SetId("")
+		d.MarkNewResource()
+		return nil
+	}
+
+	setApplicationSyncStatus(d, app)
+	return nil
+}
+
+func resourceGitopsApplicationSyncDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+func buildApplicationSyncRequest(d *schema.ResourceData) nextgen.ApplicationsApplicationSyncRequest {
+	request := nextgen.ApplicationsApplicationSyncRequest{
+		Name:     d.Get("application_id").(string),
+		Revision: d.Get("revision").(string),
+		Prune:    d.Get("prune").(bool),
+		DryRun:   d.Get("dry_run").(bool),
+	}
+
+	if attr, ok := d.GetOk("sync_options"); ok {
+		items := []string{}
+		for _, v := range attr.([]interface{}) {
+			items = append(items, v.(string))
+		}
+		request.SyncOptions = &nextgen.ApplicationsSyncOptions{
+			Items: items,
+		}
+	}
+
+	for _, r := range d.Get("resource").([]interface{}) {
+		res := r.(map[string]interface{})
+		request.Resources = append(request.Resources, nextgen.ApplicationsSyncOperationResource{
+			Group:     res["group"].(string),
+			Kind:      res["kind"].(string),
+			Name:      res["name"].(string),
+			Namespace: res["namespace"].(string),
+		})
+	}
+
+	return request
+}
+
+// getGitopsApplication returns the application to sync, or nil when it doesn't exist anymore.
+func getGitopsApplication(ctx context.Context, c *nextgen.APIClient, d *schema.ResourceData) (*nextgen.Servicev1Application, *http.Response, error) {
+	resp, httpResp, err := c.ApplicationsApiService.AgentApplicationServiceGet(ctx, d.Get("agent_id").(string), d.Get("application_id").(string), c.AccountId, d.Get("org_id").(string), d.Get("project_id").(string), &nextgen.ApplicationsApiAgentApplicationServiceGetOpts{})
+	if err != nil {
+		return nil, httpResp, err
+	}
+
+	if resp.App == nil {
+		return nil, httpResp, nil
+	}
+	return &resp, httpResp, nil
+}
+
+// waitForGitopsApplicationSync waits until the sync operation is over and the application reports the expected sync
+// and health statuses. The statuses are only looked at once an operation started after previousStartedAt, the start
+// of the last operation before the sync was triggered, so that the outcome of a previous sync isn't mistaken for the
+// outcome of this one. The application is expected to be healthy once synced, a degraded application is waited for
+// until the timeout as it may still be rolling out.
+func waitForGitopsApplicationSync(ctx context.Context, c *nextgen.APIClient, d *schema.ResourceData, previousStartedAt time.Time, waitForSync bool, waitForHealthy bool, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{applicationSyncPending},
+		Target:     []string{applicationSyncDone},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			app, _, err := getGitopsApplication(ctx, c, d)
+			if err != nil {
+				return nil, "", err
+			}
+			if app == nil {
+				return nil, "", fmt.Errorf("application %s not found", d.Get("application_id").(string))
+			}
+
+			syncStatus, healthStatus, _, phase, message := getApplicationSyncStatus(app)
+			startedAt, err := getApplicationOperationStartedAt(app)
+			if err != nil {
+				return nil, "", err
+			}
+
+			if startedAt.IsZero() && phase != "" {
+				// Without a start time the operation can't be told apart from the previous one, its status is used as is.
+				log.Printf("[WARN] The last sync operation of GitOps application %s has no start time", app.Name)
+			} else if !startedAt.After(previousStartedAt) {
+				log.Printf("[DEBUG] Waiting for the sync operation of GitOps application %s to start", app.Name)
+				return app, applicationSyncPending, nil
+			}
+
+			for _, p := range applicationSyncFailedPhases {
+				if phase == p {
+					return nil, "", fmt.Errorf("sync operation %s: %s", phase, message)
+				}
+			}
+
+			log.Printf("[DEBUG] GitOps application %s is %s and %s, last operation %s", app.Name, syncStatus, healthStatus, phase)
+			if phase == "Running" || (waitForSync && syncStatus != applicationSyncStatusSynced) || (waitForHealthy && healthStatus != applicationHealthStatusHealthy) {
+				return app, applicationSyncPending, nil
+			}
+			return app, applicationSyncDone, nil
+		},
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func setApplicationSyncStatus(d *schema.ResourceData, app *nextgen.Servicev1Application) {
+	syncStatus, healthStatus, revision, phase, message := getApplicationSyncStatus(app)
+	d.Set("sync_status", syncStatus)
+	d.Set("health_status", healthStatus)
+	d.Set("deployed_revision", revision)
+	d.Set("operation_phase", phase)
+	d.Set("operation_message", message)
+}
+
+// getApplicationSyncStatus returns the sync status, health status, synced revision and the phase and message of the
+// last sync operation of the application.
+func getApplicationSyncStatus(app *nextgen.Servicev1Application) (string, string, string, string, string) {
+	var syncStatus, healthStatus, revision, phase, message string
+	if app.App == nil || app.App.Status == nil {
+		return syncStatus, healthStatus, revision, phase, message
+	}
+
+	status := app.App.Status
+	if status.Sync != nil {
+		syncStatus = status.Sync.Status
+		revision = status.Sync.Revision
+	}
+	if status.Health != nil {
+		healthStatus = status.Health.Status
+	}
+	if status.OperationState != nil {
+		phase = status.OperationState.Phase
+		message = status.OperationState.Message
+	}
+	return syncStatus, healthStatus, revision, phase, message
+}
+
+// getApplicationOperationStartedAt returns the time the last sync operation of the application started at, or the zero
+// time when the application reports no start time.
+func getApplicationOperationStartedAt(app *nextgen.Servicev1Application) (time.Time, error) {
+	if app.App == nil || app.App.Status == nil || app.App.Status.OperationState == nil || app.App.Status.OperationState.StartedAt == nil {
+		return time.Time{}, nil
+	}
+
+	startedAt := app.App.Status.OperationState.StartedAt
+	seconds, err := strconv.ParseInt(startedAt.Seconds, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid start time %q of the last sync operation of application %s: %w", startedAt.Seconds, app.Name, err)
+	}
+	return time.Unix(seconds, int64(startedAt.Nanos)), nil
+}
@@ -0,0 +1,55 @@
+package applications_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/harness/harness-go-sdk/harness/utils"
+	"github.com/harness/terraform-provider-harness/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceGitopsApplicationSync(t *testing.T) {
+	id := strings.ToLower(fmt.Sprintf("%s%s", t.Name(), utils.RandStringBytes(5)))
+	id = strings.ReplaceAll(id, "_", "")
+	name := id
+	agentId := os.Getenv("HARNESS_TEST_GITOPS_AGENT_ID")
+	accountId := os.Getenv("HARNESS_ACCOUNT_ID")
+	clusterServer := os.Getenv("HARNESS_TEST_GITOPS_CLUSTER_SERVER_APP")
+	clusterId := os.Getenv("HARNESS_TEST_GITOPS_CLUSTER_ID")
+	repoId := os.Getenv("HARNESS_TEST_GITOPS_REPO_ID")
+	repo := os.Getenv("HARNESS_TEST_GITOPS_REPO")
+	resourceName := "harness_platform_gitops_application_sync.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.TestAccPreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceGitopsApplicationSync(id, accountId, name, agentId, id, "test", clusterServer, clusterId, repo, repoId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s/%s", agentId, id)),
+					resource.TestCheckResourceAttr(resourceName, "sync_status", "Synced"),
+					resource.TestCheckResourceAttr(resourceName, "health_status", "Healthy"),
+					resource.TestCheckResourceAttrSet(resourceName, "deployed_revision"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceGitopsApplicationSync(id string, accountId string, name string, agentId string, clusterName string, namespace string, clusterServer string, clusterId string, repo string, repoId string) string {
+	return fmt.Sprintf(`
+		%s
+
+		resource "harness_platform_gitops_application_sync" "test" {
+			org_id = harness_platform_gitops_applications.test.org_id
+			project_id = harness_platform_gitops_applications.test.project_id
+			agent_id = harness_platform_gitops_applications.test.agent_id
+			application_id = harness_platform_gitops_applications.test.identifier
+			prune = true
+			sync_options = ["CreateNamespace=true"]
+		}
+		`, testAccResourceGitopsApplicationHelm(id, accountId, name, agentId, clusterName, namespace, clusterServer, clusterId, repo, repoId))
+}
@@ -218,445 +218,7 @@ func ResourceGitopsApplication() *schema.Resource {
 								},
 							},
 						},
-						"spec": {
-							Description: "Specifications of the GitOps application. This includes the repository URL, application definition, source, destination and sync policy.",
-							Type:        schema.TypeList,
-							Optional:    true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"source": {
-										Description: "Contains all information about the source of the GitOps application.",
-										Type:        schema.TypeList,
-										Optional:    true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"repo_url": {
-													Description: "URL to the repository (git or helm) that contains the GitOps application manifests.",
-													Type:        schema.TypeString,
-													Required:    true,
-												},
-												"path": {
-													Description: "Directory path within the git repository, and is only valid for the GitOps applications sourced from git.",
-													Type:        schema.TypeString,
-													Optional:    true,
-												},
-												"target_revision": {
-													Description: "Revision of the source to sync the GitOps application to. In case of git, this can be commit, tag, or branch. If omitted, will equal to HEAD. In case of Helm, this is a semver tag of the chart's version.",
-													Type:        schema.TypeString,
-													Required:    true,
-												},
-												"chart": {
-													Description: "Helm chart name, and must be specified for the GitOps applications sourced from a helm repo.",
-													Type:        schema.TypeString,
-													Optional:    true,
-												},
-												"helm": {
-													Description: "Holds helm specific options.",
-													Type:        schema.TypeList,
-													Optional:    true,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"value_files": {
-																Description: "List of helm value files to use when generating a template.",
-																Type:        schema.TypeList,
-																Optional:    true,
-																Elem: &schema.Schema{
-																	Type: schema.TypeString,
-																},
-															},
-															"release_name": {
-																Description: "Helm release name to use. If omitted it will use the GitOps application name.",
-																Type:        schema.TypeString,
-																Optional:    true,
-															},
-															"values": {
-																Description: "Helm values to be passed to helm template, typically defined as a block.",
-																Type:        schema.TypeString,
-																Optional:    true,
-															},
-															"version": {
-																Description: "Helm version to use for templating (either \"2\" or \"3\")",
-																Type:        schema.TypeString,
-																Optional:    true,
-															},
-															"pass_credentials": {
-																Description: "Indicates if to pass credentials to all domains (helm's --pass-credentials)",
-																Type:        schema.TypeBool,
-																Optional:    true,
-															},
-															"parameters": {
-																Description: "List of helm parameters which are passed to the helm template command upon manifest generation.",
-																Type:        schema.TypeList,
-																Optional:    true,
-																Elem: &schema.Resource{
-																	Schema: map[string]*schema.Schema{
-																		"name": {
-																			Description: "Name of the helm parameter.",
-																			Type:        schema.TypeString,
-																			Optional:    true,
-																		},
-																		"value": {
-																			Description: "Value of the Helm parameter.",
-																			Type:        schema.TypeString,
-																			Optional:    true,
-																		},
-																		"force_string": {
-																			Description: "Indicates if helm should interpret booleans and numbers as strings.",
-																			Type:        schema.TypeBool,
-																			Optional:    true,
-																		},
-																	},
-																},
-															},
-															"file_parameters": {
-																Description: "File parameters to the helm template.",
-																Type:        schema.TypeList,
-																Optional:    true,
-																Elem: &schema.Resource{
-																	Schema: map[string]*schema.Schema{
-																		"name": {
-																			Description: "Name of the helm parameter.",
-																			Type:        schema.TypeString,
-																			Optional:    true,
-																		},
-																		"path": {
-																			Description: "Path to the file containing the values of the helm parameter.",
-																			Type:        schema.TypeString,
-																			Optional:    true,
-																		},
-																	},
-																},
-															},
-														},
-													},
-												},
-												"kustomize": {
-													Description: "Options specific to a GitOps application source specific to Kustomize.",
-													Type:        schema.TypeList,
-													Optional:    true,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"name_prefix": {
-																Description: "Prefix prepended to resources for kustomize apps.",
-																Type:        schema.TypeString,
-																Optional:    true,
-															},
-															"name_suffix": {
-																Description: "Suffix appended to resources for kustomize apps.",
-																Type:        schema.TypeString,
-																Optional:    true,
-															},
-															"images": {
-																Description: "List of kustomize image override specifications.",
-																Type:        schema.TypeList,
-																Optional:    true,
-																Elem: &schema.Schema{
-																	Type: schema.TypeString,
-																},
-															},
-															"common_labels": {
-																Description: "List of additional labels to add to rendered manifests.",
-																Type:        schema.TypeMap,
-																Optional:    true,
-																Elem: &schema.Schema{
-																	Type: schema.TypeString,
-																},
-															},
-															"version": {
-																Description: "Version of kustomize to use for rendering manifests.",
-																Type:        schema.TypeString,
-																Optional:    true,
-															},
-															"common_annotations": {
-																Description: "List of additional annotations to add to rendered manifests.",
-																Type:        schema.TypeMap,
-																Optional:    true,
-																Elem: &schema.Schema{
-																	Type: schema.TypeString,
-																},
-															},
-															"force_common_labels": {
-																Description: "Indicates if to force apply common labels to resources for kustomize apps.",
-																Type:        schema.TypeBool,
-																Optional:    true,
-															},
-															"force_common_annotations": {
-																Description: "Indicates if to force applying common annotations to resources for kustomize apps.",
-																Type:        schema.TypeBool,
-																Optional:    true,
-															},
-														},
-													},
-												},
-												"ksonnet": {
-													Description: "Ksonnet specific options.",
-													Type:        schema.TypeList,
-													Optional:    true,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"environment": {
-																Description: "Ksonnet application environment name.",
-																Type:        schema.TypeString,
-																Optional:    true,
-															},
-															"parameters": {
-																Description: "List of ksonnet component parameter override values.",
-																Type:        schema.TypeList,
-																Optional:    true,
-																Elem: &schema.Resource{
-																	Schema: map[string]*schema.Schema{
-																		"component": {
-																			Description: "Component of the parameter of the ksonnet application.",
-																			Type:        schema.TypeString,
-																			Optional:    true,
-																		},
-																		"name": {
-																			Description: "Name of the parameter of the ksonnet application.",
-																			Type:        schema.TypeString,
-																			Optional:    true,
-																		},
-																		"value": {
-																			Description: "Value of the parameter of the ksonnet application.",
-																			Type:        schema.TypeString,
-																			Optional:    true,
-																		},
-																	},
-																},
-															},
-														},
-													},
-												},
-												"directory": {
-													Description: "Options for applications of type plain YAML or Jsonnet.",
-													Type:        schema.TypeList,
-													Optional:    true,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"recurse": {
-																Description: "Indicates to scan a directory recursively for manifests.",
-																Type:        schema.TypeBool,
-																Optional:    true,
-															},
-															"exclude": {
-																Description: "Glob pattern to match paths against that should be explicitly excluded from being used during manifest generation.",
-																Type:        schema.TypeString,
-																Optional:    true,
-															},
-															"include": {
-																Description: "Glob pattern to match paths against that should be explicitly included during manifest generation.",
-																Type:        schema.TypeString,
-																Optional:    true,
-															},
-															"jsonnet": {
-																Description: "Options specific to applications of type Jsonnet.",
-																Type:        schema.TypeList,
-																Optional:    true,
-																Elem: &schema.Resource{
-																	Schema: map[string]*schema.Schema{
-																		"libs": {
-																			Description: "Additional library search dirs.",
-																			Type:        schema.TypeList,
-																			Optional:    true,
-																			Elem: &schema.Schema{
-																				Type: schema.TypeString,
-																			},
-																		},
-																		"ext_vars": {
-																			Description: "List of jsonnet external variables.",
-																			Type:        schema.TypeList,
-																			Optional:    true,
-																			Elem: &schema.Resource{
-																				Schema: map[string]*schema.Schema{
-																					"name": {
-																						Description: "Name of the external variables of jsonnet application.",
-																						Type:        schema.TypeString,
-																						Optional:    true,
-																					},
-																					"value": {
-																						Description: "Value of the external variables of jsonnet application.",
-																						Type:        schema.TypeString,
-																						Optional:    true,
-																					},
-																					"code": {
-																						Description: "Code of the external variables of jsonnet application.",
-																						Type:        schema.TypeBool,
-																						Optional:    true,
-																					},
-																				},
-																			},
-																		},
-																		"tlas": {
-																			Description: "List of jsonnet top-level arguments(TLAS).",
-																			Type:        schema.TypeList,
-																			Optional:    true,
-																			Elem: &schema.Resource{
-																				Schema: map[string]*schema.Schema{
-																					"name": {
-																						Description: "Name of the TLAS of the jsonnet application.",
-																						Type:        schema.TypeString,
-																						Optional:    true,
-																					},
-																					"value": {
-																						Description: "Value of the TLAS of the jsonnet application.",
-																						Type:        schema.TypeString,
-																						Optional:    true,
-																					},
-																					"code": {
-																						Description: "Code of the TLAS of the jsonnet application.",
-																						Type:        schema.TypeBool,
-																						Optional:    true,
-																					},
-																				},
-																			},
-																		},
-																	},
-																},
-															},
-														},
-													},
-												},
-												"plugin": {
-													Description: "Options specific to config management plugins.",
-													Type:        schema.TypeList,
-													Optional:    true,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"name": {
-																Description: "Name of the plugin.",
-																Type:        schema.TypeString,
-																Optional:    true,
-															},
-															"env": {
-																Description: "Entry in the GitOps application's environment.",
-																Type:        schema.TypeList,
-																Optional:    true,
-																Elem: &schema.Resource{
-																	Schema: map[string]*schema.Schema{
-																		"name": {
-																			Description: "Name of the variable, usually expressed in uppercase.",
-																			Type:        schema.TypeString,
-																			Optional:    true,
-																		},
-																		"value": {
-																			Description: "Value of the variable.",
-																			Type:        schema.TypeString,
-																			Optional:    true,
-																		},
-																	},
-																},
-															},
-														},
-													},
-												},
-											},
-										},
-									},
-									"destination": {
-										Description: "Information about the GitOps application's destination.",
-										Type:        schema.TypeList,
-										Optional:    true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"name": {
-													Description: "URL of the target cluster and must be set to the kubernetes control plane API.",
-													Type:        schema.TypeString,
-													Optional:    true,
-												},
-												"namespace": {
-													Description: "Target namespace of the GitOps application's resources. The namespace will only be set for namespace-scoped resources that have not set a value for .metadata.namespace.",
-													Type:        schema.TypeString,
-													Optional:    true,
-												},
-												"server": {
-													Description: "URL of the target cluster server for the GitOps application.",
-													Type:        schema.TypeString,
-													Optional:    true,
-												},
-											},
-										},
-									},
-									"sync_policy": {
-										Description: "Controls when a sync will be performed in response to updates in git.",
-										Type:        schema.TypeList,
-										Optional:    true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"sync_options": {
-													Description: "Options allow you to specify whole app sync-options.",
-													Type:        schema.TypeList,
-													Optional:    true,
-													Elem: &schema.Schema{
-														Type: schema.TypeString,
-													},
-												},
-												"automated": {
-													Description: "Controls the behavior of an automated sync.",
-													Type:        schema.TypeList,
-													Optional:    true,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"prune": {
-																Description: "Indicates whether to delete resources from the cluster that are not found in the sources anymore as part of automated sync (default: false).",
-																Type:        schema.TypeBool,
-																Optional:    true,
-															},
-															"self_heal": {
-																Description: "Indicates whether to revert resources back to their desired state upon modification in the cluster (default: false).",
-																Type:        schema.TypeBool,
-																Optional:    true,
-															},
-															"allow_empty": {
-																Description: "Indicates to allows apps to have zero live resources (default: false).",
-																Type:        schema.TypeBool,
-																Optional:    true,
-															},
-														},
-													},
-												},
-												"retry": {
-													Description: "Contains information about the strategy to apply when a sync failed.",
-													Type:        schema.TypeList,
-													Optional:    true,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"limit": {
-																Description: "Limit is the maximum number of attempts for retrying a failed sync. If set to 0, no retries will be performed.",
-																Type:        schema.TypeString,
-																Optional:    true,
-															},
-															"backoff": {
-																Description: "Backoff strategy to use on subsequent retries for failing syncs.",
-																Type:        schema.TypeList,
-																Optional:    true,
-																Elem: &schema.Resource{
-																	Schema: map[string]*schema.Schema{
-																		"duration": {
-																			Description: "Amount to back off. Default unit is seconds, but could also be a duration (e.g. \"2m\", \"1h\").",
-																			Type:        schema.TypeString,
-																			Optional:    true,
-																		},
-																		"factor": {
-																			Description: "Factor to multiply the base duration after each failed retry.",
-																			Type:        schema.TypeString,
-																			Optional:    true,
-																		},
-																		"max_duration": {
-																			Description: "Maximum amount of time allowed of the backoff strategy.",
-																			Type:        schema.TypeString,
-																			Optional:    true,
-																		},
-																	},
-																},
-															},
-														},
-													},
-												},
-											},
-										},
-									},
-								},
-							},
-						},
+						"spec": getApplicationSpecSchema(),
 					},
 				},
 			},
@@ -860,183 +422,7 @@ func setApplication(d *schema.ResourceData, app *nextgen.Servicev1Application) {
 			application["metadata"] = metadataList
 		}
 		if app.App.Spec != nil {
-			var specList = []interface{}{}
-			var spec = map[string]interface{}{}
-			if app.App.Spec.Source != nil {
-				var sourceList = []interface{}{}
-				var source = map[string]interface{}{}
-				source["repo_url"] = app.App.Spec.Source.RepoURL
-				source["path"] = app.App.Spec.Source.Path
-				source["target_revision"] = app.App.Spec.Source.TargetRevision
-				source["chart"] = app.App.Spec.Source.Chart
-				if app.App.Spec.Source.Helm != nil {
-					var helmList = []interface{}{}
-					var helm = map[string]interface{}{}
-					if app.App.Spec.Source.Helm.ValueFiles != nil && len(app.App.Spec.Source.Helm.ValueFiles) > 0 {
-						helm["value_files"] = app.App.Spec.Source.Helm.ValueFiles
-					}
-					helm["release_name"] = app.App.Spec.Source.Helm.ReleaseName
-					helm["values"] = app.App.Spec.Source.Helm.Values
-					helm["version"] = app.App.Spec.Source.Helm.Version
-					helm["pass_credentials"] = app.App.Spec.Source.Helm.PassCredentials
-					if app.App.Spec.Source.Helm.Parameters != nil && len(app.App.Spec.Source.Helm.Parameters) > 0 {
-						var helmParametersList = []interface{}{}
-						for _, v := range app.App.Spec.Source.Helm.Parameters {
-							var helmParam = map[string]interface{}{}
-							helmParam["name"] = v.Name
-							helmParam["value"] = v.Value
-							helmParam["force_string"] = v.ForceString
-							helmParametersList = append(helmParametersList, helmParam)
-						}
-						helm["parameters"] = helmParametersList
-					}
-					if app.App.Spec.Source.Helm.FileParameters != nil && len(app.App.Spec.Source.Helm.FileParameters) > 0 {
-						var helmFileParametersList = []interface{}{}
-						for _, v := range app.App.Spec.Source.Helm.FileParameters {
-							var helmParam = map[string]interface{}{}
-							helmParam["name"] = v.Name
-							helmParam["path"] = v.Path
-							helmFileParametersList = append(helmFileParametersList, helmParam)
-						}
-						helm["file_parameters"] = helmFileParametersList
-					}
-
-					helmList = append(helmList, helm)
-					source["helm"] = helmList
-				}
-				if app.App.Spec.Source.Kustomize != nil {
-					var kustomizeList = []interface{}{}
-					var kustomize = map[string]interface{}{}
-					kustomize["name_prefix"] = app.App.Spec.Source.Kustomize.NamePrefix
-					kustomize["name_suffix"] = app.App.Spec.Source.Kustomize.NameSuffix
-					kustomize["images"] = app.App.Spec.Source.Kustomize.Images
-					kustomize["common_labels"] = app.App.Spec.Source.Kustomize.CommonLabels
-					kustomize["version"] = app.App.Spec.Source.Kustomize.Version
-					kustomize["common_annotations"] = app.App.Spec.Source.Kustomize.CommonAnnotations
-					kustomize["force_common_labels"] = app.App.Spec.Source.Kustomize.ForceCommonLabels
-					kustomize["force_common_annotations"] = app.App.Spec.Source.Kustomize.ForceCommonAnnotations
-					kustomizeList = append(kustomizeList, kustomize)
-					source["kustomize"] = kustomizeList
-				}
-				if app.App.Spec.Source.Ksonnet != nil {
-					var ksonnetList = []interface{}{}
-					var ksonnet = map[string]interface{}{}
-					ksonnet["environment"] = app.App.Spec.Source.Ksonnet.Environment
-					var ksonnetParamList = []interface{}{}
-					for _, v := range app.App.Spec.Source.Ksonnet.Parameters {
-						var ksonnetParam = map[string]interface{}{}
-						ksonnetParam["component"] = v.Component
-						ksonnetParam["name"] = v.Name
-						ksonnetParam["value"] = v.Value
-						ksonnetParamList = append(ksonnetParamList, ksonnetParam)
-					}
-					ksonnet["parameters"] = ksonnetParamList
-					ksonnetList = append(ksonnetList, ksonnet)
-					source["ksonnet"] = ksonnetList
-				}
-				if app.App.Spec.Source.Directory != nil {
-					var directoryList = []interface{}{}
-					var directory = map[string]interface{}{}
-					directory["recurse"] = app.App.Spec.Source.Directory.Recurse
-					directory["exclude"] = app.App.Spec.Source.Directory.Exclude
-					directory["include"] = app.App.Spec.Source.Directory.Include
-					if app.App.Spec.Source.Directory.Jsonnet != nil {
-						var jsonnetList = []interface{}{}
-						var jsonnet = map[string]interface{}{}
-						jsonnet["libs"] = app.App.Spec.Source.Directory.Jsonnet.Libs
-						if app.App.Spec.Source.Directory.Jsonnet.ExtVars != nil {
-							var jsonnetExtVarsList = []interface{}{}
-							for _, v := range app.App.Spec.Source.Directory.Jsonnet.ExtVars {
-								var jsonnetExtVars = map[string]interface{}{}
-								jsonnetExtVars["name"] = v.Name
-								jsonnetExtVars["value"] = v.Value
-								jsonnetExtVars["code"] = v.Code
-								jsonnetExtVarsList = append(jsonnetExtVarsList, jsonnetExtVars)
-							}
-							jsonnet["ext_vars"] = jsonnetExtVarsList
-						}
-						if app.App.Spec.Source.Directory.Jsonnet.Tlas != nil {
-							var jsonnetTlasList = []interface{}{}
-							for _, v := range app.App.Spec.Source.Directory.Jsonnet.Tlas {
-								var jsonnetTlas = map[string]interface{}{}
-								jsonnetTlas["name"] = v.Name
-								jsonnetTlas["value"] = v.Value
-								jsonnetTlas["code"] = v.Code
-								jsonnetTlasList = append(jsonnetTlasList, jsonnetTlas)
-							}
-							jsonnet["tlas"] = jsonnetTlasList
-						}
-						jsonnetList = append(jsonnetList, jsonnet)
-						directory["jsonnet"] = jsonnetList
-					}
-					directoryList = append(directoryList, directory)
-					source["directory"] = directoryList
-				}
-				if app.App.Spec.Source.Plugin != nil {
-					var pluginList = []interface{}{}
-					var plugin = map[string]interface{}{}
-					plugin["name"] = app.App.Spec.Source.Plugin.Name
-					var pluginEnvList = []interface{}{}
-					for _, v := range app.App.Spec.Source.Plugin.Env {
-						var pluginEnv = map[string]interface{}{}
-						pluginEnv["name"] = v.Name
-						pluginEnv["value"] = v.Value
-						pluginList = append(pluginEnvList, pluginEnv)
-					}
-					plugin["env"] = pluginEnvList
-					pluginList = append(pluginList, plugin)
-					source["plugin"] = pluginList
-				}
-				sourceList = append(sourceList, source)
-				spec["source"] = sourceList
-			}
-			//destination
-			if app.App.Spec.Destination != nil {
-				var destinationList = []interface{}{}
-				var destination = map[string]interface{}{}
-				destination["name"] = app.App.Spec.Destination.Name
-				destination["namespace"] = app.App.Spec.Destination.Namespace
-				destination["server"] = app.App.Spec.Destination.Server
-				destinationList = append(destinationList, destination)
-				spec["destination"] = destinationList
-			}
-			//sync policy
-			if app.App.Spec.SyncPolicy != nil {
-				var syncPolicyList = []interface{}{}
-				var syncPolicy = map[string]interface{}{}
-				syncPolicy["sync_options"] = app.App.Spec.SyncPolicy.SyncOptions
-				if app.App.Spec.SyncPolicy.Automated != nil {
-					var syncPolicyAutomatedList = []interface{}{}
-					var syncPolicyAutomated = map[string]interface{}{}
-					syncPolicyAutomated["prune"] = app.App.Spec.SyncPolicy.Automated.Prune
-					syncPolicyAutomated["self_heal"] = app.App.Spec.SyncPolicy.Automated.SelfHeal
-					syncPolicyAutomated["allow_empty"] = app.App.Spec.SyncPolicy.Automated.AllowEmpty
-					syncPolicyAutomatedList = append(syncPolicyAutomatedList, syncPolicyAutomated)
-					syncPolicy["automated"] = syncPolicyAutomatedList
-				}
-				if app.App.Spec.SyncPolicy.Retry != nil {
-					var syncPolicyRetryList = []interface{}{}
-					var syncPolicyRetry = map[string]interface{}{}
-					syncPolicyRetry["limit"] = app.App.Spec.SyncPolicy.Retry.Limit
-					if app.App.Spec.SyncPolicy.Retry.Backoff != nil {
-						var syncPolicyRetryBackoffList = []interface{}{}
-						var syncPolicyRetryBackoff = map[string]interface{}{}
-						syncPolicyRetryBackoff["duration"] = app.App.Spec.SyncPolicy.Retry.Backoff.Duration
-						syncPolicyRetryBackoff["factor"] = app.App.Spec.SyncPolicy.Retry.Backoff.Factor
-						syncPolicyRetryBackoff["max_duration"] = app.App.Spec.SyncPolicy.Retry.Backoff.MaxDuration
-						syncPolicyRetryBackoffList = append(syncPolicyRetryBackoffList, syncPolicyRetryBackoff)
-						syncPolicyRetry["backoff"] = syncPolicyRetryBackoffList
-					}
-					syncPolicyRetryList = append(syncPolicyRetryList, syncPolicyRetry)
-					syncPolicy["retry"] = syncPolicyRetryList
-				}
-
-				syncPolicyList = append(syncPolicyList, syncPolicy)
-				spec["sync_policy"] = syncPolicyList
-			}
-
-			specList = append(specList, spec)
-			application["spec"] = specList
+			application["spec"] = []interface{}{flattenApplicationSpec(app.App.Spec)}
 		}
 		applicationList = append(applicationList, application)
 		d.Set("application", applicationList)
@@ -1144,329 +530,954 @@ func buildApplicationRequest(d *schema.ResourceData) *nextgen.ApplicationsApplic
 			}
 
 			if application["spec"] != nil && len(application["spec"].([]interface{})) > 0 {
-				var specData map[string]interface{}
-				specData = application["spec"].([]interface{})[0].(map[string]interface{})
-				//Spec Source
-				if specData["source"] != nil && len(specData["source"].([]interface{})) > 0 {
-					var specSource nextgen.ApplicationsApplicationSource
-					var source = specData["source"].([]interface{})[0].(map[string]interface{})
-					if source["repo_url"] != nil && len(source["repo_url"].(string)) > 0 {
-						specSource.RepoURL = source["repo_url"].(string)
-					}
-					if source["path"] != nil && len(source["path"].(string)) > 0 {
-						specSource.Path = source["path"].(string)
-					}
-					if source["target_revision"] != nil && len(source["target_revision"].(string)) > 0 {
-						specSource.TargetRevision = source["target_revision"].(string)
-					}
-					if source["chart"] != nil && len(source["chart"].(string)) > 0 {
-						specSource.Chart = source["chart"].(string)
-					}
-					//Helm Source Details
-					if source["helm"] != nil && len(source["helm"].([]interface{})) > 0 {
-						var helm = source["helm"].([]interface{})[0].(map[string]interface{})
-						var helmData nextgen.ApplicationsApplicationSourceHelm
-						if helm["value_files"] != nil && len(helm["value_files"].([]interface{})) > 0 {
-							var valueFiles []string
-							for _, v := range helm["value_files"].([]interface{}) {
-								valueFiles = append(valueFiles, v.(string))
-							}
-							helmData.ValueFiles = valueFiles
-						}
-						if helm["release_name"] != nil && len(helm["release_name"].(string)) > 0 {
-							helmData.ReleaseName = helm["release_name"].(string)
-						}
-						if helm["values"] != nil && len(helm["values"].(string)) > 0 {
-							helmData.Values = helm["values"].(string)
+				spec = buildApplicationSpec(application["spec"].([]interface{})[0].(map[string]interface{}))
+			}
+		}
+	}
+	return &nextgen.ApplicationsApplication{
+		Metadata: &metaData,
+		Spec:     &spec,
+	}
+}
+
+// getApplicationSpecSchema returns the schema of the specification of a GitOps application, shared with the
+// template of the applications generated by an ApplicationSet.
+func getApplicationSpecSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Specifications of the GitOps application. This includes the repository URL, application definition, source, destination and sync policy.",
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"source": {
+					Description: "Contains all information about the source of the GitOps application.",
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"repo_url": {
+								Description: "URL to the repository (git or helm) that contains the GitOps application manifests.",
+								Type:        schema.TypeString,
+								Required:    true,
+							},
+							"path": {
+								Description: "Directory path within the git repository, and is only valid for the GitOps applications sourced from git.",
+								Type:        schema.TypeString,
+								Optional:    true,
+							},
+							"target_revision": {
+								Description: "Revision of the source to sync the GitOps application to. In case of git, this can be commit, tag, or branch. If omitted, will equal to HEAD. In case of Helm, this is a semver tag of the chart's version.",
+								Type:        schema.TypeString,
+								Required:    true,
+							},
+							"chart": {
+								Description: "Helm chart name, and must be specified for the GitOps applications sourced from a helm repo.",
+								Type:        schema.TypeString,
+								Optional:    true,
+							},
+							"helm": {
+								Description: "Holds helm specific options.",
+								Type:        schema.TypeList,
+								Optional:    true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"value_files": {
+											Description: "List of helm value files to use when generating a template.",
+											Type:        schema.TypeList,
+											Optional:    true,
+											Elem: &schema.Schema{
+												Type: schema.TypeString,
+											},
+										},
+										"release_name": {
+											Description: "Helm release name to use. If omitted it will use the GitOps application name.",
+											Type:        schema.TypeString,
+											Optional:    true,
+										},
+										"values": {
+											Description: "Helm values to be passed to helm template, typically defined as a block.",
+											Type:        schema.TypeString,
+											Optional:    true,
+										},
+										"version": {
+											Description: "Helm version to use for templating (either \"2\" or \"3\")",
+											Type:        schema.TypeString,
+											Optional:    true,
+										},
+										"pass_credentials": {
+											Description: "Indicates if to pass credentials to all domains (helm's --pass-credentials)",
+											Type:        schema.TypeBool,
+											Optional:    true,
+										},
+										"parameters": {
+											Description: "List of helm parameters which are passed to the helm template command upon manifest generation.",
+											Type:        schema.TypeList,
+											Optional:    true,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"name": {
+														Description: "Name of the helm parameter.",
+														Type:        schema.TypeString,
+														Optional:    true,
+													},
+													"value": {
+														Description: "Value of the Helm parameter.",
+														Type:        schema.TypeString,
+														Optional:    true,
+													},
+													"force_string": {
+														Description: "Indicates if helm should interpret booleans and numbers as strings.",
+														Type:        schema.TypeBool,
+														Optional:    true,
+													},
+												},
+											},
+										},
+										"file_parameters": {
+											Description: "File parameters to the helm template.",
+											Type:        schema.TypeList,
+											Optional:    true,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"name": {
+														Description: "Name of the helm parameter.",
+														Type:        schema.TypeString,
+														Optional:    true,
+													},
+													"path": {
+														Description: "Path to the file containing the values of the helm parameter.",
+														Type:        schema.TypeString,
+														Optional:    true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							"kustomize": {
+								Description: "Options specific to a GitOps application source specific to Kustomize.",
+								Type:        schema.TypeList,
+								Optional:    true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"name_prefix": {
+											Description: "Prefix prepended to resources for kustomize apps.",
+											Type:        schema.TypeString,
+											Optional:    true,
+										},
+										"name_suffix": {
+											Description: "Suffix appended to resources for kustomize apps.",
+											Type:        schema.TypeString,
+											Optional:    true,
+										},
+										"images": {
+											Description: "List of kustomize image override specifications.",
+											Type:        schema.TypeList,
+											Optional:    true,
+											Elem: &schema.Schema{
+												Type: schema.TypeString,
+											},
+										},
+										"common_labels": {
+											Description: "List of additional labels to add to rendered manifests.",
+											Type:        schema.TypeMap,
+											Optional:    true,
+											Elem: &schema.Schema{
+												Type: schema.TypeString,
+											},
+										},
+										"version": {
+											Description: "Version of kustomize to use for rendering manifests.",
+											Type:        schema.TypeString,
+											Optional:    true,
+										},
+										"common_annotations": {
+											Description: "List of additional annotations to add to rendered manifests.",
+											Type:        schema.TypeMap,
+											Optional:    true,
+											Elem: &schema.Schema{
+												Type: schema.TypeString,
+											},
+										},
+										"force_common_labels": {
+											Description: "Indicates if to force apply common labels to resources for kustomize apps.",
+											Type:        schema.TypeBool,
+											Optional:    true,
+										},
+										"force_common_annotations": {
+											Description: "Indicates if to force applying common annotations to resources for kustomize apps.",
+											Type:        schema.TypeBool,
+											Optional:    true,
+										},
+									},
+								},
+							},
+							"ksonnet": {
+								Description: "Ksonnet specific options.",
+								Type:        schema.TypeList,
+								Optional:    true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"environment": {
+											Description: "Ksonnet application environment name.",
+											Type:        schema.TypeString,
+											Optional:    true,
+										},
+										"parameters": {
+											Description: "List of ksonnet component parameter override values.",
+											Type:        schema.TypeList,
+											Optional:    true,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"component": {
+														Description: "Component of the parameter of the ksonnet application.",
+														Type:        schema.TypeString,
+														Optional:    true,
+													},
+													"name": {
+														Description: "Name of the parameter of the ksonnet application.",
+														Type:        schema.TypeString,
+														Optional:    true,
+													},
+													"value": {
+														Description: "Value of the parameter of the ksonnet application.",
+														Type:        schema.TypeString,
+														Optional:    true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							"directory": {
+								Description: "Options for applications of type plain YAML or Jsonnet.",
+								Type:        schema.TypeList,
+								Optional:    true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"recurse": {
+											Description: "Indicates to scan a directory recursively for manifests.",
+											Type:        schema.TypeBool,
+											Optional:    true,
+										},
+										"exclude": {
+											Description: "Glob pattern to match paths against that should be explicitly excluded from being used during manifest generation.",
+											Type:        schema.TypeString,
+											Optional:    true,
+										},
+										"include": {
+											Description: "Glob pattern to match paths against that should be explicitly included during manifest generation.",
+											Type:        schema.TypeString,
+											Optional:    true,
+										},
+										"jsonnet": {
+											Description: "Options specific to applications of type Jsonnet.",
+											Type:        schema.TypeList,
+											Optional:    true,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"libs": {
+														Description: "Additional library search dirs.",
+														Type:        schema.TypeList,
+														Optional:    true,
+														Elem: &schema.Schema{
+															Type: schema.TypeString,
+														},
+													},
+													"ext_vars": {
+														Description: "List of jsonnet external variables.",
+														Type:        schema.TypeList,
+														Optional:    true,
+														Elem: &schema.Resource{
+															Schema: map[string]*schema.Schema{
+																"name": {
+																	Description: "Name of the external variables of jsonnet application.",
+																	Type:        schema.TypeString,
+																	Optional:    true,
+																},
+																"value": {
+																	Description: "Value of the external variables of jsonnet application.",
+																	Type:        schema.TypeString,
+																	Optional:    true,
+																},
+																"code": {
+																	Description: "Code of the external variables of jsonnet application.",
+																	Type:        schema.TypeBool,
+																	Optional:    true,
+																},
+															},
+														},
+													},
+													"tlas": {
+														Description: "List of jsonnet top-level arguments(TLAS).",
+														Type:        schema.TypeList,
+														Optional:    true,
+														Elem: &schema.Resource{
+															Schema: map[string]*schema.Schema{
+																"name": {
+																	Description: "Name of the TLAS of the jsonnet application.",
+																	Type:        schema.TypeString,
+																	Optional:    true,
+																},
+																"value": {
+																	Description: "Value of the TLAS of the jsonnet application.",
+																	Type:        schema.TypeString,
+																	Optional:    true,
+																},
+																"code": {
+																	Description: "Code of the TLAS of the jsonnet application.",
+																	Type:        schema.TypeBool,
+																	Optional:    true,
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							"plugin": {
+								Description: "Options specific to config management plugins.",
+								Type:        schema.TypeList,
+								Optional:    true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"name": {
+											Description: "Name of the plugin.",
+											Type:        schema.TypeString,
+											Optional:    true,
+										},
+										"env": {
+											Description: "Entry in the GitOps application's environment.",
+											Type:        schema.TypeList,
+											Optional:    true,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"name": {
+														Description: "Name of the variable, usually expressed in uppercase.",
+														Type:        schema.TypeString,
+														Optional:    true,
+													},
+													"value": {
+														Description: "Value of the variable.",
+														Type:        schema.TypeString,
+														Optional:    true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"destination": {
+					Description: "Information about the GitOps application's destination.",
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Description: "URL of the target cluster and must be set to the kubernetes control plane API.",
+								Type:        schema.TypeString,
+								Optional:    true,
+							},
+							"namespace": {
+								Description: "Target namespace of the GitOps application's resources. The namespace will only be set for namespace-scoped resources that have not set a value for .metadata.namespace.",
+								Type:        schema.TypeString,
+								Optional:    true,
+							},
+							"server": {
+								Description: "URL of the target cluster server for the GitOps application.",
+								Type:        schema.TypeString,
+								Optional:    true,
+							},
+						},
+					},
+				},
+				"sync_policy": {
+					Description: "Controls when a sync will be performed in response to updates in git.",
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"sync_options": {
+								Description: "Options allow you to specify whole app sync-options.",
+								Type:        schema.TypeList,
+								Optional:    true,
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+							"automated": {
+								Description: "Controls the behavior of an automated sync.",
+								Type:        schema.TypeList,
+								Optional:    true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"prune": {
+											Description: "Indicates whether to delete resources from the cluster that are not found in the sources anymore as part of automated sync (default: false).",
+											Type:        schema.TypeBool,
+											Optional:    true,
+										},
+										"self_heal": {
+											Description: "Indicates whether to revert resources back to their desired state upon modification in the cluster (default: false).",
+											Type:        schema.TypeBool,
+											Optional:    true,
+										},
+										"allow_empty": {
+											Description: "Indicates to allows apps to have zero live resources (default: false).",
+											Type:        schema.TypeBool,
+											Optional:    true,
+										},
+									},
+								},
+							},
+							"retry": {
+								Description: "Contains information about the strategy to apply when a sync failed.",
+								Type:        schema.TypeList,
+								Optional:    true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"limit": {
+											Description: "Limit is the maximum number of attempts for retrying a failed sync. If set to 0, no retries will be performed.",
+											Type:        schema.TypeString,
+											Optional:    true,
+										},
+										"backoff": {
+											Description: "Backoff strategy to use on subsequent retries for failing syncs.",
+											Type:        schema.TypeList,
+											Optional:    true,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"duration": {
+														Description: "Amount to back off. Default unit is seconds, but could also be a duration (e.g. \"2m\", \"1h\").",
+														Type:        schema.TypeString,
+														Optional:    true,
+													},
+													"factor": {
+														Description: "Factor to multiply the base duration after each failed retry.",
+														Type:        schema.TypeString,
+														Optional:    true,
+													},
+													"max_duration": {
+														Description: "Maximum amount of time allowed of the backoff strategy.",
+														Type:        schema.TypeString,
+														Optional:    true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildApplicationSpec(specData map[string]interface{}) nextgen.ApplicationsApplicationSpec {
+	var spec nextgen.ApplicationsApplicationSpec
+	//Spec Source
+	if specData["source"] != nil && len(specData["source"].([]interface{})) > 0 {
+		var specSource nextgen.ApplicationsApplicationSource
+		var source = specData["source"].([]interface{})[0].(map[string]interface{})
+		if source["repo_url"] != nil && len(source["repo_url"].(string)) > 0 {
+			specSource.RepoURL = source["repo_url"].(string)
+		}
+		if source["path"] != nil && len(source["path"].(string)) > 0 {
+			specSource.Path = source["path"].(string)
+		}
+		if source["target_revision"] != nil && len(source["target_revision"].(string)) > 0 {
+			specSource.TargetRevision = source["target_revision"].(string)
+		}
+		if source["chart"] != nil && len(source["chart"].(string)) > 0 {
+			specSource.Chart = source["chart"].(string)
+		}
+		//Helm Source Details
+		if source["helm"] != nil && len(source["helm"].([]interface{})) > 0 {
+			var helm = source["helm"].([]interface{})[0].(map[string]interface{})
+			var helmData nextgen.ApplicationsApplicationSourceHelm
+			if helm["value_files"] != nil && len(helm["value_files"].([]interface{})) > 0 {
+				var valueFiles []string
+				for _, v := range helm["value_files"].([]interface{}) {
+					valueFiles = append(valueFiles, v.(string))
+				}
+				helmData.ValueFiles = valueFiles
+			}
+			if helm["release_name"] != nil && len(helm["release_name"].(string)) > 0 {
+				helmData.ReleaseName = helm["release_name"].(string)
+			}
+			if helm["values"] != nil && len(helm["values"].(string)) > 0 {
+				helmData.Values = helm["values"].(string)
+			}
+			if helm["version"] != nil && len(helm["version"].(string)) > 0 {
+				helmData.Version = helm["version"].(string)
+			}
+			if helm["pass_credentials"] != nil {
+				helmData.PassCredentials = helm["pass_credentials"].(bool)
+			}
+			if helm["parameters"] != nil && len(helm["parameters"].([]interface{})) > 0 {
+				var helmParams []nextgen.ApplicationsHelmParameter
+				for _, v := range helm["parameters"].([]interface{}) {
+					if v != nil {
+						var helmParam = v.(map[string]interface{})
+						var helmParamD nextgen.ApplicationsHelmParameter
+						if helmParam["name"] != nil && len(helmParam["name"].(string)) > 0 {
+							helmParamD.Name = helmParam["name"].(string)
 						}
-						if helm["version"] != nil && len(helm["version"].(string)) > 0 {
-							helmData.Version = helm["version"].(string)
+						if helmParam["value"] != nil && len(helmParam["value"].(string)) > 0 {
+							helmParamD.Value = helmParam["value"].(string)
 						}
-						if helm["pass_credentials"] != nil {
-							helmData.PassCredentials = helm["pass_credentials"].(bool)
+						if helmParam["force_string"] != nil {
+							helmParamD.ForceString = helmParam["force_string"].(bool)
 						}
-						if helm["parameters"] != nil && len(helm["parameters"].([]interface{})) > 0 {
-							var helmParams []nextgen.ApplicationsHelmParameter
-							for _, v := range helm["parameters"].([]interface{}) {
-								if v != nil {
-									var helmParam = v.(map[string]interface{})
-									var helmParamD nextgen.ApplicationsHelmParameter
-									if helmParam["name"] != nil && len(helmParam["name"].(string)) > 0 {
-										helmParamD.Name = helmParam["name"].(string)
-									}
-									if helmParam["value"] != nil && len(helmParam["value"].(string)) > 0 {
-										helmParamD.Value = helmParam["value"].(string)
-									}
-									if helmParam["force_string"] != nil {
-										helmParamD.ForceString = helmParam["force_string"].(bool)
-									}
-									helmParams = append(helmParams, helmParamD)
-								}
-							}
-							helmData.Parameters = helmParams
+						helmParams = append(helmParams, helmParamD)
+					}
+				}
+				helmData.Parameters = helmParams
+			}
+			if helm["file_parameters"] != nil && len(helm["file_parameters"].([]interface{})) > 0 {
+				var helmFileParams []nextgen.ApplicationsHelmFileParameter
+				for _, v := range helm["file_parameters"].([]interface{}) {
+					if v != nil {
+						var helmFileParam = v.(map[string]interface{})
+						var helmFileParamD nextgen.ApplicationsHelmFileParameter
+						if helmFileParam["name"] != nil && len(helmFileParam["name"].(string)) > 0 {
+							helmFileParamD.Name = helmFileParam["name"].(string)
 						}
-						if helm["file_parameters"] != nil && len(helm["file_parameters"].([]interface{})) > 0 {
-							var helmFileParams []nextgen.ApplicationsHelmFileParameter
-							for _, v := range helm["file_parameters"].([]interface{}) {
-								if v != nil {
-									var helmFileParam = v.(map[string]interface{})
-									var helmFileParamD nextgen.ApplicationsHelmFileParameter
-									if helmFileParam["name"] != nil && len(helmFileParam["name"].(string)) > 0 {
-										helmFileParamD.Name = helmFileParam["name"].(string)
-									}
-									if helmFileParam["path"] != nil && len(helmFileParam["path"].(string)) > 0 {
-										helmFileParamD.Path = helmFileParam["path"].(string)
-									}
-									helmFileParams = append(helmFileParams, helmFileParamD)
-								}
-								helmData.FileParameters = helmFileParams
-							}
+						if helmFileParam["path"] != nil && len(helmFileParam["path"].(string)) > 0 {
+							helmFileParamD.Path = helmFileParam["path"].(string)
 						}
-						specSource.Helm = &helmData
+						helmFileParams = append(helmFileParams, helmFileParamD)
 					}
+					helmData.FileParameters = helmFileParams
+				}
+			}
+			specSource.Helm = &helmData
+		}
 
-					//Kustomize Source details
-					if source["kustomize"] != nil && len(source["kustomize"].([]interface{})) > 0 {
-						var kustomizeSource = source["kustomize"].([]interface{})[0].(map[string]interface{})
-						var kustomizeData nextgen.ApplicationsApplicationSourceKustomize
-						if kustomizeSource["name_prefix"] != nil && len(kustomizeSource["name_prefix"].(string)) > 0 {
-							kustomizeData.NamePrefix = kustomizeSource["name_prefix"].(string)
-						}
-						if kustomizeSource["name_suffix"] != nil && len(kustomizeSource["name_suffix"].(string)) > 0 {
-							kustomizeData.NameSuffix = kustomizeSource["name_suffix"].(string)
-						}
-						if kustomizeSource["images"] != nil && len(kustomizeSource["images"].([]interface{})) > 0 {
-							var kustomizeImages []string
-							for _, v := range kustomizeSource["images"].([]interface{}) {
-								kustomizeImages = append(kustomizeImages, v.(string))
-							}
-							kustomizeData.Images = kustomizeImages
-						}
-						if kustomizeSource["common_labels"] != nil && len(kustomizeSource["common_labels"].(map[string]interface{})) > 0 {
-							var kustomizeCommonLabels = map[string]string{}
-							for k, v := range kustomizeSource["common_labels"].(map[string]interface{}) {
-								kustomizeCommonLabels[k] = v.(string)
-							}
-							kustomizeData.CommonLabels = kustomizeCommonLabels
-						}
-						if kustomizeSource["version"] != nil && len(kustomizeSource["version"].(string)) > 0 {
-							kustomizeData.Version = kustomizeSource["version"].(string)
-						}
-						if kustomizeSource["common_annotations"] != nil && len(kustomizeSource["common_annotations"].(map[string]interface{})) > 0 {
-							var kustomizeCommonAnnotations = map[string]string{}
-							for k, v := range kustomizeSource["common_annotations"].(map[string]interface{}) {
-								kustomizeCommonAnnotations[k] = v.(string)
-							}
-							kustomizeData.CommonAnnotations = kustomizeCommonAnnotations
+		//Kustomize Source details
+		if source["kustomize"] != nil && len(source["kustomize"].([]interface{})) > 0 {
+			var kustomizeSource = source["kustomize"].([]interface{})[0].(map[string]interface{})
+			var kustomizeData nextgen.ApplicationsApplicationSourceKustomize
+			if kustomizeSource["name_prefix"] != nil && len(kustomizeSource["name_prefix"].(string)) > 0 {
+				kustomizeData.NamePrefix = kustomizeSource["name_prefix"].(string)
+			}
+			if kustomizeSource["name_suffix"] != nil && len(kustomizeSource["name_suffix"].(string)) > 0 {
+				kustomizeData.NameSuffix = kustomizeSource["name_suffix"].(string)
+			}
+			if kustomizeSource["images"] != nil && len(kustomizeSource["images"].([]interface{})) > 0 {
+				var kustomizeImages []string
+				for _, v := range kustomizeSource["images"].([]interface{}) {
+					kustomizeImages = append(kustomizeImages, v.(string))
+				}
+				kustomizeData.Images = kustomizeImages
+			}
+			if kustomizeSource["common_labels"] != nil && len(kustomizeSource["common_labels"].(map[string]interface{})) > 0 {
+				var kustomizeCommonLabels = map[string]string{}
+				for k, v := range kustomizeSource["common_labels"].(map[string]interface{}) {
+					kustomizeCommonLabels[k] = v.(string)
+				}
+				kustomizeData.CommonLabels = kustomizeCommonLabels
+			}
+			if kustomizeSource["version"] != nil && len(kustomizeSource["version"].(string)) > 0 {
+				kustomizeData.Version = kustomizeSource["version"].(string)
+			}
+			if kustomizeSource["common_annotations"] != nil && len(kustomizeSource["common_annotations"].(map[string]interface{})) > 0 {
+				var kustomizeCommonAnnotations = map[string]string{}
+				for k, v := range kustomizeSource["common_annotations"].(map[string]interface{}) {
+					kustomizeCommonAnnotations[k] = v.(string)
+				}
+				kustomizeData.CommonAnnotations = kustomizeCommonAnnotations
+			}
+			if kustomizeSource["force_common_labels"] != nil {
+				kustomizeData.ForceCommonLabels = kustomizeSource["force_common_labels"].(bool)
+			}
+			if kustomizeSource["force_common_annotations"] != nil {
+				kustomizeData.ForceCommonAnnotations = kustomizeSource["force_common_annotations"].(bool)
+			}
+
+			specSource.Kustomize = &kustomizeData
+		}
+
+		//Ksonnet
+		if source["ksonnet"] != nil && len(source["ksonnet"].([]interface{})) > 0 {
+			var ksonnetSource = source["ksonnet"].([]interface{})[0].(map[string]interface{})
+			var ksonnetData nextgen.ApplicationsApplicationSourceKsonnet
+			if ksonnetSource["environment"] != nil && len(ksonnetSource["environment"].(string)) > 0 {
+				ksonnetData.Environment = ksonnetSource["environment"].(string)
+			}
+			if ksonnetSource["parameters"] != nil && len(ksonnetSource["parameters"].([]interface{})) > 0 {
+				var ksonnetParams []nextgen.ApplicationsKsonnetParameter
+				for _, v := range ksonnetSource["parameters"].([]interface{}) {
+					if v != nil {
+						var ksonnetParamSource = v.(map[string]interface{})
+						var ksonnetParam nextgen.ApplicationsKsonnetParameter
+						if ksonnetParamSource["component"] != nil && len(ksonnetParamSource["component"].(string)) > 0 {
+							ksonnetParam.Component = ksonnetParamSource["component"].(string)
 						}
-						if kustomizeSource["force_common_labels"] != nil {
-							kustomizeData.ForceCommonLabels = kustomizeSource["force_common_labels"].(bool)
+						if ksonnetParamSource["name"] != nil && len(ksonnetParamSource["name"].(string)) > 0 {
+							ksonnetParam.Name = ksonnetParamSource["name"].(string)
 						}
-						if kustomizeSource["force_common_annotations"] != nil {
-							kustomizeData.ForceCommonAnnotations = kustomizeSource["force_common_annotations"].(bool)
+						if ksonnetParamSource["value"] != nil && len(ksonnetParamSource["value"].(string)) > 0 {
+							ksonnetParam.Value = ksonnetParamSource["value"].(string)
 						}
-
-						specSource.Kustomize = &kustomizeData
+						ksonnetParams = append(ksonnetParams, ksonnetParam)
 					}
+					ksonnetData.Parameters = ksonnetParams
+				}
+			}
+			specSource.Ksonnet = &ksonnetData
+		}
+		//Directory
+		if source["directory"] != nil && len(source["directory"].([]interface{})) > 0 {
+			var directorySource = source["directory"].([]interface{})[0].(map[string]interface{})
+			var directoryData nextgen.ApplicationsApplicationSourceDirectory
+			if directorySource["recurse"] != nil {
+				directoryData.Recurse = directorySource["recurse"].(bool)
+			}
+			if directorySource["exclude"] != nil && len(directorySource["exclude"].(string)) > 0 {
+				directoryData.Exclude = directorySource["exclude"].(string)
+			}
+			if directorySource["include"] != nil && len(directorySource["include"].(string)) > 0 {
+				directoryData.Exclude = directorySource["include"].(string)
+			}
 
-					//Ksonnet
-					if source["ksonnet"] != nil && len(source["ksonnet"].([]interface{})) > 0 {
-						var ksonnetSource = source["ksonnet"].([]interface{})[0].(map[string]interface{})
-						var ksonnetData nextgen.ApplicationsApplicationSourceKsonnet
-						if ksonnetSource["environment"] != nil && len(ksonnetSource["environment"].(string)) > 0 {
-							ksonnetData.Environment = ksonnetSource["environment"].(string)
-						}
-						if ksonnetSource["parameters"] != nil && len(ksonnetSource["parameters"].([]interface{})) > 0 {
-							var ksonnetParams []nextgen.ApplicationsKsonnetParameter
-							for _, v := range ksonnetSource["parameters"].([]interface{}) {
-								if v != nil {
-									var ksonnetParamSource = v.(map[string]interface{})
-									var ksonnetParam nextgen.ApplicationsKsonnetParameter
-									if ksonnetParamSource["component"] != nil && len(ksonnetParamSource["component"].(string)) > 0 {
-										ksonnetParam.Component = ksonnetParamSource["component"].(string)
-									}
-									if ksonnetParamSource["name"] != nil && len(ksonnetParamSource["name"].(string)) > 0 {
-										ksonnetParam.Name = ksonnetParamSource["name"].(string)
-									}
-									if ksonnetParamSource["value"] != nil && len(ksonnetParamSource["value"].(string)) > 0 {
-										ksonnetParam.Value = ksonnetParamSource["value"].(string)
-									}
-									ksonnetParams = append(ksonnetParams, ksonnetParam)
-								}
-								ksonnetData.Parameters = ksonnetParams
+			if directorySource["jsonnet"] != nil && len(directorySource["jsonnet"].([]interface{})) > 0 {
+				var directoryJsonnet = directorySource["jsonnet"].([]interface{})[0].(map[string]interface{})
+				var jsonnetData nextgen.ApplicationsApplicationSourceJsonnet
+				if directoryJsonnet["libs"] != nil && len(directoryJsonnet["libs"].([]interface{})) > 0 {
+					var jsonnetLibs []string
+					for _, v := range directoryJsonnet["libs"].([]interface{}) {
+						jsonnetLibs = append(jsonnetLibs, v.(string))
+					}
+					jsonnetData.Libs = jsonnetLibs
+				}
+				if directoryJsonnet["ext_vars"] != nil && len(directoryJsonnet["ext_vars"].([]interface{})) > 0 {
+					var jsonnetExtVars []nextgen.ApplicationsJsonnetVar
+					for _, v := range directoryJsonnet["ext_vars"].([]interface{}) {
+						if v != nil {
+							var jsonnetExtVar = v.(map[string]interface{})
+							var jsonnetExtVarData nextgen.ApplicationsJsonnetVar
+							if jsonnetExtVar["name"] != nil && len(jsonnetExtVar["name"].(string)) > 0 {
+								jsonnetExtVarData.Name = jsonnetExtVar["name"].(string)
+							}
+							if jsonnetExtVar["value"] != nil && len(jsonnetExtVar["value"].(string)) > 0 {
+								jsonnetExtVarData.Value = jsonnetExtVar["value"].(string)
+							}
+							if jsonnetExtVar["code"] != nil {
+								jsonnetExtVarData.Code = jsonnetExtVar["code"].(bool)
 							}
+							jsonnetExtVars = append(jsonnetExtVars, jsonnetExtVarData)
 						}
-						specSource.Ksonnet = &ksonnetData
 					}
-					//Directory
-					if source["directory"] != nil && len(source["directory"].([]interface{})) > 0 {
-						var directorySource = source["directory"].([]interface{})[0].(map[string]interface{})
-						var directoryData nextgen.ApplicationsApplicationSourceDirectory
-						if directorySource["recurse"] != nil {
-							directoryData.Recurse = directorySource["recurse"].(bool)
-						}
-						if directorySource["exclude"] != nil && len(directorySource["exclude"].(string)) > 0 {
-							directoryData.Exclude = directorySource["exclude"].(string)
-						}
-						if directorySource["include"] != nil && len(directorySource["include"].(string)) > 0 {
-							directoryData.Exclude = directorySource["include"].(string)
-						}
-
-						if directorySource["jsonnet"] != nil && len(directorySource["jsonnet"].([]interface{})) > 0 {
-							var directoryJsonnet = directorySource["jsonnet"].([]interface{})[0].(map[string]interface{})
-							var jsonnetData nextgen.ApplicationsApplicationSourceJsonnet
-							if directoryJsonnet["libs"] != nil && len(directoryJsonnet["libs"].([]interface{})) > 0 {
-								var jsonnetLibs []string
-								for _, v := range directoryJsonnet["libs"].([]interface{}) {
-									jsonnetLibs = append(jsonnetLibs, v.(string))
-								}
-								jsonnetData.Libs = jsonnetLibs
+					jsonnetData.ExtVars = jsonnetExtVars
+				}
+				if directoryJsonnet["tlas"] != nil && len(directoryJsonnet["tlas"].([]interface{})) > 0 {
+					var jsonnetTlasVars []nextgen.ApplicationsJsonnetVar
+					for _, v := range directoryJsonnet["ext_vars"].([]interface{}) {
+						if v != nil {
+							var jsonnetTlasVar = v.(map[string]interface{})
+							var jsonnetTlasVarData nextgen.ApplicationsJsonnetVar
+							if jsonnetTlasVar["name"] != nil && len(jsonnetTlasVar["name"].(string)) > 0 {
+								jsonnetTlasVarData.Name = jsonnetTlasVar["name"].(string)
 							}
-							if directoryJsonnet["ext_vars"] != nil && len(directoryJsonnet["ext_vars"].([]interface{})) > 0 {
-								var jsonnetExtVars []nextgen.ApplicationsJsonnetVar
-								for _, v := range directoryJsonnet["ext_vars"].([]interface{}) {
-									if v != nil {
-										var jsonnetExtVar = v.(map[string]interface{})
-										var jsonnetExtVarData nextgen.ApplicationsJsonnetVar
-										if jsonnetExtVar["name"] != nil && len(jsonnetExtVar["name"].(string)) > 0 {
-											jsonnetExtVarData.Name = jsonnetExtVar["name"].(string)
-										}
-										if jsonnetExtVar["value"] != nil && len(jsonnetExtVar["value"].(string)) > 0 {
-											jsonnetExtVarData.Value = jsonnetExtVar["value"].(string)
-										}
-										if jsonnetExtVar["code"] != nil {
-											jsonnetExtVarData.Code = jsonnetExtVar["code"].(bool)
-										}
-										jsonnetExtVars = append(jsonnetExtVars, jsonnetExtVarData)
-									}
-								}
-								jsonnetData.ExtVars = jsonnetExtVars
+							if jsonnetTlasVar["value"] != nil && len(jsonnetTlasVar["value"].(string)) > 0 {
+								jsonnetTlasVarData.Value = jsonnetTlasVar["value"].(string)
 							}
-							if directoryJsonnet["tlas"] != nil && len(directoryJsonnet["tlas"].([]interface{})) > 0 {
-								var jsonnetTlasVars []nextgen.ApplicationsJsonnetVar
-								for _, v := range directoryJsonnet["ext_vars"].([]interface{}) {
-									if v != nil {
-										var jsonnetTlasVar = v.(map[string]interface{})
-										var jsonnetTlasVarData nextgen.ApplicationsJsonnetVar
-										if jsonnetTlasVar["name"] != nil && len(jsonnetTlasVar["name"].(string)) > 0 {
-											jsonnetTlasVarData.Name = jsonnetTlasVar["name"].(string)
-										}
-										if jsonnetTlasVar["value"] != nil && len(jsonnetTlasVar["value"].(string)) > 0 {
-											jsonnetTlasVarData.Value = jsonnetTlasVar["value"].(string)
-										}
-										if jsonnetTlasVar["code"] != nil {
-											jsonnetTlasVarData.Code = jsonnetTlasVar["code"].(bool)
-										}
-										jsonnetTlasVars = append(jsonnetTlasVars, jsonnetTlasVarData)
-									}
-								}
-								jsonnetData.Tlas = jsonnetTlasVars
+							if jsonnetTlasVar["code"] != nil {
+								jsonnetTlasVarData.Code = jsonnetTlasVar["code"].(bool)
 							}
-
-							directoryData.Jsonnet = &jsonnetData
-
+							jsonnetTlasVars = append(jsonnetTlasVars, jsonnetTlasVarData)
 						}
-						specSource.Directory = &directoryData
 					}
+					jsonnetData.Tlas = jsonnetTlasVars
+				}
 
-					//Plugin
-					if source["plugin"] != nil && len(source["plugin"].([]interface{})) > 0 {
-						var pluginSource = source["plugin"].([]interface{})[0].(map[string]interface{})
-						var pluginData nextgen.ApplicationsApplicationSourcePlugin
-						if pluginSource["name"] != nil && len(pluginSource["name"].(string)) > 0 {
-							pluginData.Name = pluginSource["name"].(string)
+				directoryData.Jsonnet = &jsonnetData
+
+			}
+			specSource.Directory = &directoryData
+		}
+
+		//Plugin
+		if source["plugin"] != nil && len(source["plugin"].([]interface{})) > 0 {
+			var pluginSource = source["plugin"].([]interface{})[0].(map[string]interface{})
+			var pluginData nextgen.ApplicationsApplicationSourcePlugin
+			if pluginSource["name"] != nil && len(pluginSource["name"].(string)) > 0 {
+				pluginData.Name = pluginSource["name"].(string)
+			}
+			if pluginSource["env"] != nil && len(pluginSource["env"].([]interface{})) > 0 {
+				var pluginEnvs []nextgen.ApplicationsEnvEntry
+				for _, v := range pluginSource["env"].([]interface{}) {
+					if v != nil {
+						var pluginEnv = v.(map[string]interface{})
+						var pluginEnvData nextgen.ApplicationsEnvEntry
+						if pluginEnv["name"] != nil && len(pluginEnv["name"].(string)) > 0 {
+							pluginEnvData.Name = pluginEnv["name"].(string)
 						}
-						if pluginSource["env"] != nil && len(pluginSource["env"].([]interface{})) > 0 {
-							var pluginEnvs []nextgen.ApplicationsEnvEntry
-							for _, v := range pluginSource["env"].([]interface{}) {
-								if v != nil {
-									var pluginEnv = v.(map[string]interface{})
-									var pluginEnvData nextgen.ApplicationsEnvEntry
-									if pluginEnv["name"] != nil && len(pluginEnv["name"].(string)) > 0 {
-										pluginEnvData.Name = pluginEnv["name"].(string)
-									}
-									if pluginEnv["value"] != nil && len(pluginEnv["value"].(string)) > 0 {
-										pluginEnvData.Value = pluginEnv["value"].(string)
-									}
-									pluginEnvs = append(pluginEnvs, pluginEnvData)
-								}
-								pluginData.Env = pluginEnvs
-							}
+						if pluginEnv["value"] != nil && len(pluginEnv["value"].(string)) > 0 {
+							pluginEnvData.Value = pluginEnv["value"].(string)
 						}
-						specSource.Plugin = &pluginData
+						pluginEnvs = append(pluginEnvs, pluginEnvData)
 					}
-					spec.Source = &specSource
+					pluginData.Env = pluginEnvs
 				}
+			}
+			specSource.Plugin = &pluginData
+		}
+		spec.Source = &specSource
+	}
 
-				//Destination
-				if specData["destination"] != nil && len(specData["destination"].([]interface{})) > 0 {
-					var specDestinationData nextgen.ApplicationsApplicationDestination
-					var specDestination = specData["destination"].([]interface{})[0].(map[string]interface{})
-					if specDestination["name"] != nil && len(specDestination["name"].(string)) > 0 {
-						specDestinationData.Name = specDestination["name"].(string)
-					}
-					if specDestination["namespace"] != nil && len(specDestination["namespace"].(string)) > 0 {
-						specDestinationData.Namespace = specDestination["namespace"].(string)
-					}
-					if specDestination["server"] != nil && len(specDestination["server"].(string)) > 0 {
-						specDestinationData.Server = specDestination["server"].(string)
-					}
-					spec.Destination = &specDestinationData
+	//Destination
+	if specData["destination"] != nil && len(specData["destination"].([]interface{})) > 0 {
+		var specDestinationData nextgen.ApplicationsApplicationDestination
+		var specDestination = specData["destination"].([]interface{})[0].(map[string]interface{})
+		if specDestination["name"] != nil && len(specDestination["name"].(string)) > 0 {
+			specDestinationData.Name = specDestination["name"].(string)
+		}
+		if specDestination["namespace"] != nil && len(specDestination["namespace"].(string)) > 0 {
+			specDestinationData.Namespace = specDestination["namespace"].(string)
+		}
+		if specDestination["server"] != nil && len(specDestination["server"].(string)) > 0 {
+			specDestinationData.Server = specDestination["server"].(string)
+		}
+		spec.Destination = &specDestinationData
+	}
+	//sync policy
+	if specData["sync_policy"] != nil && len(specData["sync_policy"].([]interface{})) > 0 {
+		var syncPolicyData nextgen.ApplicationsSyncPolicy
+		var syncPolicy = specData["sync_policy"].([]interface{})[0].(map[string]interface{})
+		if syncPolicy["sync_options"] != nil && len(syncPolicy["sync_options"].([]interface{})) > 0 {
+			var syncOptions []string
+			for _, v := range syncPolicy["sync_options"].([]interface{}) {
+				syncOptions = append(syncOptions, v.(string))
+			}
+			syncPolicyData.SyncOptions = syncOptions
+		}
+		if syncPolicy["automated"] != nil && len(syncPolicy["automated"].([]interface{})) > 0 {
+			var automatedSyncPolicyData nextgen.ApplicationsSyncPolicyAutomated
+			var automatedSyncPolicy = syncPolicy["automated"].([]interface{})[0].(map[string]interface{})
+			if automatedSyncPolicy["prune"] != nil {
+				automatedSyncPolicyData.Prune = automatedSyncPolicy["prune"].(bool)
+			}
+			if automatedSyncPolicy["self_heal"] != nil {
+				automatedSyncPolicyData.SelfHeal = automatedSyncPolicy["self_heal"].(bool)
+			}
+			if automatedSyncPolicy["allow_empty"] != nil {
+				automatedSyncPolicyData.AllowEmpty = automatedSyncPolicy["allow_empty"].(bool)
+			}
+			syncPolicyData.Automated = &automatedSyncPolicyData
+		}
+		if syncPolicy["retry"] != nil && len(syncPolicy["retry"].([]interface{})) > 0 {
+			var retrySync = syncPolicy["retry"].([]interface{})[0].(map[string]interface{})
+			var retrySyncData nextgen.ApplicationsRetryStrategy
+			if retrySync["limit"] != nil && len(retrySync["limit"].(string)) > 0 {
+				retrySyncData.Limit = retrySync["limit"].(string)
+			}
+			if retrySync["backoff"] != nil && len(retrySync["backoff"].([]interface{})) > 0 {
+				var syncBackoff = retrySync["backoff"].([]interface{})[0].(map[string]interface{})
+				var syncBackoffData nextgen.ApplicationsBackoff
+				if syncBackoff["duration"] != nil && len(syncBackoff["duration"].(string)) > 0 {
+					syncBackoffData.Duration = syncBackoff["duration"].(string)
 				}
-				//sync policy
-				if specData["sync_policy"] != nil && len(specData["sync_policy"].([]interface{})) > 0 {
-					var syncPolicyData nextgen.ApplicationsSyncPolicy
-					var syncPolicy = specData["sync_policy"].([]interface{})[0].(map[string]interface{})
-					if syncPolicy["sync_options"] != nil && len(syncPolicy["sync_options"].([]interface{})) > 0 {
-						var syncOptions []string
-						for _, v := range syncPolicy["sync_options"].([]interface{}) {
-							syncOptions = append(syncOptions, v.(string))
-						}
-						syncPolicyData.SyncOptions = syncOptions
-					}
-					if syncPolicy["automated"] != nil && len(syncPolicy["automated"].([]interface{})) > 0 {
-						var automatedSyncPolicyData nextgen.ApplicationsSyncPolicyAutomated
-						var automatedSyncPolicy = syncPolicy["automated"].([]interface{})[0].(map[string]interface{})
-						if automatedSyncPolicy["prune"] != nil {
-							automatedSyncPolicyData.Prune = automatedSyncPolicy["prune"].(bool)
-						}
-						if automatedSyncPolicy["self_heal"] != nil {
-							automatedSyncPolicyData.SelfHeal = automatedSyncPolicy["self_heal"].(bool)
-						}
-						if automatedSyncPolicy["allow_empty"] != nil {
-							automatedSyncPolicyData.AllowEmpty = automatedSyncPolicy["allow_empty"].(bool)
-						}
-						syncPolicyData.Automated = &automatedSyncPolicyData
+				if syncBackoff["factor"] != nil && len(syncBackoff["factor"].(string)) > 0 {
+					syncBackoffData.Factor = syncBackoff["factor"].(string)
+				}
+				if syncBackoff["max_duration"] != nil && len(syncBackoff["max_duration"].(string)) > 0 {
+					syncBackoffData.MaxDuration = syncBackoff["max_duration"].(string)
+				}
+				retrySyncData.Backoff = &syncBackoffData
+			}
+			syncPolicyData.Retry = &retrySyncData
+		}
+		spec.SyncPolicy = &syncPolicyData
+	}
+	return spec
+}
+
+func flattenApplicationSpec(applicationSpec *nextgen.ApplicationsApplicationSpec) map[string]interface{} {
+	var spec = map[string]interface{}{}
+	if applicationSpec.Source != nil {
+		var sourceList = []interface{}{}
+		var source = map[string]interface{}{}
+		source["repo_url"] = applicationSpec.Source.RepoURL
+		source["path"] = applicationSpec.Source.Path
+		source["target_revision"] = applicationSpec.Source.TargetRevision
+		source["chart"] = applicationSpec.Source.Chart
+		if applicationSpec.Source.Helm != nil {
+			var helmList = []interface{}{}
+			var helm = map[string]interface{}{}
+			if applicationSpec.Source.Helm.ValueFiles != nil && len(applicationSpec.Source.Helm.ValueFiles) > 0 {
+				helm["value_files"] = applicationSpec.Source.Helm.ValueFiles
+			}
+			helm["release_name"] = applicationSpec.Source.Helm.ReleaseName
+			helm["values"] = applicationSpec.Source.Helm.Values
+			helm["version"] = applicationSpec.Source.Helm.Version
+			helm["pass_credentials"] = applicationSpec.Source.Helm.PassCredentials
+			if applicationSpec.Source.Helm.Parameters != nil && len(applicationSpec.Source.Helm.Parameters) > 0 {
+				var helmParametersList = []interface{}{}
+				for _, v := range applicationSpec.Source.Helm.Parameters {
+					var helmParam = map[string]interface{}{}
+					helmParam["name"] = v.Name
+					helmParam["value"] = v.Value
+					helmParam["force_string"] = v.ForceString
+					helmParametersList = append(helmParametersList, helmParam)
+				}
+				helm["parameters"] = helmParametersList
+			}
+			if applicationSpec.Source.Helm.FileParameters != nil && len(applicationSpec.Source.Helm.FileParameters) > 0 {
+				var helmFileParametersList = []interface{}{}
+				for _, v := range applicationSpec.Source.Helm.FileParameters {
+					var helmParam = map[string]interface{}{}
+					helmParam["name"] = v.Name
+					helmParam["path"] = v.Path
+					helmFileParametersList = append(helmFileParametersList, helmParam)
+				}
+				helm["file_parameters"] = helmFileParametersList
+			}
+
+			helmList = append(helmList, helm)
+			source["helm"] = helmList
+		}
+		if applicationSpec.Source.Kustomize != nil {
+			var kustomizeList = []interface{}{}
+			var kustomize = map[string]interface{}{}
+			kustomize["name_prefix"] = applicationSpec.Source.Kustomize.NamePrefix
+			kustomize["name_suffix"] = applicationSpec.Source.Kustomize.NameSuffix
+			kustomize["images"] = applicationSpec.Source.Kustomize.Images
+			kustomize["common_labels"] = applicationSpec.Source.Kustomize.CommonLabels
+			kustomize["version"] = applicationSpec.Source.Kustomize.Version
+			kustomize["common_annotations"] = applicationSpec.Source.Kustomize.CommonAnnotations
+			kustomize["force_common_labels"] = applicationSpec.Source.Kustomize.ForceCommonLabels
+			kustomize["force_common_annotations"] = applicationSpec.Source.Kustomize.ForceCommonAnnotations
+			kustomizeList = append(kustomizeList, kustomize)
+			source["kustomize"] = kustomizeList
+		}
+		if applicationSpec.Source.Ksonnet != nil {
+			var ksonnetList = []interface{}{}
+			var ksonnet = map[string]interface{}{}
+			ksonnet["environment"] = applicationSpec.Source.Ksonnet.Environment
+			var ksonnetParamList = []interface{}{}
+			for _, v := range applicationSpec.Source.Ksonnet.Parameters {
+				var ksonnetParam = map[string]interface{}{}
+				ksonnetParam["component"] = v.Component
+				ksonnetParam["name"] = v.Name
+				ksonnetParam["value"] = v.Value
+				ksonnetParamList = append(ksonnetParamList, ksonnetParam)
+			}
+			ksonnet["parameters"] = ksonnetParamList
+			ksonnetList = append(ksonnetList, ksonnet)
+			source["ksonnet"] = ksonnetList
+		}
+		if applicationSpec.Source.Directory != nil {
+			var directoryList = []interface{}{}
+			var directory = map[string]interface{}{}
+			directory["recurse"] = applicationSpec.Source.Directory.Recurse
+			directory["exclude"] = applicationSpec.Source.Directory.Exclude
+			directory["include"] = applicationSpec.Source.Directory.Include
+			if applicationSpec.Source.Directory.Jsonnet != nil {
+				var jsonnetList = []interface{}{}
+				var jsonnet = map[string]interface{}{}
+				jsonnet["libs"] = applicationSpec.Source.Directory.Jsonnet.Libs
+				if applicationSpec.Source.Directory.Jsonnet.ExtVars != nil {
+					var jsonnetExtVarsList = []interface{}{}
+					for _, v := range applicationSpec.Source.Directory.Jsonnet.ExtVars {
+						var jsonnetExtVars = map[string]interface{}{}
+						jsonnetExtVars["name"] = v.Name
+						jsonnetExtVars["value"] = v.Value
+						jsonnetExtVars["code"] = v.Code
+						jsonnetExtVarsList = append(jsonnetExtVarsList, jsonnetExtVars)
 					}
-					if syncPolicy["retry"] != nil && len(syncPolicy["retry"].([]interface{})) > 0 {
-						var retrySync = syncPolicy["retry"].([]interface{})[0].(map[string]interface{})
-						var retrySyncData nextgen.ApplicationsRetryStrategy
-						if retrySync["limit"] != nil && len(retrySync["limit"].(string)) > 0 {
-							retrySyncData.Limit = retrySync["limit"].(string)
-						}
-						if retrySync["backoff"] != nil && len(retrySync["backoff"].([]interface{})) > 0 {
-							var syncBackoff = retrySync["backoff"].([]interface{})[0].(map[string]interface{})
-							var syncBackoffData nextgen.ApplicationsBackoff
-							if syncBackoff["duration"] != nil && len(syncBackoff["duration"].(string)) > 0 {
-								syncBackoffData.Duration = syncBackoff["duration"].(string)
-							}
-							if syncBackoff["factor"] != nil && len(syncBackoff["factor"].(string)) > 0 {
-								syncBackoffData.Factor = syncBackoff["factor"].(string)
-							}
-							if syncBackoff["max_duration"] != nil && len(syncBackoff["max_duration"].(string)) > 0 {
-								syncBackoffData.MaxDuration = syncBackoff["max_duration"].(string)
-							}
-							retrySyncData.Backoff = &syncBackoffData
-						}
-						syncPolicyData.Retry = &retrySyncData
+					jsonnet["ext_vars"] = jsonnetExtVarsList
+				}
+				if applicationSpec.Source.Directory.Jsonnet.Tlas != nil {
+					var jsonnetTlasList = []interface{}{}
+					for _, v := range applicationSpec.Source.Directory.Jsonnet.Tlas {
+						var jsonnetTlas = map[string]interface{}{}
+						jsonnetTlas["name"] = v.Name
+						jsonnetTlas["value"] = v.Value
+						jsonnetTlas["code"] = v.Code
+						jsonnetTlasList = append(jsonnetTlasList, jsonnetTlas)
 					}
-					spec.SyncPolicy = &syncPolicyData
+					jsonnet["tlas"] = jsonnetTlasList
 				}
+				jsonnetList = append(jsonnetList, jsonnet)
+				directory["jsonnet"] = jsonnetList
+			}
+			directoryList = append(directoryList, directory)
+			source["directory"] = directoryList
+		}
+		if applicationSpec.Source.Plugin != nil {
+			var pluginList = []interface{}{}
+			var plugin = map[string]interface{}{}
+			plugin["name"] = applicationSpec.Source.Plugin.Name
+			var pluginEnvList = []interface{}{}
+			for _, v := range applicationSpec.Source.Plugin.Env {
+				var pluginEnv = map[string]interface{}{}
+				pluginEnv["name"] = v.Name
+				pluginEnv["value"] = v.Value
+				pluginList = append(pluginEnvList, pluginEnv)
 			}
+			plugin["env"] = pluginEnvList
+			pluginList = append(pluginList, plugin)
+			source["plugin"] = pluginList
 		}
+		sourceList = append(sourceList, source)
+		spec["source"] = sourceList
 	}
-	return &nextgen.ApplicationsApplication{
-		Metadata: &metaData,
-		Spec:     &spec,
+	//destination
+	if applicationSpec.Destination != nil {
+		var destinationList = []interface{}{}
+		var destination = map[string]interface{}{}
+		destination["name"] = applicationSpec.Destination.Name
+		destination["namespace"] = applicationSpec.Destination.Namespace
+		destination["server"] = applicationSpec.Destination.Server
+		destinationList = append(destinationList, destination)
+		spec["destination"] = destinationList
+	}
+	//sync policy
+	if applicationSpec.SyncPolicy != nil {
+		var syncPolicyList = []interface{}{}
+		var syncPolicy = map[string]interface{}{}
+		syncPolicy["sync_options"] = applicationSpec.SyncPolicy.SyncOptions
+		if applicationSpec.SyncPolicy.Automated != nil {
+			var syncPolicyAutomatedList = []interface{}{}
+			var syncPolicyAutomated = map[string]interface{}{}
+			syncPolicyAutomated["prune"] = applicationSpec.SyncPolicy.Automated.Prune
+			syncPolicyAutomated["self_heal"] = applicationSpec.SyncPolicy.Automated.SelfHeal
+			syncPolicyAutomated["allow_empty"] = applicationSpec.SyncPolicy.Automated.AllowEmpty
+			syncPolicyAutomatedList = append(syncPolicyAutomatedList, syncPolicyAutomated)
+			syncPolicy["automated"] = syncPolicyAutomatedList
+		}
+		if applicationSpec.SyncPolicy.Retry != nil {
+			var syncPolicyRetryList = []interface{}{}
+			var syncPolicyRetry = map[string]interface{}{}
+			syncPolicyRetry["limit"] = applicationSpec.SyncPolicy.Retry.Limit
+			if applicationSpec.SyncPolicy.Retry.Backoff != nil {
+				var syncPolicyRetryBackoffList = []interface{}{}
+				var syncPolicyRetryBackoff = map[string]interface{}{}
+				syncPolicyRetryBackoff["duration"] = applicationSpec.SyncPolicy.Retry.Backoff.Duration
+				syncPolicyRetryBackoff["factor"] = applicationSpec.SyncPolicy.Retry.Backoff.Factor
+				syncPolicyRetryBackoff["max_duration"] = applicationSpec.SyncPolicy.Retry.Backoff.MaxDuration
+				syncPolicyRetryBackoffList = append(syncPolicyRetryBackoffList, syncPolicyRetryBackoff)
+				syncPolicyRetry["backoff"] = syncPolicyRetryBackoffList
+			}
+			syncPolicyRetryList = append(syncPolicyRetryList, syncPolicyRetry)
+			syncPolicy["retry"] = syncPolicyRetryList
+		}
+
+		syncPolicyList = append(syncPolicyList, syncPolicy)
+		spec["sync_policy"] = syncPolicyList
 	}
+	return spec
 }
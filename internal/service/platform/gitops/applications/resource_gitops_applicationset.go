@@ -0,0 +1,727 @@
+package applications
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/antihax/optional"
+	"github.com/harness/harness-go-sdk/harness/nextgen"
+	"github.com/harness/terraform-provider-harness/helpers"
+	"github.com/harness/terraform-provider-harness/internal"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceGitopsApplicationSet() *schema.Resource {
+	resource := &schema.Resource{
+		Description: "Resource for managing a Harness GitOps ApplicationSet. An ApplicationSet generates GitOps applications from a template, for instance one application per cluster or per directory of a repository.",
+
+		CreateContext: resourceGitopsApplicationSetCreate,
+		ReadContext:   resourceGitopsApplicationSetRead,
+		UpdateContext: resourceGitopsApplicationSetUpdate,
+		DeleteContext: resourceGitopsApplicationSetDelete,
+		Importer:      helpers.GitopsAgentResourceImporter,
+
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Description: "Organization identifier of the GitOps ApplicationSet.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"project_id": {
+				Description: "Project identifier of the GitOps ApplicationSet.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"agent_id": {
+				Description: "Agent identifier of the GitOps ApplicationSet.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"identifier": {
+				Description: "Identifier of the GitOps ApplicationSet.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"upsert": {
+				Description: "Indicates if the GitOps ApplicationSet should be updated if existing and inserted if not.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"applicationset": {
+				Description: "Definition of the GitOps ApplicationSet resource.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metadata": {
+							Description: "Metadata of the ApplicationSet.",
+							Type:        schema.TypeList,
+							Required:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Description: "Name of the ApplicationSet. Name cannot be updated.",
+										Type:        schema.TypeString,
+										Required:    true,
+										ForceNew:    true,
+									},
+									"namespace": {
+										Description: "Namespace of the ApplicationSet. An empty namespace is equivalent to the namespace of the GitOps agent.",
+										Type:        schema.TypeString,
+										Optional:    true,
+										Computed:    true,
+									},
+									"labels": {
+										Description: "Labels of the ApplicationSet.",
+										Type:        schema.TypeMap,
+										Optional:    true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"annotations": {
+										Description: "Annotations of the ApplicationSet.",
+										Type:        schema.TypeMap,
+										Optional:    true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+						"spec": {
+							Description: "Specification of the ApplicationSet.",
+							Type:        schema.TypeList,
+							Required:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"go_template": {
+										Description: "Render the template with Go templates instead of the default fasttemplate syntax.",
+										Type:        schema.TypeBool,
+										Optional:    true,
+									},
+									"generator": {
+										Description: "Generators of the parameters the template is rendered with. Each generator must set exactly one type of generator.",
+										Type:        schema.TypeList,
+										Required:    true,
+										MinItems:    1,
+										Elem:        getApplicationSetGeneratorSchema(false),
+									},
+									"template": {
+										Description: "Template of the generated applications.",
+										Type:        schema.TypeList,
+										Required:    true,
+										MaxItems:    1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"metadata": {
+													Description: "Metadata of the generated applications.",
+													Type:        schema.TypeList,
+													Required:    true,
+													MaxItems:    1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"name": {
+																Description: "Name of the generated applications, e.g. `{{name}}-guestbook`.",
+																Type:        schema.TypeString,
+																Required:    true,
+															},
+															"namespace": {
+																Description: "Namespace of the generated applications.",
+																Type:        schema.TypeString,
+																Optional:    true,
+															},
+															"labels": {
+																Description: "Labels of the generated applications.",
+																Type:        schema.TypeMap,
+																Optional:    true,
+																Elem: &schema.Schema{
+																	Type: schema.TypeString,
+																},
+															},
+															"annotations": {
+																Description: "Annotations of the generated applications.",
+																Type:        schema.TypeMap,
+																Optional:    true,
+																Elem: &schema.Schema{
+																	Type: schema.TypeString,
+																},
+															},
+															"finalizers": {
+																Description: "Finalizers of the generated applications.",
+																Type:        schema.TypeList,
+																Optional:    true,
+																Elem: &schema.Schema{
+																	Type: schema.TypeString,
+																},
+															},
+														},
+													},
+												},
+												"spec": getApplicationSpecSchema(),
+											},
+										},
+									},
+									"sync_policy": {
+										Description: "Sync policy of the ApplicationSet.",
+										Type:        schema.TypeList,
+										Optional:    true,
+										MaxItems:    1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"preserve_resources_on_deletion": {
+													Description: "Keep the resources of the generated applications when the applications are deleted.",
+													Type:        schema.TypeBool,
+													Optional:    true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return resource
+}
+
+// getApplicationSetGeneratorSchema returns the schema of a generator of an ApplicationSet. The generators combined by
+// a matrix generator can't be matrix generators themselves.
+func getApplicationSetGeneratorSchema(nested bool) *schema.Resource {
+	generator := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"list": {
+				Description: "Generates one set of parameters per element of a fixed list.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"elements": {
+							Description: "Parameters of each element.",
+							Type:        schema.TypeList,
+							Required:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeMap,
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+						},
+					},
+				},
+			},
+			"cluster": {
+				Description: "Generates one set of parameters per cluster of the agent, e.g. `name` and `server`.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"match_labels": {
+							Description: "Labels the clusters must have. All the clusters are selected when empty.",
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"values": {
+							Description: "Additional parameters passed to the template.",
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"git": {
+				Description: "Generates one set of parameters per directory or per file of a Git repository.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"repo_url": {
+							Description: "URL of the repository.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"revision": {
+							Description: "Revision of the repository.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "HEAD",
+						},
+						"directory": {
+							Description: "Directories of the repository, each matching directory generates a set of parameters, e.g. `path` and `path.basename`.",
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"path": {
+										Description: "Path of the directories, supports glob patterns.",
+										Type:        schema.TypeString,
+										Required:    true,
+									},
+									"exclude": {
+										Description: "Exclude the matching directories.",
+										Type:        schema.TypeBool,
+										Optional:    true,
+									},
+								},
+							},
+						},
+						"file": {
+							Description: "JSON or YAML files of the repository, the content of each matching file is a set of parameters.",
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"path": {
+										Description: "Path of the files, supports glob patterns.",
+										Type:        schema.TypeString,
+										Required:    true,
+									},
+								},
+							},
+						},
+						"values": {
+							Description: "Additional parameters passed to the template.",
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if !nested {
+		generator.Schema["matrix"] = &schema.Schema{
+			Description: "Combines the parameters of two generators.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"generator": {
+						Description: "Generators to combine.",
+						Type:        schema.TypeList,
+						Required:    true,
+						MinItems:    2,
+						MaxItems:    2,
+						Elem:        getApplicationSetGeneratorSchema(true),
+					},
+				},
+			},
+		}
+	}
+
+	return generator
+}
+
+func resourceGitopsApplicationSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
+
+	appSet, err := buildApplicationSet(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, httpResp, err := c.ApplicationSetApiService.AgentApplicationSetServiceCreate(ctx, nextgen.ApplicationsApplicationSetCreateRequest{
+		Applicationset: appSet,
+		Upsert:         d.Get("upsert").(bool),
+	}, d.Get("agent_id").(string), &nextgen.ApplicationSetApiAgentApplicationSetServiceCreateOpts{
+		AccountIdentifier: optional.NewString(c.AccountId),
+		OrgIdentifier:     optional.NewString(d.Get("org_id").(string)),
+		ProjectIdentifier: optional.NewString(d.Get("project_id").(string)),
+	})
+	if err != nil {
+		return helpers.HandleApiError(err, d, httpResp)
+	}
+
+	setApplicationSet(d, &resp)
+	return nil
+}
+
+func resourceGitopsApplicationSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
+
+	resp, httpResp, err := c.ApplicationSetApiService.AgentApplicationSetServiceGet(ctx, d.Get("agent_id").(string), d.Id(), c.AccountId, d.Get("org_id").(string), d.Get("project_id").(string), &nextgen.ApplicationSetApiAgentApplicationSetServiceGetOpts{})
+	if err != nil {
+		return helpers.HandleReadApiError(err, d, httpResp)
+	}
+
+	if resp.AppSet == nil {
+		d.SetId("")
+		d.MarkNewResource()
+		return nil
+	}
+
+	setApplicationSet(d, &resp)
+	return nil
+}
+
+func resourceGitopsApplicationSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
+
+	appSet, err := buildApplicationSet(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, httpResp, err := c.ApplicationSetApiService.AgentApplicationSetServiceUpdate(ctx, nextgen.ApplicationsApplicationSetUpdateRequest{
+		Applicationset: appSet,
+	}, d.Get("agent_id").(string), d.Id(), &nextgen.ApplicationSetApiAgentApplicationSetServiceUpdateOpts{
+		AccountIdentifier: optional.NewString(c.AccountId),
+		OrgIdentifier:     optional.NewString(d.Get("org_id").(string)),
+		ProjectIdentifier: optional.NewString(d.Get("project_id").(string)),
+	})
+	if err != nil {
+		return helpers.HandleApiError(err, d, httpResp)
+	}
+
+	setApplicationSet(d, &resp)
+	return nil
+}
+
+func resourceGitopsApplicationSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
+
+	_, httpResp, err := c.ApplicationSetApiService.AgentApplicationSetServiceDelete(ctx, d.Get("agent_id").(string), d.Id(), &nextgen.ApplicationSetApiAgentApplicationSetServiceDeleteOpts{
+		AccountIdentifier: optional.NewString(c.AccountId),
+		OrgIdentifier:     optional.NewString(d.Get("org_id").(string)),
+		ProjectIdentifier: optional.NewString(d.Get("project_id").(string)),
+	})
+	if err != nil {
+		return helpers.HandleApiError(err, d, httpResp)
+	}
+
+	return nil
+}
+
+func buildApplicationSet(d *schema.ResourceData) (*nextgen.ApplicationsApplicationSet, error) {
+	appSet := d.Get("applicationset").([]interface{})[0].(map[string]interface{})
+
+	metadata := appSet["metadata"].([]interface{})[0].(map[string]interface{})
+	spec := appSet["spec"].([]interface{})[0].(map[string]interface{})
+
+	generators := []nextgen.ApplicationsApplicationSetGenerator{}
+	for i, g := range spec["generator"].([]interface{}) {
+		generator, err := buildApplicationSetGenerator(g)
+		if err != nil {
+			return nil, fmt.Errorf("generator %d: %w", i, err)
+		}
+		generators = append(generators, *generator)
+	}
+
+	result := &nextgen.ApplicationsApplicationSet{
+		Metadata: &nextgen.V1ObjectMeta{
+			Name:        metadata["name"].(string),
+			Namespace:   metadata["namespace"].(string),
+			Labels:      expandStringMap(metadata["labels"]),
+			Annotations: expandStringMap(metadata["annotations"]),
+		},
+		Spec: &nextgen.ApplicationsApplicationSetSpec{
+			GoTemplate: spec["go_template"].(bool),
+			Generators: generators,
+			Template:   buildApplicationSetTemplate(spec["template"].([]interface{})[0].(map[string]interface{})),
+		},
+	}
+
+	if policies := spec["sync_policy"].([]interface{}); len(policies) > 0 && policies[0] != nil {
+		policy := policies[0].(map[string]interface{})
+		result.Spec.SyncPolicy = &nextgen.ApplicationsApplicationSetSyncPolicy{
+			PreserveResourcesOnDeletion: policy["preserve_resources_on_deletion"].(bool),
+		}
+	}
+
+	return result, nil
+}
+
+func buildApplicationSetTemplate(template map[string]interface{}) *nextgen.ApplicationsApplicationSetTemplate {
+	metadata := template["metadata"].([]interface{})[0].(map[string]interface{})
+
+	result := &nextgen.ApplicationsApplicationSetTemplate{
+		Metadata: &nextgen.ApplicationsApplicationSetTemplateMeta{
+			Name:        metadata["name"].(string),
+			Namespace:   metadata["namespace"].(string),
+			Labels:      expandStringMap(metadata["labels"]),
+			Annotations: expandStringMap(metadata["annotations"]),
+			Finalizers:  expandStringList(metadata["finalizers"]),
+		},
+	}
+
+	if specs := template["spec"].([]interface{}); len(specs) > 0 && specs[0] != nil {
+		spec := buildApplicationSpec(specs[0].(map[string]interface{}))
+		result.Spec = &spec
+	}
+
+	return result
+}
+
+func buildApplicationSetGenerator(g interface{}) (*nextgen.ApplicationsApplicationSetGenerator, error) {
+	if g == nil {
+		return nil, fmt.Errorf("exactly one of list, cluster, git or matrix must be set")
+	}
+	generator := g.(map[string]interface{})
+
+	nested, err := buildApplicationSetNestedGenerator(generator)
+	result := &nextgen.ApplicationsApplicationSetGenerator{}
+
+	if matrices := generator["matrix"].([]interface{}); len(matrices) > 0 && matrices[0] != nil {
+		if err == nil {
+			return nil, fmt.Errorf("exactly one of list, cluster, git or matrix must be set")
+		}
+
+		matrix := &nextgen.ApplicationsMatrixGenerator{}
+		for i, child := range matrices[0].(map[string]interface{})["generator"].([]interface{}) {
+			if child == nil {
+				return nil, fmt.Errorf("matrix generator %d: exactly one of list, cluster or git must be set", i)
+			}
+			childGenerator, err := buildApplicationSetNestedGenerator(child.(map[string]interface{}))
+			if err != nil {
+				return nil, fmt.Errorf("matrix generator %d: %w", i, err)
+			}
+			matrix.Generators = append(matrix.Generators, *childGenerator)
+		}
+		result.Matrix = matrix
+		return result, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("exactly one of list, cluster, git or matrix must be set")
+	}
+
+	result.List = nested.List
+	result.Clusters = nested.Clusters
+	result.Git = nested.Git
+	return result, nil
+}
+
+// buildApplicationSetNestedGenerator builds the list, cluster or git generator set in the configuration. An error is
+// returned unless exactly one of them is set.
+func buildApplicationSetNestedGenerator(generator map[string]interface{}) (*nextgen.ApplicationsApplicationSetNestedGenerator, error) {
+	result := &nextgen.ApplicationsApplicationSetNestedGenerator{}
+	count := 0
+
+	if lists := generator["list"].([]interface{}); len(lists) > 0 && lists[0] != nil {
+		list := &nextgen.ApplicationsListGenerator{}
+		for _, element := range lists[0].(map[string]interface{})["elements"].([]interface{}) {
+			list.Elements = append(list.Elements, expandStringMap(element))
+		}
+		result.List = list
+		count++
+	}
+
+	if clusters := generator["cluster"].([]interface{}); len(clusters) > 0 {
+		cluster := map[string]interface{}{}
+		if clusters[0] != nil {
+			cluster = clusters[0].(map[string]interface{})
+		}
+		result.Clusters = &nextgen.ApplicationsClusterGenerator{
+			Selector: &nextgen.V1LabelSelector{
+				MatchLabels: expandStringMap(cluster["match_labels"]),
+			},
+			Values: expandStringMap(cluster["values"]),
+		}
+		count++
+	}
+
+	if gits := generator["git"].([]interface{}); len(gits) > 0 && gits[0] != nil {
+		git := gits[0].(map[string]interface{})
+		result.Git = &nextgen.ApplicationsGitGenerator{
+			RepoURL:  git["repo_url"].(string),
+			Revision: git["revision"].(string),
+			Values:   expandStringMap(git["values"]),
+		}
+		for _, dir := range git["directory"].([]interface{}) {
+			directory := dir.(map[string]interface{})
+			result.Git.Directories = append(result.Git.Directories, nextgen.ApplicationsGitDirectoryGeneratorItem{
+				Path:    directory["path"].(string),
+				Exclude: directory["exclude"].(bool),
+			})
+		}
+		for _, f := range git["file"].([]interface{}) {
+			result.Git.Files = append(result.Git.Files, nextgen.ApplicationsGitFileGeneratorItem{
+				Path: f.(map[string]interface{})["path"].(string),
+			})
+		}
+		count++
+	}
+
+	if count != 1 {
+		return nil, fmt.Errorf("exactly one of list, cluster or git must be set")
+	}
+	return result, nil
+}
+
+func setApplicationSet(d *schema.ResourceData, appSet *nextgen.Servicev1ApplicationSet) {
+	d.SetId(appSet.Name)
+	d.Set("identifier", appSet.Name)
+	d.Set("org_id", appSet.OrgIdentifier)
+	d.Set("project_id", appSet.ProjectIdentifier)
+	d.Set("agent_id", appSet.AgentIdentifier)
+
+	if appSet.AppSet != nil {
+		d.Set("applicationset", []interface{}{flattenApplicationSet(appSet.AppSet)})
+	}
+}
+
+func flattenApplicationSet(appSet *nextgen.ApplicationsApplicationSet) map[string]interface{} {
+	result := map[string]interface{}{}
+
+	if appSet.Metadata != nil {
+		result["metadata"] = []interface{}{map[string]interface{}{
+			"name":        appSet.Metadata.Name,
+			"namespace":   appSet.Metadata.Namespace,
+			"labels":      appSet.Metadata.Labels,
+			"annotations": appSet.Metadata.Annotations,
+		}}
+	}
+
+	if appSet.Spec != nil {
+		generators := []interface{}{}
+		for _, g := range appSet.Spec.Generators {
+			generator := flattenApplicationSetNestedGenerator(nextgen.ApplicationsApplicationSetNestedGenerator{
+				List:     g.List,
+				Clusters: g.Clusters,
+				Git:      g.Git,
+			})
+			if g.Matrix != nil {
+				children := []interface{}{}
+				for _, child := range g.Matrix.Generators {
+					children = append(children, flattenApplicationSetNestedGenerator(child))
+				}
+				generator["matrix"] = []interface{}{map[string]interface{}{
+					"generator": children,
+				}}
+			}
+			generators = append(generators, generator)
+		}
+
+		spec := map[string]interface{}{
+			"go_template": appSet.Spec.GoTemplate,
+			"generator":   generators,
+		}
+		if appSet.Spec.Template != nil {
+			spec["template"] = []interface{}{flattenApplicationSetTemplate(appSet.Spec.Template)}
+		}
+		if appSet.Spec.SyncPolicy != nil {
+			spec["sync_policy"] = []interface{}{map[string]interface{}{
+				"preserve_resources_on_deletion": appSet.Spec.SyncPolicy.PreserveResourcesOnDeletion,
+			}}
+		}
+		result["spec"] = []interface{}{spec}
+	}
+
+	return result
+}
+
+func flattenApplicationSetTemplate(template *nextgen.ApplicationsApplicationSetTemplate) map[string]interface{} {
+	result := map[string]interface{}{}
+
+	if template.Metadata != nil {
+		result["metadata"] = []interface{}{map[string]interface{}{
+			"name":        template.Metadata.Name,
+			"namespace":   template.Metadata.Namespace,
+			"labels":      template.Metadata.Labels,
+			"annotations": template.Metadata.Annotations,
+			"finalizers":  template.Metadata.Finalizers,
+		}}
+	}
+	if template.Spec != nil {
+		result["spec"] = []interface{}{flattenApplicationSpec(template.Spec)}
+	}
+
+	return result
+}
+
+func flattenApplicationSetNestedGenerator(generator nextgen.ApplicationsApplicationSetNestedGenerator) map[string]interface{} {
+	result := map[string]interface{}{}
+
+	if generator.List != nil {
+		elements := []interface{}{}
+		for _, element := range generator.List.Elements {
+			elements = append(elements, element)
+		}
+		result["list"] = []interface{}{map[string]interface{}{
+			"elements": elements,
+		}}
+	}
+
+	if generator.Clusters != nil {
+		cluster := map[string]interface{}{
+			"values": generator.Clusters.Values,
+		}
+		if generator.Clusters.Selector != nil {
+			cluster["match_labels"] = generator.Clusters.Selector.MatchLabels
+		}
+		result["cluster"] = []interface{}{cluster}
+	}
+
+	if generator.Git != nil {
+		directories := []interface{}{}
+		for _, directory := range generator.Git.Directories {
+			directories = append(directories, map[string]interface{}{
+				"path":    directory.Path,
+				"exclude": directory.Exclude,
+			})
+		}
+		files := []interface{}{}
+		for _, file := range generator.Git.Files {
+			files = append(files, map[string]interface{}{
+				"path": file.Path,
+			})
+		}
+		result["git"] = []interface{}{map[string]interface{}{
+			"repo_url":  generator.Git.RepoURL,
+			"revision":  generator.Git.Revision,
+			"directory": directories,
+			"file":      files,
+			"values":    generator.Git.Values,
+		}}
+	}
+
+	return result
+}
+
+func expandStringMap(v interface{}) map[string]string {
+	result := map[string]string{}
+	if v == nil {
+		return result
+	}
+	for key, value := range v.(map[string]interface{}) {
+		result[key] = value.(string)
+	}
+	return result
+}
+
+func expandStringList(v interface{}) []string {
+	result := []string{}
+	if v == nil {
+		return result
+	}
+	for _, value := range v.([]interface{}) {
+		result = append(result, value.(string))
+	}
+	return result
+}
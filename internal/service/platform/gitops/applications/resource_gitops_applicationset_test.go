@@ -0,0 +1,152 @@
+package applications_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/harness/harness-go-sdk/harness/nextgen"
+	"github.com/harness/harness-go-sdk/harness/utils"
+	"github.com/harness/terraform-provider-harness/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceGitopsApplicationSet(t *testing.T) {
+	id := strings.ToLower(fmt.Sprintf("%s%s", t.Name(), utils.RandStringBytes(5)))
+	id = strings.ReplaceAll(id, "_", "")
+	agentId := os.Getenv("HARNESS_TEST_GITOPS_AGENT_ID")
+	repo := os.Getenv("HARNESS_TEST_GITOPS_REPO")
+	resourceName := "harness_platform_gitops_applicationset.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.TestAccPreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccResourceGitopsApplicationSetDestroy(resourceName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceGitopsApplicationSet(id, agentId, repo, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", id),
+					resource.TestCheckResourceAttr(resourceName, "identifier", id),
+					resource.TestCheckResourceAttr(resourceName, "applicationset.0.spec.0.generator.0.list.0.elements.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "applicationset.0.spec.0.generator.1.matrix.0.generator.#", "2"),
+				),
+			},
+			{
+				Config: testAccResourceGitopsApplicationSet(id, agentId, repo, "test_updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", id),
+					resource.TestCheckResourceAttr(resourceName, "applicationset.0.spec.0.template.0.spec.0.destination.0.namespace", "test_updated"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       acctest.GitopsAgentProjectLevelResourceImportStateIdFunc(resourceName),
+				ImportStateVerifyIgnore: []string{"upsert"},
+			},
+		},
+	})
+}
+
+func testAccResourceGitopsApplicationSetDestroy(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		appSet, _ := testAccGetApplicationSet(resourceName, state)
+		if appSet != nil {
+			return fmt.Errorf("Found ApplicationSet: %s", appSet.Name)
+		}
+		return nil
+	}
+}
+
+func testAccGetApplicationSet(resourceName string, state *terraform.State) (*nextgen.Servicev1ApplicationSet, error) {
+	r := acctest.TestAccGetResource(resourceName, state)
+	c, ctx := acctest.TestAccGetPlatformClientWithContext()
+	agentIdentifier := r.Primary.Attributes["agent_id"]
+	orgIdentifier := r.Primary.Attributes["org_id"]
+	projectIdentifier := r.Primary.Attributes["project_id"]
+
+	resp, _, err := c.ApplicationSetApiService.AgentApplicationSetServiceGet(ctx, agentIdentifier, r.Primary.ID, c.AccountId, orgIdentifier, projectIdentifier, &nextgen.ApplicationSetApiAgentApplicationSetServiceGetOpts{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func testAccResourceGitopsApplicationSet(id string, agentId string, repo string, namespace string) string {
+	return fmt.Sprintf(`
+		resource "harness_platform_organization" "test" {
+			identifier = "%[1]s"
+			name = "%[1]s"
+		}
+
+		resource "harness_platform_project" "test" {
+			identifier = "%[1]s"
+			name = "%[1]s"
+			org_id = harness_platform_organization.test.id
+		}
+
+		resource "harness_platform_gitops_applicationset" "test" {
+			org_id = harness_platform_organization.test.id
+			project_id = harness_platform_project.test.id
+			agent_id = "%[2]s"
+			upsert = true
+			applicationset {
+				metadata {
+					name = "%[1]s"
+				}
+				spec {
+					generator {
+						list {
+							elements = [
+								{
+									cluster = "engineering-dev"
+									url     = "https://kubernetes.default.svc"
+								},
+								{
+									cluster = "engineering-prod"
+									url     = "https://kubernetes.default.svc"
+								}
+							]
+						}
+					}
+					generator {
+						matrix {
+							generator {
+								cluster {}
+							}
+							generator {
+								git {
+									repo_url = "%[3]s"
+									revision = "HEAD"
+									directory {
+										path = "applicationset/examples/git-generator-directory/cluster-addons/*"
+									}
+								}
+							}
+						}
+					}
+					template {
+						metadata {
+							name = "{{cluster}}-guestbook"
+						}
+						spec {
+							source {
+								repo_url = "%[3]s"
+								path = "helm-guestbook"
+								target_revision = "master"
+							}
+							destination {
+								server = "{{url}}"
+								namespace = "%[4]s"
+							}
+						}
+					}
+				}
+			}
+		}
+		`, id, agentId, repo, namespace)
+}
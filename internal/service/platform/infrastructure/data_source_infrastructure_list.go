@@ -0,0 +1,173 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/antihax/optional"
+	"github.com/harness/harness-go-sdk/harness/nextgen"
+	"github.com/harness/terraform-provider-harness/helpers"
+	"github.com/harness/terraform-provider-harness/internal"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceInfrastructureList() *schema.Resource {
+	resource := &schema.Resource{
+		Description: "Data source for listing the Harness Infrastructures of an environment. Every page of results is fetched.",
+
+		ReadContext: dataSourceInfrastructureListRead,
+
+		Schema: map[string]*schema.Schema{
+			"env_id": {
+				Description: "environment identifier.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"type": {
+				Description: fmt.Sprintf("Filter the Infrastructures by type. Valid values are %s.", strings.Join(nextgen.InfrastructureTypeValues, ", ")),
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"deployment_type": {
+				Description: fmt.Sprintf("Filter the Infrastructures by deployment type. Valid values are %s.", strings.Join(nextgen.InfrastructureDeploymentypeValues, ", ")),
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"infrastructures": {
+				Description: "List of Infrastructures matching the filters.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identifier": {
+							Description: "identifier of the Infrastructure.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the Infrastructure.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "Description of the Infrastructure.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"org_id": {
+							Description: "Unique identifier of the organization.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"project_id": {
+							Description: "Unique identifier of the project.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"env_id": {
+							Description: "environment identifier.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"tags": {
+							Description: "Tags associated with the Infrastructure.",
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"type": {
+							Description: "Type of Infrastructure.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"deployment_type": {
+							Description: "Infrastructure deployment type.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"yaml": {
+							Description: "Infrastructure YAML",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	helpers.SetOptionalOrgAndProjectLevelDataSourceSchema(resource.Schema)
+	helpers.SetListDataSourceFilterSchema(resource.Schema)
+
+	return resource
+}
+
+func dataSourceInfrastructureListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
+
+	env_id := d.Get("env_id").(string)
+	infraType := d.Get("type").(string)
+	deploymentType := d.Get("deployment_type").(string)
+
+	opts := &nextgen.InfrastructuresApiGetInfrastructureListOpts{
+		OrgIdentifier:     helpers.BuildField(d, "org_id"),
+		ProjectIdentifier: helpers.BuildField(d, "project_id"),
+		SearchTerm:        helpers.BuildField(d, "search_term"),
+		Size:              optional.NewInt32(helpers.ListPageSize),
+	}
+
+	infrastructures := []interface{}{}
+	httpResp, err := helpers.ListAllPages(func(page int32) (bool, *http.Response, error) {
+		opts.Page = optional.NewInt32(page)
+
+		resp, httpResp, err := c.InfrastructuresApi.GetInfrastructureList(ctx, c.AccountId, env_id, opts)
+		if err != nil {
+			return false, httpResp, err
+		}
+
+		if resp.Data == nil {
+			return false, httpResp, nil
+		}
+
+		for _, v := range resp.Data.Content {
+			infra := v.Infrastructure
+			if infra == nil || !helpers.MatchesFilterTags(d, infra.Tags) {
+				continue
+			}
+
+			if infraType != "" && string(infra.Type_) != infraType {
+				continue
+			}
+
+			if deploymentType != "" && string(infra.DeploymentType) != deploymentType {
+				continue
+			}
+
+			infrastructures = append(infrastructures, map[string]interface{}{
+				"identifier":      infra.Identifier,
+				"name":            infra.Name,
+				"description":     infra.Description,
+				"org_id":          infra.OrgIdentifier,
+				"project_id":      infra.ProjectIdentifier,
+				"env_id":          infra.EnvironmentRef,
+				"tags":            helpers.FlattenTags(infra.Tags),
+				"type":            string(infra.Type_),
+				"deployment_type": string(infra.DeploymentType),
+				"yaml":            infra.Yaml,
+			})
+		}
+
+		return helpers.HasMorePages(page, resp.Data.TotalPages), httpResp, nil
+	})
+	if err != nil {
+		return helpers.HandleApiError(err, d, httpResp)
+	}
+
+	d.SetId(helpers.ListDataSourceId(c.AccountId, d, "org_id", "project_id", "env_id", "type", "deployment_type", "search_term", "filter_tags"))
+	d.Set("infrastructures", infrastructures)
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package infrastructure_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/harness/harness-go-sdk/harness/utils"
+	"github.com/harness/terraform-provider-harness/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceInfrastructureList(t *testing.T) {
+
+	id := fmt.Sprintf("%s_%s", t.Name(), utils.RandStringBytes(6))
+	name := id
+	resourceName := "data.harness_platform_infrastructure_list.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.TestAccPreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceInfrastructureList(id, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "infrastructures.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "infrastructures.0.identifier", id),
+					resource.TestCheckResourceAttr(resourceName, "infrastructures.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "infrastructures.0.env_id", id),
+					resource.TestCheckResourceAttr(resourceName, "infrastructures.0.type", "KubernetesDirect"),
+					resource.TestCheckResourceAttr(resourceName, "infrastructures.0.deployment_type", "Kubernetes"),
+					resource.TestCheckResourceAttr("data.harness_platform_infrastructure_list.ssh", "infrastructures.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceInfrastructureList(id string, name string) string {
+	return fmt.Sprintf(`
+		resource "harness_platform_organization" "test" {
+			identifier = "%[1]s"
+			name = "%[2]s"
+		}
+
+		resource "harness_platform_environment" "test" {
+			identifier = "%[1]s"
+			name = "%[2]s"
+			org_id = harness_platform_organization.test.id
+			type = "PreProduction"
+		}
+
+		resource "harness_platform_infrastructure" "test" {
+			identifier = "%[1]s"
+			name = "%[2]s"
+			org_id = harness_platform_organization.test.id
+			env_id = harness_platform_environment.test.id
+			type = "KubernetesDirect"
+			deployment_type = "Kubernetes"
+			yaml = <<-EOT
+			   infrastructureDefinition:
+         name: "%[2]s"
+         identifier: "%[1]s"
+         description: ""
+         orgIdentifier: ${harness_platform_organization.test.id}
+         environmentRef: ${harness_platform_environment.test.id}
+         deploymentType: Kubernetes
+         type: KubernetesDirect
+         spec:
+          connectorRef: account.gfgf
+          namespace: asdasdsa
+          releaseName: release-<+INFRA_KEY>
+          allowSimultaneousDeployments: false
+      EOT
+		}
+
+		data "harness_platform_infrastructure_list" "test" {
+			org_id = harness_platform_infrastructure.test.org_id
+			env_id = harness_platform_infrastructure.test.env_id
+			type = "KubernetesDirect"
+		}
+
+		data "harness_platform_infrastructure_list" "ssh" {
+			org_id = harness_platform_infrastructure.test.org_id
+			env_id = harness_platform_infrastructure.test.env_id
+			deployment_type = "Ssh"
+		}
+`, id, name)
+}
@@ -22,7 +22,13 @@ func ResourceInfrastructure() *schema.Resource {
 		UpdateContext: resourceInfrastructureCreateOrUpdate,
 		DeleteContext: resourceInfrastructureDelete,
 		CreateContext: resourceInfrastructureCreateOrUpdate,
-		Importer:      helpers.EnvRelatedResourceImporter,
+		CustomizeDiff: helpers.ValidateYamlDiff("infrastructure", "yaml", map[string]string{
+			"identifier":        "identifier",
+			"orgIdentifier":     "org_id",
+			"projectIdentifier": "project_id",
+			"environmentRef":    "env_id",
+		}),
+		Importer: helpers.EnvRelatedResourceImporter,
 
 		Schema: map[string]*schema.Schema{
 			"identifier": {
@@ -62,6 +68,8 @@ func ResourceInfrastructure() *schema.Resource {
 	}
 	helpers.SetMultiLevelResourceSchema(resource.Schema)
 
+	helpers.SetGitExperienceSchema(resource.Schema)
+
 	// overwrite schema for tags since these are read from the yaml
 	if s, ok := resource.Schema["tags"]; ok {
 		s.Computed = true
@@ -73,22 +81,28 @@ func ResourceInfrastructure() *schema.Resource {
 func resourceInfrastructureRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
 
-	env_id := d.Get("env_id").(string)
-
-	resp, httpResp, err := c.InfrastructuresApi.GetInfrastructure(ctx, d.Id(), c.AccountId, env_id, &nextgen.InfrastructuresApiGetInfrastructureOpts{
-		OrgIdentifier:     helpers.BuildField(d, "org_id"),
-		ProjectIdentifier: helpers.BuildField(d, "project_id"),
-	})
-
+	resp, httpResp, err := getInfrastructure(ctx, c, d, d.Id())
 	if err != nil {
 		return helpers.HandleReadApiError(err, d, httpResp)
 	}
 
 	readInfrastructure(d, resp.Data)
+	readInfrastructureGitDetails(d, resp.Data)
 
 	return nil
 }
 
+func getInfrastructure(ctx context.Context, c *nextgen.APIClient, d *schema.ResourceData, id string) (nextgen.ResponseDtoInfrastructureResponse, *http.Response, error) {
+	git := helpers.BuildGitReadOptions(d)
+
+	return c.InfrastructuresApi.GetInfrastructure(ctx, id, c.AccountId, d.Get("env_id").(string), &nextgen.InfrastructuresApiGetInfrastructureOpts{
+		OrgIdentifier:     helpers.BuildField(d, "org_id"),
+		ProjectIdentifier: helpers.BuildField(d, "project_id"),
+		Branch:            git.BranchName,
+		RepoName:          git.RepoName,
+	})
+}
+
 func resourceInfrastructureCreateOrUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
 
@@ -98,13 +112,49 @@ func resourceInfrastructureCreateOrUpdate(ctx context.Context, d *schema.Resourc
 	id := d.Id()
 	infra := buildInfrastructure(d)
 
-	if id == "" {
+	git := helpers.BuildGitOptions(d)
+
+	if id == "" && helpers.IsImportFromGit(d) {
+		gitImport := helpers.BuildGitImportOptions(d)
+		_, httpResp, err = c.InfrastructuresApi.ImportInfrastructure(ctx, c.AccountId, &nextgen.InfrastructuresApiImportInfrastructureOpts{
+			OrgIdentifier:         helpers.BuildField(d, "org_id"),
+			ProjectIdentifier:     helpers.BuildField(d, "project_id"),
+			EnvironmentIdentifier: optional.NewString(infra.EnvironmentRef),
+			InfraIdentifier:       optional.NewString(infra.Identifier),
+			ConnectorRef:          gitImport.ConnectorRef,
+			RepoName:              gitImport.RepoName,
+			Branch:                gitImport.BranchName,
+			FilePath:              gitImport.FilePath,
+			IsForceImport:         gitImport.IsForceImport,
+		})
+		if err == nil {
+			// The import only returns the identifier of the infrastructure.
+			resp, httpResp, err = getInfrastructure(ctx, c, d, infra.Identifier)
+		}
+	} else if id == "" {
 		resp, httpResp, err = c.InfrastructuresApi.CreateInfrastructure(ctx, c.AccountId, &nextgen.InfrastructuresApiCreateInfrastructureOpts{
-			Body: optional.NewInterface(infra),
+			Body:         optional.NewInterface(infra),
+			StoreType:    git.StoreType,
+			ConnectorRef: git.ConnectorRef,
+			RepoName:     git.RepoName,
+			FilePath:     git.FilePath,
+			BranchName:   git.BranchName,
+			BaseBranch:   git.BaseBranch,
+			IsNewBranch:  git.IsNewBranch,
+			CommitMsg:    git.CommitMessage,
 		})
 	} else {
 		resp, httpResp, err = c.InfrastructuresApi.UpdateInfrastructure(ctx, c.AccountId, &nextgen.InfrastructuresApiUpdateInfrastructureOpts{
-			Body: optional.NewInterface(infra),
+			Body:         optional.NewInterface(infra),
+			ConnectorRef: git.ConnectorRef,
+			RepoName:     git.RepoName,
+			FilePath:     git.FilePath,
+			BranchName:   git.BranchName,
+			BaseBranch:   git.BaseBranch,
+			IsNewBranch:  git.IsNewBranch,
+			CommitMsg:    git.CommitMessage,
+			LastObjectId: git.LastObjectId,
+			LastCommitId: git.LastCommitId,
 		})
 	}
 
@@ -113,6 +163,7 @@ func resourceInfrastructureCreateOrUpdate(ctx context.Context, d *schema.Resourc
 	}
 
 	readInfrastructure(d, resp.Data)
+	readInfrastructureGitDetails(d, resp.Data)
 
 	return nil
 }
@@ -161,3 +212,19 @@ func readInfrastructure(d *schema.ResourceData, infra *nextgen.InfrastructureRes
 	d.Set("deployment_type", infra.Infrastructure.DeploymentType)
 	d.Set("yaml", infra.Infrastructure.Yaml)
 }
+
+func readInfrastructureGitDetails(d *schema.ResourceData, infra *nextgen.InfrastructureResponse) {
+	if infra.Infrastructure.EntityGitDetails == nil {
+		return
+	}
+
+	helpers.SetGitDetails(d, &helpers.GitDetails{
+		StoreType:    infra.Infrastructure.StoreType,
+		ConnectorRef: infra.Infrastructure.ConnectorRef,
+		BranchName:   infra.Infrastructure.EntityGitDetails.BranchName,
+		FilePath:     infra.Infrastructure.EntityGitDetails.FilePath,
+		RepoName:     infra.Infrastructure.EntityGitDetails.RepoName,
+		LastCommitId: infra.Infrastructure.EntityGitDetails.CommitId,
+		LastObjectId: infra.Infrastructure.EntityGitDetails.ObjectId,
+	})
+}
@@ -36,8 +36,9 @@ func DataSourceInputSet() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"branch_name": {
-							Description: "Name of the branch.",
+							Description: "Name of the branch to read the Entity from. Defaults to the default branch of the repository.",
 							Type:        schema.TypeString,
+							Optional:    true,
 							Computed:    true,
 						},
 						"file_path": {
@@ -109,14 +110,7 @@ func dataSourceInputSetRead(ctx context.Context, d *schema.ResourceData, meta in
 
 	pipelineId := d.Get("pipeline_id").(string)
 
-	branch_name := helpers.BuildField(d, "git_details.0.branch_name")
-	parent_entity_connector_ref := helpers.BuildField(d, "git_details.0.parent_entity_connector_ref")
-	parent_entity_repo_name := helpers.BuildField(d, "git_details.0.parent_entity_repo_name")
-
-	store_type := helpers.BuildField(d, "git_details.0.store_type")
-	base_branch := helpers.BuildField(d, "git_details.0.base_branch")
-	commit_message := helpers.BuildField(d, "git_details.0.commit_message")
-	connector_ref := helpers.BuildField(d, "git_details.0.connector_ref")
+	git := helpers.BuildGitReadOptions(d)
 
 	resp, httpResp, err := c.InputSetsApi.GetInputSet(ctx,
 		d.Get("org_id").(string),
@@ -125,9 +119,9 @@ func dataSourceInputSetRead(ctx context.Context, d *schema.ResourceData, meta in
 		d.Get("pipeline_id").(string),
 		&nextgen.InputSetsApiGetInputSetOpts{
 			HarnessAccount:           optional.NewString(c.AccountId),
-			BranchName:               branch_name,
-			ParentEntityConnectorRef: parent_entity_connector_ref,
-			ParentEntityRepoName:     parent_entity_repo_name,
+			BranchName:               git.BranchName,
+			ParentEntityConnectorRef: git.ParentEntityConnectorRef,
+			ParentEntityRepoName:     git.ParentEntityRepoName,
 		},
 	)
 
@@ -135,7 +129,7 @@ func dataSourceInputSetRead(ctx context.Context, d *schema.ResourceData, meta in
 		return helpers.HandleApiError(err, d, httpResp)
 	}
 
-	readInputSet(d, &resp, pipelineId, store_type, base_branch, commit_message, connector_ref)
+	readInputSet(d, &resp, pipelineId)
 
 	return nil
 }
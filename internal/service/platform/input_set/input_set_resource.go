@@ -10,7 +10,6 @@ import (
 	"github.com/harness/terraform-provider-harness/internal"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func ResourceInputSet() *schema.Resource {
@@ -21,7 +20,13 @@ func ResourceInputSet() *schema.Resource {
 		UpdateContext: resourceInputSetCreateOrUpdate,
 		CreateContext: resourceInputSetCreateOrUpdate,
 		DeleteContext: resourceInputSetDelete,
-		Importer:      helpers.PipelineResourceImporter,
+		CustomizeDiff: helpers.ValidateYamlDiff("input_set", "yaml", map[string]string{
+			"identifier":          "identifier",
+			"orgIdentifier":       "org_id",
+			"projectIdentifier":   "project_id",
+			"pipeline.identifier": "pipeline_id",
+		}),
+		Importer: helpers.PipelineResourceImporter,
 
 		Schema: map[string]*schema.Schema{
 			"pipeline_id": {
@@ -33,126 +38,11 @@ func ResourceInputSet() *schema.Resource {
 				Description: "Input Set YAML." + helpers.Descriptions.YamlText.String(),
 				Type:        schema.TypeString,
 				Optional:    true,
-				Computed: true,
-			},
-			"git_details": {
-				Description: "Contains parameters related to creating an Entity for Git Experience.",
-				Type:        schema.TypeList,
-				MaxItems:    1,
-				Optional:    true,
-				Computed: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"branch_name": {
-							Description: "Name of the branch.",
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed: true,
-						},
-						"file_path": {
-							Description: "File path of the Entity in the repository.",
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed: true,
-						},
-						"commit_message": {
-							Description: "Commit message used for the merge commit.",
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed: true,
-						},
-						"base_branch": {
-							Description: "Name of the default branch (this checks out a new branch titled by branch_name).",
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
-						},
-						"connector_ref": {
-							Description: "Identifier of the Harness Connector used for CRUD operations on the Entity." + helpers.Descriptions.ConnectorRefText.String(),
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed: true,
-						},
-						"store_type": {
-							Description:  "Specifies whether the Entity is to be stored in Git or not. Possible values: INLINE, REMOTE.",
-							Type:         schema.TypeString,
-							Optional:     true,
-							Computed: true,
-							ValidateFunc: validation.StringInSlice([]string{"INLINE", "REMOTE"}, false),
-						},
-						"repo_name": {
-							Description: "Name of the repository.",
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed: true,
-						},
-						"last_object_id": {
-							Description: "Last object identifier (for Github). To be provided only when updating Pipeline.",
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
-						},
-						"last_commit_id": {
-							Description: "Last commit identifier (for Git Repositories other than Github). To be provided only when updating Pipeline.",
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
-						},
-						"parent_entity_connector_ref": {
-							Description: "Connector reference for Parent Entity (Pipeline)." + helpers.Descriptions.ConnectorRefText.String(),
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
-						},
-						"parent_entity_repo_name": {
-							Description: "Repository name for Parent Entity (Pipeline).",
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
-						},
-					},
-				},
-			},
-			"import_from_git": {
-				Description: "Flag to set if importing from Git",
-				Type:        schema.TypeBool,
-				Optional:    true,
-			},
-			"git_import_info": {
-				Description: "Contains Git Information for importing entities from Git",
-				Type:        schema.TypeList,
-				MaxItems:    1,
-				Optional:    true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"branch_name": {
-							Description: "Name of the branch.",
-							Type:        schema.TypeString,
-							Optional:    true,
-						},
-						"file_path": {
-							Description: "File path of the Entity in the repository.",
-							Type:        schema.TypeString,
-							Optional:    true,
-						},
-						"connector_ref": {
-							Description: "Identifier of the Harness Connector used for importing entity from Git" + helpers.Descriptions.ConnectorRefText.String(),
-							Type:        schema.TypeString,
-							Optional:    true,
-						},
-						"repo_name": {
-							Description: "Name of the repository.",
-							Type:        schema.TypeString,
-							Optional:    true,
-						},
-						"is_force_import": {
-							Description: "",
-							Type:        schema.TypeBool,
-							Optional:    true,
-						},
-					},
-				},
+				Computed:    true,
 			},
+			"git_details":     helpers.GetGitDetailsSchema(true),
+			"import_from_git": helpers.GetImportFromGitSchema(),
+			"git_import_info": helpers.GetGitImportInfoSchema(),
 			"input_set_import_request": {
 				Description: "Contains parameters for importing a input set",
 				Type:        schema.TypeList,
@@ -176,6 +66,7 @@ func ResourceInputSet() *schema.Resource {
 		},
 	}
 	helpers.SetProjectLevelResourceSchema(resource.Schema)
+	helpers.SetGitMoveConfigSchema(resource.Schema)
 
 	return resource
 }
@@ -191,25 +82,16 @@ func resourceInputSetRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	pipelineId := d.Get("pipeline_id").(string)
 
-	var branch_name optional.String
-
-	branch_name = helpers.BuildField(d, "git_details.0.branch_name")
-	parent_entity_connector_ref := helpers.BuildField(d, "git_details.0.parent_entity_connector_ref")
-	parent_entity_repo_name := helpers.BuildField(d, "git_details.0.parent_entity_repo_name")
-
-	var store_type = helpers.BuildField(d, "git_details.0.store_type")
-	var base_branch = helpers.BuildField(d, "git_details.0.base_branch")
-	var commit_message = helpers.BuildField(d, "git_details.0.commit_message")
-	var connector_ref = helpers.BuildField(d, "git_details.0.connector_ref")
+	git := helpers.BuildGitReadOptions(d)
 
 	resp, httpResp, err := c.InputSetsApi.GetInputSet(ctx, orgId, projectId, id, pipelineId, &nextgen.InputSetsApiGetInputSetOpts{
 		HarnessAccount:           optional.NewString(c.AccountId),
-		BranchName:               branch_name,
-		ParentEntityConnectorRef: parent_entity_connector_ref,
-		ParentEntityRepoName:     parent_entity_repo_name,
+		BranchName:               git.BranchName,
+		ParentEntityConnectorRef: git.ParentEntityConnectorRef,
+		ParentEntityRepoName:     git.ParentEntityRepoName,
 	})
 
-	if httpResp.StatusCode == 404 {
+	if httpResp != nil && httpResp.StatusCode == 404 {
 		d.SetId("")
 		d.MarkNewResource()
 		return nil
@@ -219,7 +101,7 @@ func resourceInputSetRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return helpers.HandleApiError(err, d, httpResp)
 	}
 
-	readInputSet(d, &resp, pipelineId, store_type, base_branch, commit_message, connector_ref)
+	readInputSet(d, &resp, pipelineId)
 
 	return nil
 
@@ -229,7 +111,6 @@ func resourceInputSetCreateOrUpdate(ctx context.Context, d *schema.ResourceData,
 	c, ctx := meta.(*internal.Session).GetClientWithContext(ctx)
 
 	var err error
-	var inputSet_id string
 	var resp nextgen.InputSetResponseBody
 	var response nextgen.InputSetSaveResponseBody
 	var httpResp *http.Response
@@ -239,14 +120,9 @@ func resourceInputSetCreateOrUpdate(ctx context.Context, d *schema.ResourceData,
 	projectIdentifier := d.Get("project_id").(string)
 	pipelineIdentifier := d.Get("pipeline_id").(string)
 
-	var store_type optional.String
-	var base_branch optional.String
-	var commit_message optional.String
-	var connector_ref optional.String
-
 	if id == "" {
-		if d.Get("import_from_git").(bool) {
-			inputSet_id = d.Get("identifier").(string)
+		if helpers.IsImportFromGit(d) {
+			inputSet_id := d.Get("identifier").(string)
 
 			input_set_import_request_body := createImportFromGitRequest(d)
 
@@ -255,63 +131,73 @@ func resourceInputSetCreateOrUpdate(ctx context.Context, d *schema.ResourceData,
 					Body:           optional.NewInterface(input_set_import_request_body),
 					HarnessAccount: optional.NewString(c.AccountId)})
 
-		} else{
+		} else {
 			inputSet := buildCreateInputSet(d)
-			if inputSet.GitDetails != nil {
-				base_branch = optional.NewString(inputSet.GitDetails.BaseBranch)
-				store_type = optional.NewString(inputSet.GitDetails.StoreType)
-				commit_message = optional.NewString(inputSet.GitDetails.CommitMessage)
-				connector_ref = optional.NewString(inputSet.GitDetails.ConnectorRef)
-			}
-
-			inputSet_id = inputSet.Identifier
 			resp, httpResp, err = c.InputSetsApi.CreateInputSet(ctx, inputSet, pipelineIdentifier, orgIdentifier, projectIdentifier, &nextgen.InputSetsApiCreateInputSetOpts{
 				HarnessAccount: optional.NewString(c.AccountId),
 			})
 		}
 	} else {
 		inputSet := buildUpdateInputSet(d)
-		if inputSet.GitDetails != nil {
-			base_branch = optional.NewString(inputSet.GitDetails.BaseBranch)
-			commit_message = optional.NewString(inputSet.GitDetails.CommitMessage)
+
+		moved := false
+		if moveConfigType := helpers.GetGitMoveConfigType(d); moveConfigType != "" {
+			body := helpers.BuildGitMoveConfigRequestBody(d, moveConfigType)
+			body.PipelineIdentifier = pipelineIdentifier
+
+			_, httpResp, err = c.InputSetsApi.MoveConfig(ctx, orgIdentifier, projectIdentifier, id, &nextgen.InputSetsApiMoveConfigOpts{
+				Body:           optional.NewInterface(body),
+				HarnessAccount: optional.NewString(c.AccountId),
+			})
+			if err != nil {
+				return helpers.HandleApiError(err, d, httpResp)
+			}
+			moved = true
 		}
-		store_type = helpers.BuildField(d, "git_details.0.store_type")
-		connector_ref = helpers.BuildField(d, "git_details.0.connector_ref")
 
-		resp, httpResp, err = c.InputSetsApi.UpdateInputSet(ctx, inputSet, pipelineIdentifier, orgIdentifier, projectIdentifier, id, &nextgen.InputSetsApiUpdateInputSetOpts{
-			HarnessAccount: optional.NewString(c.AccountId),
-		})
+		// Moving the input set commits its current yaml, the input set is only updated when something else changed.
+		if !moved || d.HasChangesExcept("git_details") {
+			if moved && inputSet.GitDetails != nil {
+				// The object and commit in state are the ones before the move.
+				inputSet.GitDetails.LastObjectId = ""
+				inputSet.GitDetails.LastCommitId = ""
+			}
+			resp, httpResp, err = c.InputSetsApi.UpdateInputSet(ctx, inputSet, pipelineIdentifier, orgIdentifier, projectIdentifier, id, &nextgen.InputSetsApiUpdateInputSetOpts{
+				HarnessAccount: optional.NewString(c.AccountId),
+			})
+		}
 	}
 
 	if err != nil {
 		return helpers.HandleApiError(err, d, httpResp)
 	}
 
-	if d.Get("import_from_git").(bool) {
-
-		inputSet_id = response.Identifier
+	if id != "" && resp.Identifier == "" {
+		// Only the input set was moved, it's read from its new location.
+		response.Identifier = id
+	}
 
-		branch_name := helpers.BuildField(d, "git_import_info.0.branch_name")
-		parent_entity_connector_ref := helpers.BuildField(d, "git_import_info.0.connector_ref")
-		parent_entity_repo_name := helpers.BuildField(d, "git_import_info.0.repo_name")
+	if response.Identifier != "" {
+		// The move and the import don't return the input set.
+		git := helpers.BuildGitReadOptions(d)
+		parentEntityConnectorRef, parentEntityRepoName := git.ParentEntityConnectorRef, git.ParentEntityRepoName
+		if id == "" {
+			// The imported input set is stored along with its pipeline.
+			parentEntityConnectorRef, parentEntityRepoName = git.ConnectorRef, git.RepoName
+		}
 
-		resp, httpResp, err := c.InputSetsApi.GetInputSet(ctx, orgIdentifier, projectIdentifier, inputSet_id, pipelineIdentifier, &nextgen.InputSetsApiGetInputSetOpts{
+		resp, httpResp, err = c.InputSetsApi.GetInputSet(ctx, orgIdentifier, projectIdentifier, response.Identifier, pipelineIdentifier, &nextgen.InputSetsApiGetInputSetOpts{
 			HarnessAccount:           optional.NewString(c.AccountId),
-			BranchName:               branch_name,
-			ParentEntityConnectorRef: parent_entity_connector_ref,
-			ParentEntityRepoName:     parent_entity_repo_name,
+			BranchName:               git.BranchName,
+			ParentEntityConnectorRef: parentEntityConnectorRef,
+			ParentEntityRepoName:     parentEntityRepoName,
 		})
-
 		if err != nil {
 			return helpers.HandleApiError(err, d, httpResp)
 		}
-
-		readInputSet(d, &resp, pipelineIdentifier, optional.NewString("REMOTE"), optional.EmptyString() , optional.EmptyString(), parent_entity_connector_ref)
-
-		return nil
 	}
 
-	readInputSet(d, &resp, pipelineIdentifier, store_type, base_branch, commit_message, connector_ref)
+	readInputSet(d, &resp, pipelineIdentifier)
 
 	return nil
 }
@@ -319,23 +205,12 @@ func resourceInputSetCreateOrUpdate(ctx context.Context, d *schema.ResourceData,
 func createImportFromGitRequest(d *schema.ResourceData) *nextgen.InputSetsImportRequestBody {
 
 	input_set_git_import_info := &nextgen.GitImportInfo{}
-	if attr, ok := d.GetOk("git_import_info"); ok {
-		config := attr.([]interface{})[0].(map[string]interface{})
-		if attr, ok := config["branch_name"]; ok {
-			input_set_git_import_info.BranchName = attr.(string)
-		}
-		if attr, ok := config["file_path"]; ok {
-			input_set_git_import_info.FilePath = attr.(string)
-		}
-		if attr, ok := config["connector_ref"]; ok {
-			input_set_git_import_info.ConnectorRef = attr.(string)
-		}
-		if attr, ok := config["repo_name"]; ok {
-			input_set_git_import_info.RepoName = attr.(string)
-		}
-		if attr, ok := config["is_force_import"]; ok {
-			input_set_git_import_info.IsForceImport = attr.(bool)
-		}
+	if info := helpers.ExpandGitImportInfo(d); info != nil {
+		input_set_git_import_info.BranchName = info.BranchName
+		input_set_git_import_info.FilePath = info.FilePath
+		input_set_git_import_info.ConnectorRef = info.ConnectorRef
+		input_set_git_import_info.RepoName = info.RepoName
+		input_set_git_import_info.IsForceImport = info.IsForceImport
 	}
 
 	input_set_import_request := &nextgen.InputSetsImportRequestDto{}
@@ -382,29 +257,15 @@ func buildCreateInputSet(d *schema.ResourceData) nextgen.InputSetCreateRequestBo
 		InputSetYaml: d.Get("yaml").(string),
 	}
 
-	if attr, ok := d.GetOk("git_details"); ok {
-		config := attr.([]interface{})[0].(map[string]interface{})
-		inputSet.GitDetails = &nextgen.GitCreateDetails{}
-		if attr, ok := config["branch_name"]; ok {
-			inputSet.GitDetails.BranchName = attr.(string)
-		}
-		if attr, ok := config["file_path"]; ok {
-			inputSet.GitDetails.FilePath = attr.(string)
-		}
-		if attr, ok := config["commit_message"]; ok {
-			inputSet.GitDetails.CommitMessage = attr.(string)
-		}
-		if attr, ok := config["base_branch"]; ok {
-			inputSet.GitDetails.BaseBranch = attr.(string)
-		}
-		if attr, ok := config["connector_ref"]; ok {
-			inputSet.GitDetails.ConnectorRef = attr.(string)
-		}
-		if attr, ok := config["store_type"]; ok {
-			inputSet.GitDetails.StoreType = attr.(string)
-		}
-		if attr, ok := config["repo_name"]; ok {
-			inputSet.GitDetails.RepoName = attr.(string)
+	if git := helpers.ExpandGitDetails(d); git != nil {
+		inputSet.GitDetails = &nextgen.GitCreateDetails{
+			BranchName:    git.BranchName,
+			FilePath:      git.FilePath,
+			CommitMessage: git.CommitMessage,
+			BaseBranch:    git.BaseBranch,
+			ConnectorRef:  git.ConnectorRef,
+			StoreType:     git.StoreType,
+			RepoName:      git.RepoName,
 		}
 	}
 	return inputSet
@@ -419,40 +280,21 @@ func buildUpdateInputSet(d *schema.ResourceData) nextgen.InputSetUpdateRequestBo
 		InputSetYaml: d.Get("yaml").(string),
 	}
 
-	if attr, ok := d.GetOk("git_details"); ok {
-		configs := attr.([]interface{})
-		if len(configs) > 0 {
-			config := configs[0].(map[string]interface{})
-
-			inputSet.GitDetails = &nextgen.InputSetGitUpdateDetails{}
-
-			if attr, ok := config["branch_name"]; ok {
-				inputSet.GitDetails.BranchName = attr.(string)
-			}
-			if attr, ok := config["commit_message"]; ok {
-				inputSet.GitDetails.CommitMessage = attr.(string)
-			}
-			if attr, ok := config["base_branch"]; ok {
-				inputSet.GitDetails.BaseBranch = attr.(string)
-			}
-			if attr, ok := config["last_object_id"]; ok {
-				inputSet.GitDetails.LastObjectId = attr.(string)
-			}
-			if attr, ok := config["last_commit_id"]; ok {
-				inputSet.GitDetails.LastCommitId = attr.(string)
-			}
-			if attr, ok := config["parent_entity_connector_ref"]; ok {
-				inputSet.GitDetails.ParentEntityConnectorRef = attr.(string)
-			}
-			if attr, ok := config["parent_entity_repo_name"]; ok {
-				inputSet.GitDetails.ParentEntityRepoName = attr.(string)
-			}
+	if git := helpers.ExpandGitDetails(d); git != nil {
+		inputSet.GitDetails = &nextgen.InputSetGitUpdateDetails{
+			BranchName:               git.BranchName,
+			CommitMessage:            git.CommitMessage,
+			BaseBranch:               git.BaseBranch,
+			LastObjectId:             git.LastObjectId,
+			LastCommitId:             git.LastCommitId,
+			ParentEntityConnectorRef: git.ParentEntityConnectorRef,
+			ParentEntityRepoName:     git.ParentEntityRepoName,
 		}
 	}
 	return inputSet
 }
 
-func readInputSet(d *schema.ResourceData, inputSet *nextgen.InputSetResponseBody, pipelineId string, store_type optional.String, base_branch optional.String, commit_message optional.String, connector_ref optional.String) {
+func readInputSet(d *schema.ResourceData, inputSet *nextgen.InputSetResponseBody, pipelineId string) {
 	d.SetId(inputSet.Identifier)
 	d.Set("identifier", inputSet.Identifier)
 	d.Set("name", inputSet.Name)
@@ -463,29 +305,12 @@ func readInputSet(d *schema.ResourceData, inputSet *nextgen.InputSetResponseBody
 	d.Set("pipeline_id", pipelineId)
 	d.Set("yaml", inputSet.InputSetYaml)
 	if inputSet.GitDetails != nil {
-		d.Set("git_details", []interface{}{readGitDetails(inputSet, store_type, base_branch, commit_message, connector_ref)})
-	}
-}
-
-func readGitDetails(inputSet *nextgen.InputSetResponseBody, store_type optional.String, base_branch optional.String, commit_message optional.String, connector_ref optional.String) map[string]interface{} {
-	git_details := map[string]interface{}{
-		"branch_name":    inputSet.GitDetails.BranchName,
-		"file_path":      inputSet.GitDetails.FilePath,
-		"repo_name":      inputSet.GitDetails.RepoName,
-		"last_commit_id": inputSet.GitDetails.CommitId,
-		"last_object_id": inputSet.GitDetails.ObjectId,
-	}
-	if store_type.IsSet() {
-		git_details["store_type"] = store_type.Value()
-	}
-	if base_branch.IsSet() {
-		git_details["base_branch"] = base_branch.Value()
-	}
-	if commit_message.IsSet() {
-		git_details["commit_message"] = commit_message.Value()
-	}
-	if connector_ref.IsSet() {
-		git_details["connector_ref"] = connector_ref.Value()
+		helpers.SetGitDetails(d, &helpers.GitDetails{
+			BranchName:   inputSet.GitDetails.BranchName,
+			FilePath:     inputSet.GitDetails.FilePath,
+			RepoName:     inputSet.GitDetails.RepoName,
+			LastCommitId: inputSet.GitDetails.CommitId,
+			LastObjectId: inputSet.GitDetails.ObjectId,
+		})
 	}
-	return git_details
 }
@@ -19,6 +19,7 @@ func ResourceManualFreeze() *schema.Resource {
 		UpdateContext: resourceManualFreezeCreateOrUpdate,
 		DeleteContext: resourceManualFreezeDelete,
 		CreateContext: resourceManualFreezeCreateOrUpdate,
+		CustomizeDiff: helpers.ValidateYamlDiff("freeze", "yaml", helpers.YamlScopeArguments),
 		Importer:      helpers.MultiLevelResourceImporter,
 
 		Schema: map[string]*schema.Schema{
@@ -0,0 +1,973 @@
+package monitored_service
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// healthSourceTypes lists the health source types supported by the monitored service.
+var healthSourceTypes = []string{
+	"AppDynamics", "NewRelic", "StackdriverLog", "Splunk", "Prometheus", "Stackdriver", "DatadogMetrics", "DatadogLog",
+	"Dynatrace", "ErrorTracking", "CustomHealthMetric", "CustomHealthLog", "SplunkMetric", "ElasticSearch",
+	"CloudWatchMetrics", "AwsPrometheus", "SumologicMetrics", "SumologicLogs", "SplunkSignalFXMetrics",
+	"GrafanaLokiLogs", "AzureLogs", "AzureMetrics",
+}
+
+// changeSourceTypes lists the change source types supported by the monitored service.
+var changeSourceTypes = []string{"HarnessCDNextGen", "PagerDuty", "K8sCluster", "HarnessCD"}
+
+// healthSourceBlocks maps the typed health source blocks to the health source types they configure. The other types
+// are configured with the JSON spec.
+var healthSourceBlocks = map[string][]string{
+	"app_dynamics":         {"AppDynamics"},
+	"custom_health_metric": {"CustomHealthMetric"},
+	"datadog_metrics":      {"DatadogMetrics"},
+	"dynatrace":            {"Dynatrace"},
+	"elastic_search":       {"ElasticSearch"},
+	"new_relic":            {"NewRelic"},
+	"prometheus":           {"Prometheus"},
+	"splunk":               {"Splunk"},
+	"sumologic":            {"SumologicMetrics", "SumologicLogs"},
+}
+
+// changeSourceBlocks maps the typed change source blocks to the change source types they configure.
+var changeSourceBlocks = map[string][]string{
+	"pager_duty":  {"PagerDuty"},
+	"k8s_cluster": {"K8sCluster"},
+}
+
+func getHealthSourceBlocksSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"prometheus": {
+			Description: "Prometheus health source, for health sources of type `Prometheus`.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"connector_ref": getConnectorRefSchema(),
+					"metric_definition": getMetricDefinitionSchema(map[string]*schema.Schema{
+						"query": {
+							Description: "PromQL query of the metric.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+					}, true),
+					"metric_pack": getMetricPackSchema(),
+				},
+			},
+		},
+		"datadog_metrics": {
+			Description: "Datadog metrics health source, for health sources of type `DatadogMetrics`.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"connector_ref": getConnectorRefSchema(),
+					"feature":       getFeatureSchema("Datadog Cloud Metrics"),
+					"metric_definition": getMetricDefinitionSchema(map[string]*schema.Schema{
+						"query": {
+							Description: "Datadog query of the metric.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"grouping_query": {
+							Description: "Datadog query grouping the metric by service instance.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"metric": {
+							Description: "Datadog metric, when the metric comes from a dashboard.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"aggregation": {
+							Description: "Aggregation of the metric, e.g. `avg`.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"dashboard_name": {
+							Description: "Name of the dashboard the metric comes from.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"metric_path": {
+							Description: "Path of the metric in the dashboard.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+					}, true),
+					"metric_pack": getMetricPackSchema(),
+				},
+			},
+		},
+		"app_dynamics": {
+			Description: "AppDynamics health source, for health sources of type `AppDynamics`.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"connector_ref": getConnectorRefSchema(),
+					"feature":       getFeatureSchema("Application Monitoring"),
+					"application_name": {
+						Description: "Name of the AppDynamics application.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"tier_name": {
+						Description: "Name of the AppDynamics tier.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"metric_definition": getMetricDefinitionSchema(map[string]*schema.Schema{
+						"base_folder": {
+							Description: "Base folder of the metric in the AppDynamics metric browser.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"metric_path": {
+							Description: "Path of the metric relative to the tier.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"complete_metric_path": {
+							Description: "Complete path of the metric, e.g. `Overall Application Performance|docker-tier|Calls per Minute`.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"service_instance_metric_path": {
+							Description: "Path of the metric of each service instance, used by continuous verification.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+					}, false),
+					"metric_pack": getMetricPackSchema(),
+				},
+			},
+		},
+		"new_relic": {
+			Description: "New Relic health source, for health sources of type `NewRelic`.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"connector_ref": getConnectorRefSchema(),
+					"feature":       getFeatureSchema("apm"),
+					"application_id": {
+						Description: "Identifier of the New Relic application.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"application_name": {
+						Description: "Name of the New Relic application.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"metric_definition": getMetricDefinitionSchema(map[string]*schema.Schema{
+						"nrql": {
+							Description: "NRQL query of the metric.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"metric_value_json_path": {
+							Description: "JSON path of the metric values in the query response.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"timestamp_json_path": {
+							Description: "JSON path of the timestamps in the query response.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+					}, false),
+					"metric_pack": getMetricPackSchema(),
+				},
+			},
+		},
+		"dynatrace": {
+			Description: "Dynatrace health source, for health sources of type `Dynatrace`.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"connector_ref": getConnectorRefSchema(),
+					"feature":       getFeatureSchema("dynatrace_apm"),
+					"service_id": {
+						Description: "Identifier of the Dynatrace service.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"service_name": {
+						Description: "Name of the Dynatrace service.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"service_method_ids": {
+						Description: "Identifiers of the methods of the Dynatrace service.",
+						Type:        schema.TypeList,
+						Optional:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+					"metric_definition": getMetricDefinitionSchema(map[string]*schema.Schema{
+						"metric_selector": {
+							Description: "Metric selector of the metric.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+					}, true),
+					"metric_pack": getMetricPackSchema(),
+				},
+			},
+		},
+		"custom_health_metric": {
+			Description: "Custom health source fetching metrics from an HTTP API, for health sources of type `CustomHealthMetric`.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"connector_ref": getConnectorRefSchema(),
+					"metric_definition": getMetricDefinitionSchema(map[string]*schema.Schema{
+						"query_type": {
+							Description: "Type of the query, `SERVICE_BASED` or `HOST_BASED`.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "SERVICE_BASED",
+							ValidateFunc: validation.StringInSlice([]string{
+								"SERVICE_BASED", "HOST_BASED",
+							}, false),
+						},
+						"method": {
+							Description: "HTTP method of the request, `GET` or `POST`.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "GET",
+							ValidateFunc: validation.StringInSlice([]string{
+								"GET", "POST",
+							}, false),
+						},
+						"url_path": {
+							Description: "Path of the request, relative to the URL of the connector.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"request_body": {
+							Description: "Body of the request.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"start_time_placeholder": {
+							Description: "Placeholder of the start time in the request.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"end_time_placeholder": {
+							Description: "Placeholder of the end time in the request.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"time_format": {
+							Description: "Format of the start and end times in the request, `SECONDS`, `MILLISECONDS` or `CUSTOM`.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "MILLISECONDS",
+							ValidateFunc: validation.StringInSlice([]string{
+								"SECONDS", "MILLISECONDS", "CUSTOM",
+							}, false),
+						},
+						"metric_value_json_path": {
+							Description: "JSON path of the metric values in the response.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"timestamp_json_path": {
+							Description: "JSON path of the timestamps in the response.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"timestamp_format": {
+							Description: "Format of the timestamps in the response.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"service_instance_json_path": {
+							Description: "JSON path of the service instances in the response.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+					}, false),
+					"metric_pack": getMetricPackSchema(),
+				},
+			},
+		},
+		"splunk": {
+			Description: "Splunk logs health source, for health sources of type `Splunk`.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"connector_ref": getConnectorRefSchema(),
+					"feature":       getFeatureSchema("Splunk Cloud Logs"),
+					"query": {
+						Description: "Log queries of the health source.",
+						Type:        schema.TypeList,
+						Required:    true,
+						MinItems:    1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"identifier": {
+									Description: "Identifier of the query.",
+									Type:        schema.TypeString,
+									Required:    true,
+								},
+								"name": {
+									Description: "Name of the query.",
+									Type:        schema.TypeString,
+									Required:    true,
+								},
+								"query": {
+									Description: "Splunk search of the query.",
+									Type:        schema.TypeString,
+									Required:    true,
+								},
+								"service_instance_identifier": {
+									Description: "Field of the logs identifying the service instance, e.g. `['host']`.",
+									Type:        schema.TypeString,
+									Required:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"elastic_search": {
+			Description: "ELK health source, for health sources of type `ElasticSearch`. The `version` of the health source must be `v2`.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"connector_ref":    getConnectorRefSchema(),
+					"query_definition": getQueryDefinitionSchema(false),
+				},
+			},
+		},
+		"sumologic": {
+			Description: "Sumo Logic health source, for health sources of type `SumologicMetrics` or `SumologicLogs`. The `version` of the health source must be `v2`.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"connector_ref":    getConnectorRefSchema(),
+					"query_definition": getQueryDefinitionSchema(true),
+				},
+			},
+		},
+	}
+}
+
+func getChangeSourceBlocksSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"pager_duty": {
+			Description: "PagerDuty change source, for change sources of type `PagerDuty`.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"connector_ref": getConnectorRefSchema(),
+					"pager_duty_service_id": {
+						Description: "Identifier of the PagerDuty service.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+				},
+			},
+		},
+		"k8s_cluster": {
+			Description: "Kubernetes change source, for change sources of type `K8sCluster`.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"connector_ref": getConnectorRefSchema(),
+				},
+			},
+		},
+	}
+}
+
+func getConnectorRefSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Reference to the connector of the source.",
+		Type:        schema.TypeString,
+		Required:    true,
+	}
+}
+
+func getFeatureSchema(defaultFeature string) *schema.Schema {
+	return &schema.Schema{
+		Description: "Feature of the health source.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     defaultFeature,
+	}
+}
+
+func getRiskProfileSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Risk profile of the metric.",
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"risk_category": {
+					Description: "Risk category of the metric.",
+					Type:        schema.TypeString,
+					Required:    true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"Errors", "Infrastructure", "Performance_Throughput", "Performance_Other", "Performance_ResponseTime",
+					}, false),
+				},
+				"threshold_types": {
+					Description: "Directions of the deviations of the metric considered as a risk, `ACT_WHEN_HIGHER` and/or `ACT_WHEN_LOWER`.",
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice([]string{"ACT_WHEN_HIGHER", "ACT_WHEN_LOWER"}, false),
+					},
+				},
+			},
+		},
+	}
+}
+
+func getMetricThresholdSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Custom thresholds of the metrics.",
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Description:  "Type of the threshold, `IgnoreThreshold` or `FailImmediately`.",
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice([]string{"IgnoreThreshold", "FailImmediately"}, false),
+				},
+				"metric_name": {
+					Description: "Name of the metric.",
+					Type:        schema.TypeString,
+					Required:    true,
+				},
+				"metric_type": {
+					Description: "Type of the metric, e.g. `Custom`.",
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "Custom",
+				},
+				"metric_identifier": {
+					Description: "Identifier of the metric.",
+					Type:        schema.TypeString,
+					Optional:    true,
+				},
+				"group_name": {
+					Description: "Group of the metric.",
+					Type:        schema.TypeString,
+					Optional:    true,
+				},
+				"action": {
+					Description: "Action of the threshold, `Ignore` for thresholds of type `IgnoreThreshold`, `FailImmediately`, `FailAfterOccurrence` or `FailAfterConsecutiveOccurrence` otherwise.",
+					Type:        schema.TypeString,
+					Required:    true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"Ignore", "FailImmediately", "FailAfterOccurrence", "FailAfterConsecutiveOccurrence",
+					}, false),
+				},
+				"count": {
+					Description: "Number of occurrences after which the threshold fails.",
+					Type:        schema.TypeInt,
+					Optional:    true,
+				},
+				"criteria_type": {
+					Description:  "Type of the criteria, `Absolute` or `Percentage`.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "Absolute",
+					ValidateFunc: validation.StringInSlice([]string{"Absolute", "Percentage"}, false),
+				},
+				"greater_than": {
+					Description: "The threshold applies when the metric is greater than this value.",
+					Type:        schema.TypeFloat,
+					Optional:    true,
+				},
+				"less_than": {
+					Description: "The threshold applies when the metric is less than this value.",
+					Type:        schema.TypeFloat,
+					Optional:    true,
+				},
+			},
+		},
+	}
+}
+
+func getMetricPackSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Metric packs of the health source. Custom thresholds are set on the `Custom` metric pack.",
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"identifier": {
+					Description: "Identifier of the metric pack, e.g. `Errors`, `Performance` or `Custom`.",
+					Type:        schema.TypeString,
+					Required:    true,
+				},
+				"metric_threshold": getMetricThresholdSchema(),
+			},
+		},
+	}
+}
+
+// getMetricDefinitionSchema returns the schema of the custom metrics of a health source, made of the fields common to
+// all the health sources and of the given fields specific to the type of health source.
+func getMetricDefinitionSchema(fields map[string]*schema.Schema, manualQuery bool) *schema.Schema {
+	metricDefinition := map[string]*schema.Schema{
+		"identifier": {
+			Description: "Identifier of the metric.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"metric_name": {
+			Description: "Name of the metric.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"group_name": {
+			Description: "Group of the metric.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"risk_profile": getRiskProfileSchema(),
+		"live_monitoring_enabled": {
+			Description: "Use the metric for live monitoring.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		},
+		"deployment_verification_enabled": {
+			Description: "Use the metric for continuous verification.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		},
+		"service_instance_field_name": {
+			Description: "Field identifying the service instance, used by continuous verification.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"sli_enabled": {
+			Description: "Use the metric for SLIs.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		},
+	}
+	if manualQuery {
+		metricDefinition["is_manual_query"] = &schema.Schema{
+			Description: "Indicates if the query was written manually rather than built from the metric browser.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+		}
+	}
+	for k, v := range fields {
+		metricDefinition[k] = v
+	}
+
+	return &schema.Schema{
+		Description: "Custom metrics of the health source.",
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: metricDefinition,
+		},
+	}
+}
+
+// getQueryDefinitionSchema returns the schema of the queries of the health sources of version v2.
+func getQueryDefinitionSchema(metrics bool) *schema.Schema {
+	queryDefinition := map[string]*schema.Schema{
+		"identifier": {
+			Description: "Identifier of the query.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"name": {
+			Description: "Name of the query.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"group_name": {
+			Description: "Group of the query.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"query": {
+			Description: "Query of the health source.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"index": {
+			Description: "Index queried.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"service_instance_field": {
+			Description: "Field identifying the service instance.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"timestamp_identifier": {
+			Description: "Field of the timestamp of the logs.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"timestamp_format": {
+			Description: "Format of the timestamp of the logs.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"message_identifier": {
+			Description: "Field of the message of the logs.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"live_monitoring_enabled": {
+			Description: "Use the query for live monitoring.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		},
+		"continuous_verification_enabled": {
+			Description: "Use the query for continuous verification.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		},
+	}
+	if metrics {
+		queryDefinition["sli_enabled"] = &schema.Schema{
+			Description: "Use the query for SLIs.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		}
+		queryDefinition["risk_profile"] = getRiskProfileSchema()
+		queryDefinition["metric_threshold"] = getMetricThresholdSchema()
+	}
+
+	return &schema.Schema{
+		Description: "Queries of the health source.",
+		Type:        schema.TypeList,
+		Required:    true,
+		MinItems:    1,
+		Elem: &schema.Resource{
+			Schema: queryDefinition,
+		},
+	}
+}
+
+// getSourceSpec returns the JSON spec of a health or change source, either set directly in `spec` or expanded from
+// the typed block matching the type of the source. An error is returned unless exactly one of them is set.
+func getSourceSpec(kind string, source map[string]interface{}, blocks map[string][]string) (string, error) {
+	sourceType := source["type"].(string)
+	spec, _ := source["spec"].(string)
+
+	setBlocks := []string{}
+	for block := range blocks {
+		if v, ok := source[block].([]interface{}); ok && len(v) > 0 {
+			setBlocks = append(setBlocks, block)
+		}
+	}
+	sort.Strings(setBlocks)
+
+	if len(setBlocks) == 0 {
+		return spec, nil
+	}
+	if len(setBlocks) > 1 || spec != "" {
+		return "", fmt.Errorf("%s %s: only one of spec, %s can be set", kind, source["identifier"], strings.Join(setBlocks, ", "))
+	}
+
+	block := setBlocks[0]
+	if !isBlockOfType(blocks[block], sourceType) {
+		return "", fmt.Errorf("%s %s: %s can't be used with sources of type %s, expected one of %s", kind, source["identifier"], block, sourceType, strings.Join(blocks[block], ", "))
+	}
+
+	data := map[string]interface{}{}
+	if v := source[block].([]interface{})[0]; v != nil {
+		data = v.(map[string]interface{})
+	}
+
+	expanded, err := json.Marshal(expandSourceBlock(block, sourceType, data))
+	if err != nil {
+		return "", fmt.Errorf("%s %s: %w", kind, source["identifier"], err)
+	}
+	return string(expanded), nil
+}
+
+func isBlockOfType(types []string, sourceType string) bool {
+	for _, t := range types {
+		if t == sourceType {
+			return true
+		}
+	}
+	return false
+}
+
+// expandSourceBlock converts a typed block to the JSON spec of the source.
+func expandSourceBlock(block string, sourceType string, data map[string]interface{}) map[string]interface{} {
+	spec := map[string]interface{}{
+		"connectorRef": data["connector_ref"],
+	}
+	if feature, ok := data["feature"]; ok {
+		spec["feature"] = feature
+	}
+
+	switch block {
+	case "prometheus":
+		spec["metricDefinitions"] = expandMetricDefinitions(data, func(definition map[string]interface{}, d map[string]interface{}) {
+			definition["query"] = d["query"]
+			definition["isManualQuery"] = d["is_manual_query"]
+		})
+		spec["metricPacks"] = expandMetricPacks(data)
+	case "datadog_metrics":
+		spec["metricDefinitions"] = expandMetricDefinitions(data, func(definition map[string]interface{}, d map[string]interface{}) {
+			definition["query"] = d["query"]
+			definition["groupingQuery"] = d["grouping_query"]
+			definition["metric"] = d["metric"]
+			definition["aggregation"] = d["aggregation"]
+			definition["dashboardName"] = d["dashboard_name"]
+			definition["metricPath"] = d["metric_path"]
+			definition["isManualQuery"] = d["is_manual_query"]
+			definition["isCustomCreatedMetric"] = true
+		})
+		spec["metricPacks"] = expandMetricPacks(data)
+	case "app_dynamics":
+		spec["applicationName"] = data["application_name"]
+		spec["tierName"] = data["tier_name"]
+		spec["metricDefinitions"] = expandMetricDefinitions(data, func(definition map[string]interface{}, d map[string]interface{}) {
+			definition["baseFolder"] = d["base_folder"]
+			definition["metricPath"] = d["metric_path"]
+			definition["completeMetricPath"] = d["complete_metric_path"]
+			deploymentVerification := definition["analysis"].(map[string]interface{})["deploymentVerification"].(map[string]interface{})
+			deploymentVerification["serviceInstanceMetricPath"] = d["service_instance_metric_path"]
+		})
+		spec["metricPacks"] = expandMetricPacks(data)
+	case "new_relic":
+		spec["applicationId"] = data["application_id"]
+		spec["applicationName"] = data["application_name"]
+		spec["newRelicMetricDefinitions"] = expandMetricDefinitions(data, func(definition map[string]interface{}, d map[string]interface{}) {
+			definition["nrql"] = d["nrql"]
+			definition["responseMapping"] = map[string]interface{}{
+				"metricValueJsonPath": d["metric_value_json_path"],
+				"timestampJsonPath":   d["timestamp_json_path"],
+			}
+		})
+		spec["metricPacks"] = expandMetricPacks(data)
+	case "dynatrace":
+		spec["serviceId"] = data["service_id"]
+		spec["serviceName"] = data["service_name"]
+		spec["serviceMethodIds"] = data["service_method_ids"]
+		spec["metricDefinitions"] = expandMetricDefinitions(data, func(definition map[string]interface{}, d map[string]interface{}) {
+			definition["metricSelector"] = d["metric_selector"]
+			definition["isManualQuery"] = d["is_manual_query"]
+		})
+		spec["metricPacks"] = expandMetricPacks(data)
+	case "custom_health_metric":
+		spec["metricDefinitions"] = expandMetricDefinitions(data, func(definition map[string]interface{}, d map[string]interface{}) {
+			definition["queryType"] = d["query_type"]
+			definition["requestDefinition"] = map[string]interface{}{
+				"method":      d["method"],
+				"urlPath":     d["url_path"],
+				"requestBody": d["request_body"],
+				"startTimeInfo": map[string]interface{}{
+					"placeholder":     d["start_time_placeholder"],
+					"timestampFormat": d["time_format"],
+				},
+				"endTimeInfo": map[string]interface{}{
+					"placeholder":     d["end_time_placeholder"],
+					"timestampFormat": d["time_format"],
+				},
+			}
+			definition["metricResponseMapping"] = map[string]interface{}{
+				"metricValueJsonPath":     d["metric_value_json_path"],
+				"timestampJsonPath":       d["timestamp_json_path"],
+				"timestampFormat":         d["timestamp_format"],
+				"serviceInstanceJsonPath": d["service_instance_json_path"],
+			}
+		})
+		spec["metricPacks"] = expandMetricPacks(data)
+	case "splunk":
+		queries := []interface{}{}
+		for _, q := range data["query"].([]interface{}) {
+			query := q.(map[string]interface{})
+			queries = append(queries, map[string]interface{}{
+				"identifier":                query["identifier"],
+				"name":                      query["name"],
+				"query":                     query["query"],
+				"serviceInstanceIdentifier": query["service_instance_identifier"],
+			})
+		}
+		spec["queries"] = queries
+	case "elastic_search":
+		spec["queryDefinitions"] = expandQueryDefinitions(data)
+	case "sumologic":
+		spec["queryDefinitions"] = expandQueryDefinitions(data)
+	case "pager_duty":
+		spec["pagerDutyServiceId"] = data["pager_duty_service_id"]
+	}
+
+	return spec
+}
+
+func expandMetricDefinitions(data map[string]interface{}, expandFields func(definition map[string]interface{}, d map[string]interface{})) []interface{} {
+	definitions := []interface{}{}
+	for _, v := range data["metric_definition"].([]interface{}) {
+		d := v.(map[string]interface{})
+		definition := map[string]interface{}{
+			"identifier": d["identifier"],
+			"metricName": d["metric_name"],
+			"groupName":  d["group_name"],
+			"analysis": map[string]interface{}{
+				"liveMonitoring": map[string]interface{}{
+					"enabled": d["live_monitoring_enabled"],
+				},
+				"deploymentVerification": map[string]interface{}{
+					"enabled":                  d["deployment_verification_enabled"],
+					"serviceInstanceFieldName": d["service_instance_field_name"],
+				},
+			},
+			"sli": map[string]interface{}{
+				"enabled": d["sli_enabled"],
+			},
+		}
+		if riskProfile := expandRiskProfile(d); riskProfile != nil {
+			definition["riskProfile"] = riskProfile
+		}
+		expandFields(definition, d)
+		definitions = append(definitions, definition)
+	}
+	return definitions
+}
+
+func expandQueryDefinitions(data map[string]interface{}) []interface{} {
+	definitions := []interface{}{}
+	for _, v := range data["query_definition"].([]interface{}) {
+		d := v.(map[string]interface{})
+		definition := map[string]interface{}{
+			"identifier": d["identifier"],
+			"name":       d["name"],
+			"groupName":  d["group_name"],
+			"query":      d["query"],
+			"queryParams": map[string]interface{}{
+				"index":                d["index"],
+				"serviceInstanceField": d["service_instance_field"],
+				"timeStampIdentifier":  d["timestamp_identifier"],
+				"timeStampFormat":      d["timestamp_format"],
+				"messageIdentifier":    d["message_identifier"],
+			},
+			"liveMonitoringEnabled":         d["live_monitoring_enabled"],
+			"continuousVerificationEnabled": d["continuous_verification_enabled"],
+		}
+		if sliEnabled, ok := d["sli_enabled"]; ok {
+			definition["sliEnabled"] = sliEnabled
+		}
+		if riskProfile := expandRiskProfile(d); riskProfile != nil {
+			definition["riskProfile"] = riskProfile
+		}
+		if thresholds, ok := d["metric_threshold"]; ok {
+			definition["metricThresholds"] = expandMetricThresholds(thresholds.([]interface{}))
+		}
+		definitions = append(definitions, definition)
+	}
+	return definitions
+}
+
+func expandRiskProfile(d map[string]interface{}) map[string]interface{} {
+	riskProfiles, ok := d["risk_profile"].([]interface{})
+	if !ok || len(riskProfiles) == 0 || riskProfiles[0] == nil {
+		return nil
+	}
+	riskProfile := riskProfiles[0].(map[string]interface{})
+	return map[string]interface{}{
+		"riskCategory":   riskProfile["risk_category"],
+		"thresholdTypes": riskProfile["threshold_types"],
+	}
+}
+
+func expandMetricPacks(data map[string]interface{}) []interface{} {
+	metricPacks := []interface{}{}
+	for _, v := range data["metric_pack"].([]interface{}) {
+		metricPack := v.(map[string]interface{})
+		metricPacks = append(metricPacks, map[string]interface{}{
+			"identifier":       metricPack["identifier"],
+			"metricThresholds": expandMetricThresholds(metricPack["metric_threshold"].([]interface{})),
+		})
+	}
+	return metricPacks
+}
+
+func expandMetricThresholds(thresholds []interface{}) []interface{} {
+	metricThresholds := []interface{}{}
+	for _, v := range thresholds {
+		threshold := v.(map[string]interface{})
+
+		spec := map[string]interface{}{
+			"action": threshold["action"],
+		}
+		if count := threshold["count"].(int); count > 0 {
+			spec["spec"] = map[string]interface{}{
+				"count": count,
+			}
+		}
+
+		criteriaSpec := map[string]interface{}{}
+		if greaterThan := threshold["greater_than"].(float64); greaterThan != 0 {
+			criteriaSpec["greaterThan"] = greaterThan
+		}
+		if lessThan := threshold["less_than"].(float64); lessThan != 0 {
+			criteriaSpec["lessThan"] = lessThan
+		}
+
+		metricThresholds = append(metricThresholds, map[string]interface{}{
+			"type":       threshold["type"],
+			"metricName": threshold["metric_name"],
+			"metricType": threshold["metric_type"],
+			"identifier": threshold["metric_identifier"],
+			"groupName":  threshold["group_name"],
+			"spec":       spec,
+			"criteria": map[string]interface{}{
+				"type": threshold["criteria_type"],
+				"spec": criteriaSpec,
+			},
+		})
+	}
+	return metricThresholds
+}
+
+func mergeSchemas(base map[string]*schema.Schema, extra map[string]*schema.Schema) map[string]*schema.Schema {
+	for k, v := range extra {
+		base[k] = v
+	}
+	return base
+}
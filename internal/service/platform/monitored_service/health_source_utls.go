@@ -7,434 +7,385 @@ import (
 	"strconv" //this package is used to convert the data type
 )
 
-func getAppDynamicsHealthSource(hs map[string]interface{}) nextgen.AppDynamicsHealthSource {
-	healthSource := &nextgen.AppDynamicsHealthSource{}
-	healthSource.ConnectorRef = hs["connectorRef"].(string)
-	healthSource.ApplicationName = hs["applicationName"].(string)
-	healthSource.Feature = hs["feature"].(string)
-	healthSource.TierName = hs["tierName"].(string)
-	if hs["metricDefinitions"] != nil {
-		metricDefinitions := hs["metricDefinitions"].([]interface{})
-		healthSourceMetricDefinitions := make([]nextgen.AppDMetricDefinitions, len(metricDefinitions))
-		for i, metricDefinition := range metricDefinitions {
-			data := nextgen.AppDMetricDefinitions{}
-			metricDef, errMarshal := json.Marshal(metricDefinition)
-			if errMarshal != nil {
-				panic(fmt.Sprintf("Invalid Health source %s", hs))
-			}
-			errUnMarshal := json.Unmarshal(metricDef, &data)
-			if errUnMarshal != nil {
-				panic(fmt.Sprintf("Invalid Health source %s", hs))
-			}
-			healthSourceMetricDefinitions[i] = data
-		}
-		healthSource.MetricDefinitions = healthSourceMetricDefinitions
+func getAppDynamicsHealthSource(hs map[string]interface{}) (nextgen.AppDynamicsHealthSource, error) {
+	healthSource := nextgen.AppDynamicsHealthSource{}
+	var err error
+	if healthSource.ConnectorRef, err = getRequiredSpecString(hs, "connectorRef"); err != nil {
+		return healthSource, err
 	}
-
-	if hs["metricPacks"] != nil {
-		healthSource.MetricPacks = getMetricPacks(hs, "metricPacks")
+	if healthSource.ApplicationName, err = getSpecString(hs, "applicationName"); err != nil {
+		return healthSource, err
+	}
+	if healthSource.Feature, err = getSpecString(hs, "feature"); err != nil {
+		return healthSource, err
+	}
+	if healthSource.TierName, err = getSpecString(hs, "tierName"); err != nil {
+		return healthSource, err
 	}
-	return *healthSource
+	if err = decodeSpecField(hs, "metricDefinitions", &healthSource.MetricDefinitions); err != nil {
+		return healthSource, err
+	}
+	healthSource.MetricPacks, err = getMetricPacks(hs, "metricPacks")
+	return healthSource, err
 }
 
-func getPrometheusHealthSource(hs map[string]interface{}) nextgen.PrometheusHealthSource {
-	healthSource := &nextgen.PrometheusHealthSource{}
-
-	healthSource.ConnectorRef = hs["connectorRef"].(string)
-
-	metricDefinitions := hs["metricDefinitions"].([]interface{})
-	healthSourceMetricDefinitions := make([]nextgen.PrometheusMetricDefinition, len(metricDefinitions))
-	for i, metricDefinition := range metricDefinitions {
-		data := nextgen.PrometheusMetricDefinition{}
-		metricDef, errMarshal := json.Marshal(metricDefinition)
-		if errMarshal != nil {
-			panic(fmt.Sprintf("Invalid Health source %s", hs))
-		}
-		errUnMarshal := json.Unmarshal(metricDef, &data)
-		if errUnMarshal != nil {
-			panic(fmt.Sprintf("Invalid Health source %s", hs))
-		}
-		healthSourceMetricDefinitions[i] = data
+func getPrometheusHealthSource(hs map[string]interface{}) (nextgen.PrometheusHealthSource, error) {
+	healthSource := nextgen.PrometheusHealthSource{}
+	var err error
+	if healthSource.ConnectorRef, err = getRequiredSpecString(hs, "connectorRef"); err != nil {
+		return healthSource, err
 	}
-	healthSource.MetricDefinitions = healthSourceMetricDefinitions
-
-	if hs["metricPacks"] != nil {
-		healthSource.MetricPacks = getMetricPacks(hs, "metricPacks")
+	if err = decodeSpecField(hs, "metricDefinitions", &healthSource.MetricDefinitions); err != nil {
+		return healthSource, err
 	}
-	return *healthSource
+	healthSource.MetricPacks, err = getMetricPacks(hs, "metricPacks")
+	return healthSource, err
 }
 
-func getNewRelicHealthSource(hs map[string]interface{}) nextgen.NewRelicHealthSource {
-	healthSource := &nextgen.NewRelicHealthSource{}
-
-	healthSource.ConnectorRef = hs["connectorRef"].(string)
-	if hs["newRelicMetricDefinitions"] != nil {
-		metricDefinitions := hs["newRelicMetricDefinitions"].([]interface{})
-		healthSourceMetricDefinitions := make([]nextgen.NewRelicMetricDefinition, len(metricDefinitions))
-		for i, metricDefinition := range metricDefinitions {
-			data := nextgen.NewRelicMetricDefinition{}
-			metricDef, errMarshal := json.Marshal(metricDefinition)
-			if errMarshal != nil {
-				panic(fmt.Sprintf("Invalid Health source %s", hs))
-			}
-			errUnMarshal := json.Unmarshal(metricDef, &data)
-			if errUnMarshal != nil {
-				panic(fmt.Sprintf("Invalid Health source %s", hs))
-			}
-			healthSourceMetricDefinitions[i] = data
-		}
-		healthSource.NewRelicMetricDefinitions = healthSourceMetricDefinitions
+func getNewRelicHealthSource(hs map[string]interface{}) (nextgen.NewRelicHealthSource, error) {
+	healthSource := nextgen.NewRelicHealthSource{}
+	var err error
+	if healthSource.ConnectorRef, err = getRequiredSpecString(hs, "connectorRef"); err != nil {
+		return healthSource, err
 	}
-
-	if hs["metricPacks"] != nil {
-		healthSource.MetricPacks = getMetricPacks(hs, "metricPacks")
+	if err = decodeSpecField(hs, "newRelicMetricDefinitions", &healthSource.NewRelicMetricDefinitions); err != nil {
+		return healthSource, err
 	}
-	return *healthSource
+	healthSource.MetricPacks, err = getMetricPacks(hs, "metricPacks")
+	return healthSource, err
 }
 
-func getStackDriverHealthSource(hs map[string]interface{}) nextgen.StackdriverMetricHealthSource {
-	healthSource := &nextgen.StackdriverMetricHealthSource{}
-
-	healthSource.ConnectorRef = hs["connectorRef"].(string)
-
-	metricDefinitions := hs["metricDefinitions"].([]interface{})
-	healthSourceMetricDefinitions := make([]nextgen.StackdriverDefinition, len(metricDefinitions))
-	for i, metricDefinition := range metricDefinitions {
-		data := nextgen.StackdriverDefinition{}
-		metricDef, errMarshal := json.Marshal(metricDefinition)
-		if errMarshal != nil {
-			panic(fmt.Sprintf("Invalid Health source %s", hs))
-		}
-		errUnMarshal := json.Unmarshal(metricDef, &data)
-		if errUnMarshal != nil {
-			panic(fmt.Sprintf("Invalid Health source %s", hs))
-		}
-		healthSourceMetricDefinitions[i] = data
+func getStackDriverHealthSource(hs map[string]interface{}) (nextgen.StackdriverMetricHealthSource, error) {
+	healthSource := nextgen.StackdriverMetricHealthSource{}
+	var err error
+	if healthSource.ConnectorRef, err = getRequiredSpecString(hs, "connectorRef"); err != nil {
+		return healthSource, err
 	}
-	healthSource.MetricDefinitions = healthSourceMetricDefinitions
-
-	if hs["metricPacks"] != nil {
-		healthSource.MetricPacks = getMetricPacks(hs, "metricPacks")
+	if err = decodeSpecField(hs, "metricDefinitions", &healthSource.MetricDefinitions); err != nil {
+		return healthSource, err
 	}
-	return *healthSource
+	healthSource.MetricPacks, err = getMetricPacks(hs, "metricPacks")
+	return healthSource, err
 }
 
-func getDataDogHealthSource(hs map[string]interface{}) nextgen.DatadogMetricHealthSource {
-	healthSource := &nextgen.DatadogMetricHealthSource{}
-
-	healthSource.ConnectorRef = hs["connectorRef"].(string)
-
-	metricDefinitions := hs["metricDefinitions"].([]interface{})
-	healthSourceMetricDefinitions := make([]nextgen.DatadogMetricHealthDefinition, len(metricDefinitions))
-	for i, metricDefinition := range metricDefinitions {
-		data := nextgen.DatadogMetricHealthDefinition{}
-		metricDef, errMarshal := json.Marshal(metricDefinition)
-		if errMarshal != nil {
-			panic(fmt.Sprintf("Invalid Health source %s", hs))
-		}
-		errUnMarshal := json.Unmarshal(metricDef, &data)
-		if errUnMarshal != nil {
-			panic(fmt.Sprintf("Invalid Health source %s", hs))
-		}
-		healthSourceMetricDefinitions[i] = data
+func getDataDogHealthSource(hs map[string]interface{}) (nextgen.DatadogMetricHealthSource, error) {
+	healthSource := nextgen.DatadogMetricHealthSource{}
+	var err error
+	if healthSource.ConnectorRef, err = getRequiredSpecString(hs, "connectorRef"); err != nil {
+		return healthSource, err
 	}
-	healthSource.MetricDefinitions = healthSourceMetricDefinitions
-
-	if hs["metricPacks"] != nil {
-		healthSource.MetricPacks = getMetricPacks(hs, "metricPacks")
+	if err = decodeSpecField(hs, "metricDefinitions", &healthSource.MetricDefinitions); err != nil {
+		return healthSource, err
 	}
-	return *healthSource
+	healthSource.MetricPacks, err = getMetricPacks(hs, "metricPacks")
+	return healthSource, err
 }
 
-func getDynatraceHealthSource(hs map[string]interface{}) nextgen.DynatraceHealthSource {
-	healthSource := &nextgen.DynatraceHealthSource{}
-	healthSource.ConnectorRef = hs["connectorRef"].(string)
-	healthSource.ServiceId = hs["serviceId"].(string)
+func getDynatraceHealthSource(hs map[string]interface{}) (nextgen.DynatraceHealthSource, error) {
+	healthSource := nextgen.DynatraceHealthSource{}
+	var err error
+	if healthSource.ConnectorRef, err = getRequiredSpecString(hs, "connectorRef"); err != nil {
+		return healthSource, err
+	}
+	if healthSource.ServiceId, err = getSpecString(hs, "serviceId"); err != nil {
+		return healthSource, err
+	}
+	if healthSource.ServiceName, err = getSpecString(hs, "serviceName"); err != nil {
+		return healthSource, err
+	}
+	if healthSource.Feature, err = getSpecString(hs, "feature"); err != nil {
+		return healthSource, err
+	}
 	if hs["serviceMethodIds"] != nil {
-		serviceMethodIds := hs["serviceMethodIds"].([]interface{})
+		serviceMethodIds, ok := hs["serviceMethodIds"].([]interface{})
+		if !ok {
+			return healthSource, fmt.Errorf("serviceMethodIds must be a list, got %v", hs["serviceMethodIds"])
+		}
 		s := make([]string, len(serviceMethodIds))
 		for i, v := range serviceMethodIds {
 			s[i] = fmt.Sprint(v)
 		}
 		healthSource.ServiceMethodIds = s
 	}
-	healthSource.ServiceName = hs["serviceName"].(string)
-	healthSource.Feature = hs["feature"].(string)
-	if hs["metricDefinitions"] != nil {
-		metricDefinitions := hs["metricDefinitions"].([]interface{})
-		healthSourceMetricDefinitions := make([]nextgen.DynatraceMetricDefinition, len(metricDefinitions))
-		for i, metricDefinition := range metricDefinitions {
-			data := nextgen.DynatraceMetricDefinition{}
-			metricDef, errMarshal := json.Marshal(metricDefinition)
-			if errMarshal != nil {
-				panic(fmt.Sprintf("Invalid Health source %s", hs))
-			}
-			errUnMarshal := json.Unmarshal(metricDef, &data)
-			if errUnMarshal != nil {
-				panic(fmt.Sprintf("Invalid Health source %s", hs))
-			}
-			healthSourceMetricDefinitions[i] = data
-		}
-		healthSource.MetricDefinitions = healthSourceMetricDefinitions
-	}
-
-	if hs["metricPacks"] != nil {
-		healthSource.MetricPacks = getMetricPacks(hs, "metricPacks")
+	if err = decodeSpecField(hs, "metricDefinitions", &healthSource.MetricDefinitions); err != nil {
+		return healthSource, err
 	}
-	return *healthSource
+	healthSource.MetricPacks, err = getMetricPacks(hs, "metricPacks")
+	return healthSource, err
 }
 
-func getCustomHealthSource(hs map[string]interface{}) nextgen.CustomHealthSourceMetric {
-	healthSource := &nextgen.CustomHealthSourceMetric{}
-
-	healthSource.ConnectorRef = hs["connectorRef"].(string)
-
-	metricDefinitions := hs["metricDefinitions"].([]interface{})
-	healthSourceMetricDefinitions := make([]nextgen.CustomHealthMetricDefinition, len(metricDefinitions))
-	for i, metricDefinition := range metricDefinitions {
-		data := nextgen.CustomHealthMetricDefinition{}
-		metricDef, errMarshal := json.Marshal(metricDefinition)
-		if errMarshal != nil {
-			panic(fmt.Sprintf("Invalid Health source %s", hs))
-		}
-		errUnMarshal := json.Unmarshal(metricDef, &data)
-		if errUnMarshal != nil {
-			panic(fmt.Sprintf("Invalid Health source %s", hs))
-		}
-		healthSourceMetricDefinitions[i] = data
+func getCustomHealthSource(hs map[string]interface{}) (nextgen.CustomHealthSourceMetric, error) {
+	healthSource := nextgen.CustomHealthSourceMetric{}
+	var err error
+	if healthSource.ConnectorRef, err = getRequiredSpecString(hs, "connectorRef"); err != nil {
+		return healthSource, err
 	}
-	healthSource.MetricDefinitions = healthSourceMetricDefinitions
-
-	if hs["metricPacks"] != nil {
-		healthSource.MetricPacks = getMetricPacks(hs, "metricPacks")
+	if err = decodeSpecField(hs, "metricDefinitions", &healthSource.MetricDefinitions); err != nil {
+		return healthSource, err
 	}
-	return *healthSource
+	healthSource.MetricPacks, err = getMetricPacks(hs, "metricPacks")
+	return healthSource, err
 }
 
-func getSplunkHealthSource(hs map[string]interface{}) nextgen.SplunkMetricHealthSource {
-	healthSource := &nextgen.SplunkMetricHealthSource{}
-
-	healthSource.ConnectorRef = hs["connectorRef"].(string)
-
-	metricDefinitions := hs["metricDefinitions"].([]interface{})
-	healthSourceMetricDefinitions := make([]nextgen.SplunkMetricDefinition, len(metricDefinitions))
-	for i, metricDefinition := range metricDefinitions {
-		data := nextgen.SplunkMetricDefinition{}
-		metricDef, errMarshal := json.Marshal(metricDefinition)
-		if errMarshal != nil {
-			panic(fmt.Sprintf("Invalid Health source %s", hs))
-		}
-		errUnMarshal := json.Unmarshal(metricDef, &data)
-		if errUnMarshal != nil {
-			panic(fmt.Sprintf("Invalid Health source %s", hs))
-		}
-		healthSourceMetricDefinitions[i] = data
+func getSplunkHealthSource(hs map[string]interface{}) (nextgen.SplunkMetricHealthSource, error) {
+	healthSource := nextgen.SplunkMetricHealthSource{}
+	var err error
+	if healthSource.ConnectorRef, err = getRequiredSpecString(hs, "connectorRef"); err != nil {
+		return healthSource, err
 	}
-	healthSource.MetricDefinitions = healthSourceMetricDefinitions
-
-	if hs["metricPacks"] != nil {
-		healthSource.MetricPacks = getMetricPacks(hs, "metricPacks")
+	if err = decodeSpecField(hs, "metricDefinitions", &healthSource.MetricDefinitions); err != nil {
+		return healthSource, err
 	}
-	return *healthSource
+	healthSource.MetricPacks, err = getMetricPacks(hs, "metricPacks")
+	return healthSource, err
 }
 
-func getCloudWatchHealthSource(hs map[string]interface{}) nextgen.CloudWatchMetricsHealthSource {
-	healthSource := &nextgen.CloudWatchMetricsHealthSource{}
-
-	healthSource.ConnectorRef = hs["connectorRef"].(string)
-
-	metricDefinitions := hs["metricDefinitions"].([]interface{})
-	healthSourceMetricDefinitions := make([]nextgen.CloudWatchMetricDefinition, len(metricDefinitions))
-	for i, metricDefinition := range metricDefinitions {
-		data := nextgen.CloudWatchMetricDefinition{}
-		metricDef, errMarshal := json.Marshal(metricDefinition)
-		if errMarshal != nil {
-			panic(fmt.Sprintf("Invalid Health source %s", hs))
-		}
-		errUnMarshal := json.Unmarshal(metricDef, &data)
-		if errUnMarshal != nil {
-			panic(fmt.Sprintf("Invalid Health source %s", hs))
-		}
-		healthSourceMetricDefinitions[i] = data
+func getCloudWatchHealthSource(hs map[string]interface{}) (nextgen.CloudWatchMetricsHealthSource, error) {
+	healthSource := nextgen.CloudWatchMetricsHealthSource{}
+	var err error
+	if healthSource.ConnectorRef, err = getRequiredSpecString(hs, "connectorRef"); err != nil {
+		return healthSource, err
 	}
-	healthSource.MetricDefinitions = healthSourceMetricDefinitions
-
-	if hs["metricPacks"] != nil {
-		healthSource.MetricPacks = getMetricPacks(hs, "metricPacks")
+	if err = decodeSpecField(hs, "metricDefinitions", &healthSource.MetricDefinitions); err != nil {
+		return healthSource, err
 	}
-	return *healthSource
+	healthSource.MetricPacks, err = getMetricPacks(hs, "metricPacks")
+	return healthSource, err
 }
 
-func getAwsPrometheusHealthSource(hs map[string]interface{}) nextgen.AwsPrometheusHealthSource {
-	healthSource := &nextgen.AwsPrometheusHealthSource{}
-
-	healthSource.ConnectorRef = hs["connectorRef"].(string)
-
-	metricDefinitions := hs["metricDefinitions"].([]interface{})
-	healthSourceMetricDefinitions := make([]nextgen.PrometheusMetricDefinition, len(metricDefinitions))
-	for i, metricDefinition := range metricDefinitions {
-		data := nextgen.PrometheusMetricDefinition{}
-		metricDef, errMarshal := json.Marshal(metricDefinition)
-		if errMarshal != nil {
-			panic(fmt.Sprintf("Invalid Health source %s", hs))
-		}
-		errUnMarshal := json.Unmarshal(metricDef, &data)
-		if errUnMarshal != nil {
-			panic(fmt.Sprintf("Invalid Health source %s", hs))
-		}
-		healthSourceMetricDefinitions[i] = data
+func getAwsPrometheusHealthSource(hs map[string]interface{}) (nextgen.AwsPrometheusHealthSource, error) {
+	healthSource := nextgen.AwsPrometheusHealthSource{}
+	var err error
+	if healthSource.ConnectorRef, err = getRequiredSpecString(hs, "connectorRef"); err != nil {
+		return healthSource, err
 	}
-	healthSource.MetricDefinitions = healthSourceMetricDefinitions
-
-	if hs["metricPacks"] != nil {
-		healthSource.MetricPacks = getMetricPacks(hs, "metricPacks")
+	if err = decodeSpecField(hs, "metricDefinitions", &healthSource.MetricDefinitions); err != nil {
+		return healthSource, err
 	}
-	return *healthSource
+	healthSource.MetricPacks, err = getMetricPacks(hs, "metricPacks")
+	return healthSource, err
 }
 
-func getNextGenHealthSource(hs map[string]interface{}) nextgen.NextGenHealthSource {
-	healthSource := &nextgen.NextGenHealthSource{}
-	healthSource.ConnectorRef = hs["connectorRef"].(string)
+func getNextGenHealthSource(hs map[string]interface{}) (nextgen.NextGenHealthSource, error) {
+	healthSource := nextgen.NextGenHealthSource{}
+	var err error
+	if healthSource.ConnectorRef, err = getRequiredSpecString(hs, "connectorRef"); err != nil {
+		return healthSource, err
+	}
 	healthSourceParamDto := nextgen.HealthSourceParamsDto{}
-	healthSourceParamData, errMarshal := json.Marshal(hs["healthSourceParams"])
-	if errMarshal != nil {
-		panic(fmt.Sprintf("Invalid health source param dto %s", hs))
+	if err = decodeSpecField(hs, "healthSourceParams", &healthSourceParamDto); err != nil {
+		return healthSource, err
 	}
-	errUnMarshal := json.Unmarshal(healthSourceParamData, &healthSourceParamDto)
-	if errUnMarshal != nil {
-		panic(fmt.Sprintf("Invalid health source param dto %s", hs))
+	queryDefinitions, ok := hs["queryDefinitions"].([]interface{})
+	if !ok {
+		return healthSource, fmt.Errorf("queryDefinitions must be a list, got %v", hs["queryDefinitions"])
 	}
-	queryDefinitions := hs["queryDefinitions"].([]interface{})
 	queryDefinitionDtos := make([]nextgen.QueryDefinition, len(queryDefinitions))
 	for i, queryDefinition := range queryDefinitions {
-		data := queryDefinition.(map[string]interface{})
-
-		queryParams := nextgen.QueryParamsDto{}
-		queryParamsData, _ := json.Marshal(data["queryParams"])
-		json.Unmarshal(queryParamsData, &queryParams)
-
-		riskProfile := nextgen.RiskProfile{}
-		riskProfileData, _ := json.Marshal(data["riskProfile"])
-		json.Unmarshal(riskProfileData, &riskProfile)
-
-		query := ""
-		if data["query"] != nil {
-			query = data["query"].(string)
-		}
-		liveMonitoringEnabled := false
-		if data["liveMonitoringEnabled"] != nil {
-			liveMonitoringEnabled, _ = strconv.ParseBool(data["liveMonitoringEnabled"].(string))
-		}
-		continuousVerificationEnabled := false
-		if data["continuousVerificationEnabled"] != nil {
-			continuousVerificationEnabled, _ = strconv.ParseBool(data["continuousVerificationEnabled"].(string))
-		}
-		sliEnabled := false
-		if data["sliEnabled"] != nil {
-			sliEnabled, _ = strconv.ParseBool(data["sliEnabled"].(string))
+		data, ok := queryDefinition.(map[string]interface{})
+		if !ok {
+			return healthSource, fmt.Errorf("queryDefinitions[%d] must be an object, got %v", i, queryDefinition)
 		}
-		queryDefinitionDto := &nextgen.QueryDefinition{
-			Identifier:                    data["identifier"].(string),
-			Name:                          data["name"].(string),
-			GroupName:                     data["groupName"].(string),
-			LiveMonitoringEnabled:         liveMonitoringEnabled,
-			ContinuousVerificationEnabled: continuousVerificationEnabled,
-			SliEnabled:                    sliEnabled,
-			Query:                         query,
-			MetricThresholds:              getMetricThreshold(data),
-			QueryParams:                   &queryParams,
-			RiskProfile:                   &riskProfile,
+		queryDefinitionDto, err := getQueryDefinition(data)
+		if err != nil {
+			return healthSource, fmt.Errorf("queryDefinitions[%d]: %w", i, err)
 		}
-		queryDefinitionDtos[i] = *queryDefinitionDto
+		queryDefinitionDtos[i] = queryDefinitionDto
 	}
 	healthSource.QueryDefinitions = queryDefinitionDtos
 	healthSource.HealthSourceParams = &healthSourceParamDto
 
-	return *healthSource
+	return healthSource, nil
 }
 
-func getMetricPacks(hs map[string]interface{}, path string) []nextgen.TimeSeriesMetricPackDto {
-	metricPacks := hs[path].([]interface{})
+func getQueryDefinition(data map[string]interface{}) (nextgen.QueryDefinition, error) {
+	queryDefinition := nextgen.QueryDefinition{}
+	var err error
+	if queryDefinition.Identifier, err = getRequiredSpecString(data, "identifier"); err != nil {
+		return queryDefinition, err
+	}
+	if queryDefinition.Name, err = getRequiredSpecString(data, "name"); err != nil {
+		return queryDefinition, err
+	}
+	if queryDefinition.GroupName, err = getSpecString(data, "groupName"); err != nil {
+		return queryDefinition, err
+	}
+	if queryDefinition.Query, err = getSpecString(data, "query"); err != nil {
+		return queryDefinition, err
+	}
+	if queryDefinition.LiveMonitoringEnabled, err = getSpecBool(data, "liveMonitoringEnabled"); err != nil {
+		return queryDefinition, err
+	}
+	if queryDefinition.ContinuousVerificationEnabled, err = getSpecBool(data, "continuousVerificationEnabled"); err != nil {
+		return queryDefinition, err
+	}
+	if queryDefinition.SliEnabled, err = getSpecBool(data, "sliEnabled"); err != nil {
+		return queryDefinition, err
+	}
+
+	queryParams := nextgen.QueryParamsDto{}
+	if err = decodeSpecField(data, "queryParams", &queryParams); err != nil {
+		return queryDefinition, err
+	}
+	queryDefinition.QueryParams = &queryParams
+
+	riskProfile := nextgen.RiskProfile{}
+	if err = decodeSpecField(data, "riskProfile", &riskProfile); err != nil {
+		return queryDefinition, err
+	}
+	queryDefinition.RiskProfile = &riskProfile
+
+	queryDefinition.MetricThresholds, err = getMetricThreshold(data)
+	return queryDefinition, err
+}
+
+func getMetricPacks(hs map[string]interface{}, path string) ([]nextgen.TimeSeriesMetricPackDto, error) {
+	if hs[path] == nil {
+		return nil, nil
+	}
+	metricPacks, ok := hs[path].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s must be a list, got %v", path, hs[path])
+	}
 	metricPackDto := make([]nextgen.TimeSeriesMetricPackDto, len(metricPacks))
 	for i, metricPack := range metricPacks {
-		test := metricPack.(map[string]interface{})
-		timeSeriesMetricPackDto := &nextgen.TimeSeriesMetricPackDto{
-			Identifier:       test["identifier"].(string),
-			MetricThresholds: getMetricThreshold(test),
+		data, ok := metricPack.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s[%d] must be an object, got %v", path, i, metricPack)
+		}
+		identifier, err := getRequiredSpecString(data, "identifier")
+		if err != nil {
+			return nil, fmt.Errorf("%s[%d]: %w", path, i, err)
+		}
+		metricThresholds, err := getMetricThreshold(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s[%d]: %w", path, i, err)
+		}
+		metricPackDto[i] = nextgen.TimeSeriesMetricPackDto{
+			Identifier:       identifier,
+			MetricThresholds: metricThresholds,
 		}
-		metricPackDto[i] = *timeSeriesMetricPackDto
 	}
-	return metricPackDto
+	return metricPackDto, nil
 }
 
-func getMetricThreshold(hs map[string]interface{}) []nextgen.MetricThreshold {
-	if hs["metricThresholds"] != nil {
-		metricThresholds := hs["metricThresholds"].([]interface{})
-		metricThresholdDto := make([]nextgen.MetricThreshold, len(metricThresholds))
-		for j, metricThreshold := range metricThresholds {
-			metricThresholdDto[j] = getMetricThresholdByType(metricThreshold.(map[string]interface{}))
+func getMetricThreshold(hs map[string]interface{}) ([]nextgen.MetricThreshold, error) {
+	if hs["metricThresholds"] == nil {
+		return make([]nextgen.MetricThreshold, 0), nil
+	}
+	metricThresholds, ok := hs["metricThresholds"].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("metricThresholds must be a list, got %v", hs["metricThresholds"])
+	}
+	metricThresholdDto := make([]nextgen.MetricThreshold, len(metricThresholds))
+	for j, metricThreshold := range metricThresholds {
+		data, ok := metricThreshold.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("metricThresholds[%d] must be an object, got %v", j, metricThreshold)
+		}
+		threshold, err := getMetricThresholdByType(data)
+		if err != nil {
+			return nil, fmt.Errorf("metricThresholds[%d]: %w", j, err)
 		}
-		return metricThresholdDto
-	} else {
-		return make([]nextgen.MetricThreshold, 0)
+		metricThresholdDto[j] = threshold
 	}
+	return metricThresholdDto, nil
 }
 
-func getMetricThresholdByType(hs map[string]interface{}) nextgen.MetricThreshold {
-	metricThresholdType := hs["type"].(string)
-	spec, errMarshal := json.Marshal(hs["spec"])
-	if errMarshal != nil {
-		panic(fmt.Sprintf("Invalid metric threshold %s", hs))
+func getMetricThresholdByType(hs map[string]interface{}) (nextgen.MetricThreshold, error) {
+	metricThreshold := nextgen.MetricThreshold{}
+	metricThresholdType, err := getRequiredSpecString(hs, "type")
+	if err != nil {
+		return metricThreshold, err
 	}
-	criteria := nextgen.MetricThresholdCriteria{}
-	criteriaData, errMarshal := json.Marshal(hs["criteria"])
-	if errMarshal != nil {
-		panic(fmt.Sprintf("Invalid metric threshold %s", hs))
+	metricThreshold.Type_ = nextgen.MetricThresholdType(metricThresholdType)
+	if metricThreshold.MetricName, err = getRequiredSpecString(hs, "metricName"); err != nil {
+		return metricThreshold, err
 	}
-	errUnMarshal := json.Unmarshal(criteriaData, &criteria)
-	if errUnMarshal != nil {
-		panic(fmt.Sprintf("Invalid metric threshold %s", hs))
+	if metricThreshold.MetricType, err = getRequiredSpecString(hs, "metricType"); err != nil {
+		return metricThreshold, err
 	}
-	groupName := ""
-	if hs["groupName"] != nil {
-		groupName = hs["groupName"].(string)
+	if metricThreshold.MetricIdentifier, err = getSpecString(hs, "identifier"); err != nil {
+		return metricThreshold, err
 	}
-	identifier := ""
-	if hs["identifier"] != nil {
-		identifier = hs["identifier"].(string)
+	if metricThreshold.GroupName, err = getSpecString(hs, "groupName"); err != nil {
+		return metricThreshold, err
+	}
+
+	criteria := nextgen.MetricThresholdCriteria{}
+	if err = decodeSpecField(hs, "criteria", &criteria); err != nil {
+		return metricThreshold, err
 	}
-	if metricThresholdType == "FailImmediately" {
+	metricThreshold.Criteria = &criteria
+
+	switch metricThresholdType {
+	case "FailImmediately":
 		data := nextgen.FailMetricThresholdSpec{}
-		err := json.Unmarshal(spec, &data)
-		if err != nil {
-			panic(fmt.Sprintf("Invalid metric threshold %s", hs))
+		if err = decodeSpecField(hs, "spec", &data); err != nil {
+			return metricThreshold, err
 		}
-
-		return nextgen.MetricThreshold{
-			MetricName:       hs["metricName"].(string),
-			MetricType:       hs["metricType"].(string),
-			MetricIdentifier: identifier,
-			GroupName:        groupName,
-			Type_:            nextgen.MetricThresholdType(metricThresholdType),
-			FailImmediately:  &data,
-			Criteria:         &criteria,
+		metricThreshold.FailImmediately = &data
+	case "IgnoreThreshold":
+		data := nextgen.IgnoreMetricThresholdSpec{}
+		if err = decodeSpecField(hs, "spec", &data); err != nil {
+			return metricThreshold, err
 		}
+		metricThreshold.IgnoreThreshold = &data
+	default:
+		return metricThreshold, fmt.Errorf("invalid metric threshold type %s, expected FailImmediately or IgnoreThreshold", metricThresholdType)
 	}
-	if metricThresholdType == "IgnoreThreshold" {
-		data := nextgen.IgnoreMetricThresholdSpec{}
-		err := json.Unmarshal(spec, &data)
+	return metricThreshold, nil
+}
+
+// decodeSpecField decodes the value found at the given key of a health source spec into out. A missing key leaves
+// out untouched.
+func decodeSpecField(hs map[string]interface{}, key string, out interface{}) error {
+	if hs[key] == nil {
+		return nil
+	}
+	data, err := json.Marshal(hs[key])
+	if err != nil {
+		return fmt.Errorf("invalid %s: %w", key, err)
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("invalid %s: %w", key, err)
+	}
+	return nil
+}
+
+func getSpecString(hs map[string]interface{}, key string) (string, error) {
+	if hs[key] == nil {
+		return "", nil
+	}
+	s, ok := hs[key].(string)
+	if !ok {
+		return "", fmt.Errorf("%s must be a string, got %v", key, hs[key])
+	}
+	return s, nil
+}
+
+func getRequiredSpecString(hs map[string]interface{}, key string) (string, error) {
+	s, err := getSpecString(hs, key)
+	if err == nil && s == "" {
+		err = fmt.Errorf("%s is required", key)
+	}
+	return s, err
+}
+
+// getSpecBool reads a flag of a health source spec, which is either a boolean or a string such as "true".
+func getSpecBool(hs map[string]interface{}, key string) (bool, error) {
+	switch v := hs[key].(type) {
+	case nil:
+		return false, nil
+	case bool:
+		return v, nil
+	case string:
+		b, err := strconv.ParseBool(v)
 		if err != nil {
-			panic(fmt.Sprintf("Invalid metric threshold %s", hs))
-		}
-		return nextgen.MetricThreshold{
-			MetricName:       hs["metricName"].(string),
-			MetricType:       hs["metricType"].(string),
-			MetricIdentifier: identifier,
-			GroupName:        groupName,
-			Type_:            nextgen.MetricThresholdType(metricThresholdType),
-			IgnoreThreshold:  &data,
-			Criteria:         &criteria,
+			return false, fmt.Errorf("%s must be a boolean, got %s", key, v)
 		}
+		return b, nil
+	default:
+		return false, fmt.Errorf("%s must be a boolean, got %v", key, v)
 	}
-	panic(fmt.Sprintf("Invalid metric threshold %s", hs))
 }
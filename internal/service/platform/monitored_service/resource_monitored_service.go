@@ -9,7 +9,7 @@ import (
 	"github.com/harness/terraform-provider-harness/internal"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"strings"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func ResourceMonitoredService() *schema.Resource {
@@ -21,6 +21,7 @@ func ResourceMonitoredService() *schema.Resource {
 		UpdateContext: resourceMonitoredServiceUpdate,
 		DeleteContext: resourceMonitoredServiceDelete,
 		Importer:      helpers.MultiLevelResourceImporter,
+		CustomizeDiff: validateMonitoredServiceSources,
 
 		Schema: map[string]*schema.Schema{
 			"org_id": {
@@ -90,7 +91,7 @@ func ResourceMonitoredService() *schema.Resource {
 							Type:        schema.TypeSet,
 							Optional:    true,
 							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
+								Schema: mergeSchemas(map[string]*schema.Schema{
 									"name": {
 										Description: "Name of the health source.",
 										Type:        schema.TypeString,
@@ -102,9 +103,10 @@ func ResourceMonitoredService() *schema.Resource {
 										Required:    true,
 									},
 									"type": {
-										Description: "Type of the health source.",
-										Type:        schema.TypeString,
-										Required:    true,
+										Description:  "Type of the health source.",
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(healthSourceTypes, false),
 									},
 									"version": {
 										Description: "Version of the health source.",
@@ -112,11 +114,12 @@ func ResourceMonitoredService() *schema.Resource {
 										Optional:    true,
 									},
 									"spec": {
-										Description: "Specification of the health source. Depends on the type of the health source.",
-										Type:        schema.TypeString,
-										Required:    true,
+										Description:  "Specification of the health source as JSON. Depends on the type of the health source. Exactly one of `spec` or the block matching the type of the health source must be set.",
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsJSON,
 									},
-								},
+								}, getHealthSourceBlocksSchema()),
 							},
 						},
 						"change_sources": {
@@ -124,7 +127,7 @@ func ResourceMonitoredService() *schema.Resource {
 							Type:        schema.TypeSet,
 							Optional:    true,
 							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
+								Schema: mergeSchemas(map[string]*schema.Schema{
 									"name": {
 										Description: "Name of the change source.",
 										Type:        schema.TypeString,
@@ -136,9 +139,10 @@ func ResourceMonitoredService() *schema.Resource {
 										Required:    true,
 									},
 									"type": {
-										Description: "Type of the change source.",
-										Type:        schema.TypeString,
-										Required:    true,
+										Description:  "Type of the change source.",
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(changeSourceTypes, false),
 									},
 									"enabled": {
 										Description: "Enable or disable the change source.",
@@ -146,16 +150,17 @@ func ResourceMonitoredService() *schema.Resource {
 										Optional:    true,
 									},
 									"spec": {
-										Description: "Specification of the change source. Depends on the type of the change source.",
-										Type:        schema.TypeString,
-										Optional:    true,
+										Description:  "Specification of the change source as JSON. Depends on the type of the change source. Can't be set together with the block matching the type of the change source.",
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsJSON,
 									},
 									"category": {
 										Description: "Category of the change source.",
 										Type:        schema.TypeString,
 										Required:    true,
 									},
-								},
+								}, getChangeSourceBlocksSchema()),
 							},
 						},
 						"dependencies": {
@@ -231,7 +236,10 @@ func resourceMonitoredServiceCreate(ctx context.Context, d *schema.ResourceData,
 	ctx = context.WithValue(ctx, nextgen.ContextAccessToken, hh.EnvVars.BearerToken.Get())
 	var accountIdentifier string
 	accountIdentifier = c.AccountId
-	createMonitoredServiceRequest := buildMonitoredServiceRequest(d)
+	createMonitoredServiceRequest, err := buildMonitoredServiceRequest(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	respCreate, httpRespCreate, errCreate := c.MonitoredServiceApi.SaveMonitoredService(ctx, accountIdentifier,
 		&nextgen.MonitoredServiceApiSaveMonitoredServiceOpts{
 			Body: optional.NewInterface(createMonitoredServiceRequest),
@@ -280,7 +288,10 @@ func resourceMonitoredServiceUpdate(ctx context.Context, d *schema.ResourceData,
 	var accountIdentifier string
 	accountIdentifier = c.AccountId
 	identifier := d.Get("identifier").(string)
-	updateMonitoredServiceRequest := buildMonitoredServiceRequest(d)
+	updateMonitoredServiceRequest, err := buildMonitoredServiceRequest(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	respCreate, httpRespCreate, errCreate := c.MonitoredServiceApi.UpdateMonitoredService(ctx, accountIdentifier, identifier,
 		&nextgen.MonitoredServiceApiUpdateMonitoredServiceOpts{
 			Body: optional.NewInterface(updateMonitoredServiceRequest),
@@ -315,7 +326,7 @@ func resourceMonitoredServiceDelete(ctx context.Context, d *schema.ResourceData,
 	return nil
 }
 
-func buildMonitoredServiceRequest(d *schema.ResourceData) *nextgen.MonitoredServiceDto {
+func buildMonitoredServiceRequest(d *schema.ResourceData) (*nextgen.MonitoredServiceDto, error) {
 	monitoredService := &nextgen.MonitoredServiceDto{}
 
 	if attr, ok := d.GetOk("org_id"); ok {
@@ -346,19 +357,16 @@ func buildMonitoredServiceRequest(d *schema.ResourceData) *nextgen.MonitoredServ
 		}
 		monitoredService.EnvironmentRefList = environmentRefList
 
-		tags := map[string]string{}
-		for _, t := range request["tags"].(*schema.Set).List() {
-			tagStr := t.(string)
-			parts := strings.Split(tagStr, ":")
-			tags[parts[0]] = parts[1]
-		}
-		monitoredService.Tags = tags
+		monitoredService.Tags = helpers.ExpandTags(request["tags"].(*schema.Set).List())
 
 		healthSources := request["health_sources"].(*schema.Set).List()
 		hss := make([]nextgen.HealthSource, len(healthSources))
 		for i, healthSource := range healthSources {
 			hs := healthSource.(map[string]interface{})
-			healthSourceDto := getHealthSourceByType(hs)
+			healthSourceDto, err := getHealthSourceByType(hs)
+			if err != nil {
+				return nil, err
+			}
 			hss[i] = healthSourceDto
 		}
 
@@ -366,7 +374,10 @@ func buildMonitoredServiceRequest(d *schema.ResourceData) *nextgen.MonitoredServ
 		csDto := make([]nextgen.ChangeSourceDto, len(changeSources))
 		for i, changeSource := range changeSources {
 			cs := changeSource.(map[string]interface{})
-			changeSourceDto := getChangeSourceByType(cs)
+			changeSourceDto, err := getChangeSourceByType(cs)
+			if err != nil {
+				return nil, err
+			}
 			csDto[i] = changeSourceDto
 		}
 
@@ -379,7 +390,10 @@ func buildMonitoredServiceRequest(d *schema.ResourceData) *nextgen.MonitoredServ
 		serviceDependencyDto := make([]nextgen.ServiceDependencyDto, len(dependencies))
 		for i, dependency := range dependencies {
 			sd := dependency.(map[string]interface{})
-			serviceDependency := getServiceDependencyByType(sd)
+			serviceDependency, err := getServiceDependencyByType(sd)
+			if err != nil {
+				return nil, err
+			}
 			serviceDependencyDto[i] = serviceDependency
 		}
 		monitoredService.Dependencies = serviceDependencyDto
@@ -397,7 +411,40 @@ func buildMonitoredServiceRequest(d *schema.ResourceData) *nextgen.MonitoredServ
 		monitoredService.NotificationRuleRefs = notificationRuleRefs
 	}
 
-	return monitoredService
+	return monitoredService, nil
+}
+
+// validateMonitoredServiceSources checks at plan time that each health and change source sets exactly one of spec or a
+// typed block matching its type. The content of the spec is only decoded when applying, as its values, e.g. the
+// connector of the source, may not be known before.
+func validateMonitoredServiceSources(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	requests := d.Get("request").([]interface{})
+	if len(requests) == 0 || requests[0] == nil {
+		return nil
+	}
+	request := requests[0].(map[string]interface{})
+
+	for _, healthSource := range request["health_sources"].(*schema.Set).List() {
+		hs := healthSource.(map[string]interface{})
+		if hs["type"].(string) == "" {
+			continue
+		}
+		if _, err := getSourceSpec("health source", hs, healthSourceBlocks); err != nil {
+			return err
+		}
+	}
+
+	for _, changeSource := range request["change_sources"].(*schema.Set).List() {
+		cs := changeSource.(map[string]interface{})
+		if cs["type"].(string) == "" {
+			continue
+		}
+		if _, err := getSourceSpec("change source", cs, changeSourceBlocks); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func readMonitoredService(d *schema.ResourceData, monitoredServiceResponse **nextgen.MonitoredServiceResponse) {
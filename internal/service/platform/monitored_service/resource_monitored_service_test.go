@@ -3,6 +3,7 @@ package monitored_service_test
 import (
 	"fmt"
 	"github.com/antihax/optional"
+	"regexp"
 	"testing"
 
 	"github.com/harness/harness-go-sdk/harness/nextgen"
@@ -683,3 +684,165 @@ func testAccResourceMonitoredServiceWithNewRelic(id string, name string) string
 		}
 `, id, name)
 }
+
+func TestAccResourceMonitoredServiceWithTypedHealthSources(t *testing.T) {
+
+	name := t.Name()
+	id := fmt.Sprintf("%s_%s", name, utils.RandStringBytes(5))
+
+	resourceName := "harness_platform_monitored_service.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.TestAccPreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccMonitoredServiceDestroy(resourceName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceMonitoredServiceWithTypedHealthSources(id, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", id),
+					resource.TestCheckResourceAttr(resourceName, "request.0.health_sources.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: acctest.ProjectResourceImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccResourceMonitoredServiceWithInvalidHealthSource(t *testing.T) {
+
+	name := t.Name()
+	id := fmt.Sprintf("%s_%s", name, utils.RandStringBytes(5))
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.TestAccPreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceMonitoredServiceWithInvalidHealthSource(id, name),
+				ExpectError: regexp.MustCompile("invalid metric threshold type"),
+			},
+		},
+	})
+}
+
+func testAccResourceMonitoredServiceWithTypedHealthSources(id string, name string) string {
+	return fmt.Sprintf(`
+		resource "harness_platform_organization" "test" {
+			identifier = "%[1]s"
+			name = "%[2]s"
+		}
+
+		resource "harness_platform_project" "test" {
+			identifier = "%[1]s"
+			name = "%[2]s"
+			org_id = harness_platform_organization.test.id
+			color = "#472848"
+		}
+
+		resource "harness_platform_connector_prometheus" "test" {
+			identifier = "%[1]s"
+			name = "%[2]s"
+			url = "https://prometheus.com/"
+			delegate_selectors = ["harness-delegate"]
+		}
+
+		resource "harness_platform_monitored_service" "test" {
+			org_id = harness_platform_project.test.org_id
+			project_id = harness_platform_project.test.id
+			identifier = "%[1]s"
+			request {
+				name = "%[2]s"
+				type = "Application"
+				description = "description"
+				service_ref = "service_ref"
+				environment_ref = "environment_ref"
+				tags = ["foo:bar", "bar:foo"]
+				health_sources {
+					name = "appd"
+					identifier = "appd"
+					type = "AppDynamics"
+					app_dynamics {
+						connector_ref = "connectorRef"
+						application_name = "cv-app"
+						tier_name = "docker-tier"
+						metric_pack {
+							identifier = "Errors"
+						}
+					}
+				}
+				health_sources {
+					name = "prometheus"
+					identifier = "prometheus"
+					type = "Prometheus"
+					prometheus {
+						connector_ref = "account.${harness_platform_connector_prometheus.test.id}"
+						metric_definition {
+							identifier = "cpu"
+							metric_name = "cpu"
+							group_name = "infra"
+							query = "avg(container_cpu_usage_seconds_total)"
+							live_monitoring_enabled = true
+							risk_profile {
+								risk_category = "Infrastructure"
+								threshold_types = ["ACT_WHEN_HIGHER"]
+							}
+						}
+						metric_pack {
+							identifier = "Custom"
+							metric_threshold {
+								type = "IgnoreThreshold"
+								metric_name = "cpu"
+								action = "Ignore"
+								less_than = 10
+							}
+						}
+					}
+				}
+				change_sources {
+					name = "csName1"
+					identifier = "harness_cd_next_gen"
+					type = "HarnessCDNextGen"
+					enabled = true
+					category = "Deployment"
+				}
+			}
+		}
+`, id, name)
+}
+
+func testAccResourceMonitoredServiceWithInvalidHealthSource(id string, name string) string {
+	return fmt.Sprintf(`
+		resource "harness_platform_monitored_service" "test" {
+			org_id = "org_id"
+			project_id = "project_id"
+			identifier = "%[1]s"
+			request {
+				name = "%[2]s"
+				type = "Application"
+				service_ref = "service_ref"
+				environment_ref = "environment_ref"
+				health_sources {
+					name = "prometheus"
+					identifier = "prometheus"
+					type = "Prometheus"
+					spec = jsonencode({
+					connectorRef = "connectorRef"
+					metricPacks = [ {
+						identifier = "Custom"
+						metricThresholds = [ {
+							type = "IgnoreThresholds"
+							metricName = "cpu"
+							metricType = "Custom"
+						} ]
+					} ]
+					})
+				}
+			}
+		}
+`, id, name)
+}
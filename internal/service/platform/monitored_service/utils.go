@@ -6,342 +6,173 @@ import (
 	"github.com/harness/harness-go-sdk/harness/nextgen"
 )
 
-func getHealthSourceByType(hs map[string]interface{}) nextgen.HealthSource {
+func getHealthSourceByType(hs map[string]interface{}) (nextgen.HealthSource, error) {
 	healthSourceType := hs["type"].(string)
-	healthSource := hs["spec"].(string)
-	spec := jsonToMap(hs["spec"].(string))
-	if healthSourceType == "AppDynamics" {
-		data := getAppDynamicsHealthSource(spec)
-
-		return nextgen.HealthSource{
-			Name:        hs["name"].(string),
-			Identifier:  hs["identifier"].(string),
-			Version:     hs["version"].(string),
-			Type_:       nextgen.HealthSourceType(healthSourceType),
-			AppDynamics: &data,
-		}
+	healthSource := nextgen.HealthSource{
+		Name:       hs["name"].(string),
+		Identifier: hs["identifier"].(string),
+		Version:    hs["version"].(string),
+		Type_:      nextgen.HealthSourceType(healthSourceType),
 	}
-	if healthSourceType == "NewRelic" {
-		data := getNewRelicHealthSource(spec)
-		json.Unmarshal([]byte(healthSource), &data)
 
-		return nextgen.HealthSource{
-			Name:       hs["name"].(string),
-			Identifier: hs["identifier"].(string),
-			Version:    hs["version"].(string),
-			Type_:      nextgen.HealthSourceType(healthSourceType),
-			NewRelic:   &data,
-		}
+	healthSourceSpec, err := getSourceSpec("health source", hs, healthSourceBlocks)
+	if err != nil {
+		return healthSource, err
 	}
-	if healthSourceType == "StackdriverLog" {
-		data := nextgen.StackdriverLogHealthSource{}
-		json.Unmarshal([]byte(healthSource), &data)
-
-		return nextgen.HealthSource{
-			Name:           hs["name"].(string),
-			Identifier:     hs["identifier"].(string),
-			Version:        hs["version"].(string),
-			Type_:          nextgen.HealthSourceType(healthSourceType),
-			StackdriverLog: &data,
-		}
+	if healthSourceSpec == "" {
+		return healthSource, fmt.Errorf("health source %s: one of spec or a block matching its type must be set", healthSource.Identifier)
 	}
-	if healthSourceType == "Splunk" {
-		data := nextgen.SplunkHealthSource{}
-		json.Unmarshal([]byte(healthSource), &data)
-
-		return nextgen.HealthSource{
-			Name:       hs["name"].(string),
-			Identifier: hs["identifier"].(string),
-			Version:    hs["version"].(string),
-			Type_:      nextgen.HealthSourceType(healthSourceType),
-			Splunk:     &data,
-		}
+	spec, err := jsonToMap(healthSourceSpec)
+	if err != nil {
+		return healthSource, fmt.Errorf("health source %s: invalid spec: %w", healthSource.Identifier, err)
 	}
-	if healthSourceType == "Prometheus" {
-		data := getPrometheusHealthSource(spec)
 
-		return nextgen.HealthSource{
-			Name:       hs["name"].(string),
-			Identifier: hs["identifier"].(string),
-			Version:    hs["version"].(string),
-			Type_:      nextgen.HealthSourceType(healthSourceType),
-			Prometheus: &data,
+	switch healthSourceType {
+	case "AppDynamics":
+		data, e := getAppDynamicsHealthSource(spec)
+		healthSource.AppDynamics, err = &data, e
+	case "NewRelic":
+		data, e := getNewRelicHealthSource(spec)
+		if e == nil {
+			e = json.Unmarshal([]byte(healthSourceSpec), &data)
 		}
-	}
-	if healthSourceType == "Stackdriver" {
-		data := getStackDriverHealthSource(spec)
-
-		return nextgen.HealthSource{
-			Name:        hs["name"].(string),
-			Identifier:  hs["identifier"].(string),
-			Version:     hs["version"].(string),
-			Type_:       nextgen.HealthSourceType(healthSourceType),
-			Stackdriver: &data,
-		}
-	}
-	if healthSourceType == "DatadogMetrics" {
-		data := getDataDogHealthSource(spec)
-
-		return nextgen.HealthSource{
-			Name:           hs["name"].(string),
-			Identifier:     hs["identifier"].(string),
-			Version:        hs["version"].(string),
-			Type_:          nextgen.HealthSourceType(healthSourceType),
-			DatadogMetrics: &data,
-		}
-	}
-	if healthSourceType == "DatadogLog" {
+		healthSource.NewRelic, err = &data, e
+	case "StackdriverLog":
+		data := nextgen.StackdriverLogHealthSource{}
+		healthSource.StackdriverLog, err = &data, json.Unmarshal([]byte(healthSourceSpec), &data)
+	case "Splunk":
+		data := nextgen.SplunkHealthSource{}
+		healthSource.Splunk, err = &data, json.Unmarshal([]byte(healthSourceSpec), &data)
+	case "Prometheus":
+		data, e := getPrometheusHealthSource(spec)
+		healthSource.Prometheus, err = &data, e
+	case "Stackdriver":
+		data, e := getStackDriverHealthSource(spec)
+		healthSource.Stackdriver, err = &data, e
+	case "DatadogMetrics":
+		data, e := getDataDogHealthSource(spec)
+		healthSource.DatadogMetrics, err = &data, e
+	case "DatadogLog":
 		data := nextgen.DatadogLogHealthSource{}
-		json.Unmarshal([]byte(healthSource), &data)
-
-		return nextgen.HealthSource{
-			Name:       hs["name"].(string),
-			Identifier: hs["identifier"].(string),
-			Version:    hs["version"].(string),
-			Type_:      nextgen.HealthSourceType(healthSourceType),
-			DatadogLog: &data,
-		}
-	}
-	if healthSourceType == "Dynatrace" {
-		data := getDynatraceHealthSource(spec)
-
-		return nextgen.HealthSource{
-			Name:       hs["name"].(string),
-			Identifier: hs["identifier"].(string),
-			Version:    hs["version"].(string),
-			Type_:      nextgen.HealthSourceType(healthSourceType),
-			Dynatrace:  &data,
-		}
-	}
-	if healthSourceType == "ErrorTracking" {
+		healthSource.DatadogLog, err = &data, json.Unmarshal([]byte(healthSourceSpec), &data)
+	case "Dynatrace":
+		data, e := getDynatraceHealthSource(spec)
+		healthSource.Dynatrace, err = &data, e
+	case "ErrorTracking":
 		data := nextgen.ErrorTrackingHealthSource{}
-		json.Unmarshal([]byte(healthSource), &data)
-
-		return nextgen.HealthSource{
-			Name:          hs["name"].(string),
-			Identifier:    hs["identifier"].(string),
-			Version:       hs["version"].(string),
-			Type_:         nextgen.HealthSourceType(healthSourceType),
-			ErrorTracking: &data,
-		}
-	}
-	if healthSourceType == "CustomHealthMetric" {
-		data := getCustomHealthSource(spec)
-
-		return nextgen.HealthSource{
-			Name:               hs["name"].(string),
-			Identifier:         hs["identifier"].(string),
-			Version:            hs["version"].(string),
-			Type_:              nextgen.HealthSourceType(healthSourceType),
-			CustomHealthMetric: &data,
-		}
-	}
-	if healthSourceType == "CustomHealthLog" {
+		healthSource.ErrorTracking, err = &data, json.Unmarshal([]byte(healthSourceSpec), &data)
+	case "CustomHealthMetric":
+		data, e := getCustomHealthSource(spec)
+		healthSource.CustomHealthMetric, err = &data, e
+	case "CustomHealthLog":
 		data := nextgen.CustomHealthSourceLog{}
-		json.Unmarshal([]byte(healthSource), &data)
-
-		return nextgen.HealthSource{
-			Name:            hs["name"].(string),
-			Identifier:      hs["identifier"].(string),
-			Version:         hs["version"].(string),
-			Type_:           nextgen.HealthSourceType(healthSourceType),
-			CustomHealthLog: &data,
-		}
-	}
-	if healthSourceType == "SplunkMetric" {
-		data := getSplunkHealthSource(spec)
-
-		return nextgen.HealthSource{
-			Name:         hs["name"].(string),
-			Identifier:   hs["identifier"].(string),
-			Version:      hs["version"].(string),
-			Type_:        nextgen.HealthSourceType(healthSourceType),
-			SplunkMetric: &data,
-		}
-	}
-	if healthSourceType == "ElasticSearch" {
+		healthSource.CustomHealthLog, err = &data, json.Unmarshal([]byte(healthSourceSpec), &data)
+	case "SplunkMetric":
+		data, e := getSplunkHealthSource(spec)
+		healthSource.SplunkMetric, err = &data, e
+	case "ElasticSearch":
 		data := nextgen.NextGenHealthSource{}
-		json.Unmarshal([]byte(healthSource), &data)
-
-		return nextgen.HealthSource{
-			Name:          hs["name"].(string),
-			Identifier:    hs["identifier"].(string),
-			Version:       hs["version"].(string),
-			Type_:         nextgen.HealthSourceType(healthSourceType),
-			ElasticSearch: &data,
-		}
-	}
-	if healthSourceType == "CloudWatchMetrics" {
-		data := getCloudWatchHealthSource(spec)
-
-		return nextgen.HealthSource{
-			Name:              hs["name"].(string),
-			Identifier:        hs["identifier"].(string),
-			Version:           hs["version"].(string),
-			Type_:             nextgen.HealthSourceType(healthSourceType),
-			CloudWatchMetrics: &data,
-		}
-	}
-	if healthSourceType == "AwsPrometheus" {
-		data := getAwsPrometheusHealthSource(spec)
-
-		return nextgen.HealthSource{
-			Name:          hs["name"].(string),
-			Identifier:    hs["identifier"].(string),
-			Version:       hs["version"].(string),
-			Type_:         nextgen.HealthSourceType(healthSourceType),
-			AwsPrometheus: &data,
-		}
-	}
-	if healthSourceType == "SumologicMetrics" {
-		data := getNextGenHealthSource(spec)
-
-		return nextgen.HealthSource{
-			Name:             hs["name"].(string),
-			Identifier:       hs["identifier"].(string),
-			Version:          hs["version"].(string),
-			Type_:            nextgen.HealthSourceType(healthSourceType),
-			SumologicMetrics: &data,
-		}
-	}
-	if healthSourceType == "SumologicLogs" {
-		data := getNextGenHealthSource(spec)
-
-		return nextgen.HealthSource{
-			Name:          hs["name"].(string),
-			Identifier:    hs["identifier"].(string),
-			Version:       hs["version"].(string),
-			Type_:         nextgen.HealthSourceType(healthSourceType),
-			SumologicLogs: &data,
-		}
-	}
-	if healthSourceType == "SplunkSignalFXMetrics" {
-		data := getNextGenHealthSource(spec)
-
-		return nextgen.HealthSource{
-			Name:                  hs["name"].(string),
-			Identifier:            hs["identifier"].(string),
-			Version:               hs["version"].(string),
-			Type_:                 nextgen.HealthSourceType(healthSourceType),
-			SplunkSignalFXMetrics: &data,
-		}
-	}
-	if healthSourceType == "GrafanaLokiLogs" {
-		data := getNextGenHealthSource(spec)
-
-		return nextgen.HealthSource{
-			Name:            hs["name"].(string),
-			Identifier:      hs["identifier"].(string),
-			Version:         hs["version"].(string),
-			Type_:           nextgen.HealthSourceType(healthSourceType),
-			GrafanaLokiLogs: &data,
-		}
-	}
-	if healthSourceType == "AzureLogs" {
-		data := getNextGenHealthSource(spec)
+		healthSource.ElasticSearch, err = &data, json.Unmarshal([]byte(healthSourceSpec), &data)
+	case "CloudWatchMetrics":
+		data, e := getCloudWatchHealthSource(spec)
+		healthSource.CloudWatchMetrics, err = &data, e
+	case "AwsPrometheus":
+		data, e := getAwsPrometheusHealthSource(spec)
+		healthSource.AwsPrometheus, err = &data, e
+	case "SumologicMetrics":
+		data, e := getNextGenHealthSource(spec)
+		healthSource.SumologicMetrics, err = &data, e
+	case "SumologicLogs":
+		data, e := getNextGenHealthSource(spec)
+		healthSource.SumologicLogs, err = &data, e
+	case "SplunkSignalFXMetrics":
+		data, e := getNextGenHealthSource(spec)
+		healthSource.SplunkSignalFXMetrics, err = &data, e
+	case "GrafanaLokiLogs":
+		data, e := getNextGenHealthSource(spec)
+		healthSource.GrafanaLokiLogs, err = &data, e
+	case "AzureLogs":
+		data, e := getNextGenHealthSource(spec)
+		healthSource.AzureLogs, err = &data, e
+	case "AzureMetrics":
+		data, e := getNextGenHealthSource(spec)
+		healthSource.AzureMetrics, err = &data, e
+	default:
+		return healthSource, fmt.Errorf("invalid health source type %s for monitored service", healthSourceType)
+	}
+	if err != nil {
+		return healthSource, fmt.Errorf("health source %s: invalid spec: %w", healthSource.Identifier, err)
+	}
+	return healthSource, nil
+}
 
-		return nextgen.HealthSource{
-			Name:       hs["name"].(string),
-			Identifier: hs["identifier"].(string),
-			Version:    hs["version"].(string),
-			Type_:      nextgen.HealthSourceType(healthSourceType),
-			AzureLogs:  &data,
-		}
+func getChangeSourceByType(cs map[string]interface{}) (nextgen.ChangeSourceDto, error) {
+	changeSourceType := cs["type"].(string)
+	changeSource := nextgen.ChangeSourceDto{
+		Name:       cs["name"].(string),
+		Identifier: cs["identifier"].(string),
+		Type_:      nextgen.ChangeSourceType(changeSourceType),
+		Enabled:    cs["enabled"].(bool),
+		Category:   cs["category"].(string),
 	}
-	if healthSourceType == "AzureMetrics" {
-		data := getNextGenHealthSource(spec)
 
-		return nextgen.HealthSource{
-			Name:         hs["name"].(string),
-			Identifier:   hs["identifier"].(string),
-			Version:      hs["version"].(string),
-			Type_:        nextgen.HealthSourceType(healthSourceType),
-			AzureMetrics: &data,
-		}
+	changeSourceSpec, err := getSourceSpec("change source", cs, changeSourceBlocks)
+	if err != nil {
+		return changeSource, err
 	}
-	panic(fmt.Sprintf("Invalid health source type for monitored service"))
-}
 
-func getChangeSourceByType(cs map[string]interface{}) nextgen.ChangeSourceDto {
-	changeSourceType := cs["type"].(string)
-	changeSourceSpec := cs["spec"].(string)
-
-	if changeSourceType == "HarnessCDNextGen" {
+	switch changeSourceType {
+	case "HarnessCDNextGen":
 		data := nextgen.HarnessCdChangeSourceSpec{}
-		json.Unmarshal([]byte(changeSourceSpec), &data)
-
-		return nextgen.ChangeSourceDto{
-			Name:             cs["name"].(string),
-			Identifier:       cs["identifier"].(string),
-			Type_:            nextgen.ChangeSourceType(changeSourceType),
-			HarnessCDNextGen: &data,
-			Enabled:          cs["enabled"].(bool),
-			Category:         cs["category"].(string),
-		}
-	}
-	if changeSourceType == "PagerDuty" {
+		changeSource.HarnessCDNextGen, err = &data, unmarshalSpec(changeSourceSpec, &data)
+	case "PagerDuty":
 		data := nextgen.PagerDutyChangeSourceSpec{}
-		json.Unmarshal([]byte(changeSourceSpec), &data)
-
-		return nextgen.ChangeSourceDto{
-			Name:       cs["name"].(string),
-			Identifier: cs["identifier"].(string),
-			Type_:      nextgen.ChangeSourceType(changeSourceType),
-			PagerDuty:  &data,
-			Enabled:    cs["enabled"].(bool),
-			Category:   cs["category"].(string),
-		}
-	}
-	if changeSourceType == "K8sCluster" {
+		changeSource.PagerDuty, err = &data, unmarshalSpec(changeSourceSpec, &data)
+	case "K8sCluster":
 		data := nextgen.KubernetesChangeSourceSpec{}
-		json.Unmarshal([]byte(changeSourceSpec), &data)
-
-		return nextgen.ChangeSourceDto{
-			Name:       cs["name"].(string),
-			Identifier: cs["identifier"].(string),
-			Type_:      nextgen.ChangeSourceType(changeSourceType),
-			K8sCluster: &data,
-			Enabled:    cs["enabled"].(bool),
-			Category:   cs["category"].(string),
-		}
-	}
-	if changeSourceType == "HarnessCD" {
+		changeSource.K8sCluster, err = &data, unmarshalSpec(changeSourceSpec, &data)
+	case "HarnessCD":
 		data := nextgen.HarnessCdCurrentGenChangeSourceSpec{}
-		json.Unmarshal([]byte(changeSourceSpec), &data)
-
-		return nextgen.ChangeSourceDto{
-			Name:       cs["name"].(string),
-			Identifier: cs["identifier"].(string),
-			Type_:      nextgen.ChangeSourceType(changeSourceType),
-			HarnessCD:  &data,
-			Enabled:    cs["enabled"].(bool),
-			Category:   cs["category"].(string),
-		}
+		changeSource.HarnessCD, err = &data, unmarshalSpec(changeSourceSpec, &data)
+	default:
+		return changeSource, fmt.Errorf("invalid change source type %s for monitored service", changeSourceType)
 	}
-
-	panic(fmt.Sprintf("Invalid change source type for monitored service"))
+	if err != nil {
+		return changeSource, fmt.Errorf("change source %s: invalid spec: %w", changeSource.Identifier, err)
+	}
+	return changeSource, nil
 }
 
-func getServiceDependencyByType(sd map[string]interface{}) nextgen.ServiceDependencyDto {
+func getServiceDependencyByType(sd map[string]interface{}) (nextgen.ServiceDependencyDto, error) {
 	dependencyType := sd["type"].(string)
 	dependencyMetadata := sd["dependency_metadata"].(string)
+	serviceDependency := nextgen.ServiceDependencyDto{
+		MonitoredServiceIdentifier: sd["monitored_service_identifier"].(string),
+		Type_:                      nextgen.DependencyMetadataType(dependencyType),
+	}
 
 	if dependencyType == "KUBERNETES" {
 		data := nextgen.KubernetesDependencyMetadata{}
-		json.Unmarshal([]byte(dependencyMetadata), &data)
-
-		return nextgen.ServiceDependencyDto{
-			MonitoredServiceIdentifier: sd["monitored_service_identifier"].(string),
-			Type_:                      nextgen.DependencyMetadataType(dependencyType),
-			KUBERNETES:                 &data,
+		if err := unmarshalSpec(dependencyMetadata, &data); err != nil {
+			return serviceDependency, fmt.Errorf("service dependency %s: invalid dependency_metadata: %w", serviceDependency.MonitoredServiceIdentifier, err)
 		}
+		serviceDependency.KUBERNETES = &data
+		return serviceDependency, nil
 	}
 
-	panic(fmt.Sprintf("Invalid service dependency type for monitored service"))
+	return serviceDependency, fmt.Errorf("invalid service dependency type %s for monitored service", dependencyType)
+}
+
+// unmarshalSpec decodes an optional JSON spec, leaving out untouched when the spec is empty.
+func unmarshalSpec(spec string, out interface{}) error {
+	if spec == "" {
+		return nil
+	}
+	return json.Unmarshal([]byte(spec), out)
 }
 
-func jsonToMap(jsonStr string) map[string]interface{} {
+func jsonToMap(jsonStr string) (map[string]interface{}, error) {
 	result := make(map[string]interface{})
-	json.Unmarshal([]byte(jsonStr), &result)
-	return result
+	err := json.Unmarshal([]byte(jsonStr), &result)
+	return result, err
 }
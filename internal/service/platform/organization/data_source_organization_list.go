@@ -0,0 +1,104 @@
+package organization
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/antihax/optional"
+	"github.com/harness/harness-go-sdk/harness/nextgen"
+	"github.com/harness/terraform-provider-harness/helpers"
+	"github.com/harness/terraform-provider-harness/internal"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceOrganizationList() *schema.Resource {
+	resource := &schema.Resource{
+		Description: "Data source for listing the Harness organizations of the account. Every page of results is fetched.",
+
+		ReadContext: dataSourceOrganizationListRead,
+
+		Schema: map[string]*schema.Schema{
+			"organizations": {
+				Description: "List of organizations matching the filters.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identifier": {
+							Description: "Unique identifier of the organization.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the organization.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "Description of the organization.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"tags": {
+							Description: "Tags associated with the organization.",
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	helpers.SetListDataSourceFilterSchema(resource.Schema)
+
+	return resource
+}
+
+func dataSourceOrganizationListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
+
+	opts := &nextgen.OrganizationApiGetOrganizationListOpts{
+		SearchTerm: helpers.BuildField(d, "search_term"),
+		PageSize:   optional.NewInt32(helpers.ListPageSize),
+	}
+
+	orgs := []interface{}{}
+	httpResp, err := helpers.ListAllPages(func(page int32) (bool, *http.Response, error) {
+		opts.PageIndex = optional.NewInt32(page)
+
+		resp, httpResp, err := c.OrganizationApi.GetOrganizationList(ctx, c.AccountId, opts)
+		if err != nil {
+			return false, httpResp, err
+		}
+
+		if resp.Data == nil {
+			return false, httpResp, nil
+		}
+
+		for _, v := range resp.Data.Content {
+			if v.Organization == nil || !helpers.MatchesFilterTags(d, v.Organization.Tags) {
+				continue
+			}
+
+			orgs = append(orgs, map[string]interface{}{
+				"identifier":  v.Organization.Identifier,
+				"name":        v.Organization.Name,
+				"description": v.Organization.Description,
+				"tags":        helpers.FlattenTags(v.Organization.Tags),
+			})
+		}
+
+		return helpers.HasMorePages(page, resp.Data.TotalPages), httpResp, nil
+	})
+	if err != nil {
+		return helpers.HandleApiError(err, d, httpResp)
+	}
+
+	d.SetId(helpers.ListDataSourceId(c.AccountId, d, "search_term", "filter_tags"))
+	d.Set("organizations", orgs)
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package organization_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/harness/harness-go-sdk/harness/utils"
+	"github.com/harness/terraform-provider-harness/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceOrganizationList(t *testing.T) {
+
+	id := fmt.Sprintf("%s_%s", t.Name(), utils.RandStringBytes(5))
+	resourceName := "data.harness_platform_organization_list.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.TestAccPreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceOrganizationList(id),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "organizations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "organizations.0.identifier", id),
+					resource.TestCheckResourceAttr(resourceName, "organizations.0.name", id),
+					resource.TestCheckResourceAttr(resourceName, "organizations.0.description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "organizations.0.tags.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceOrganizationList(id string) string {
+	return fmt.Sprintf(`
+		resource "harness_platform_organization" "test" {
+			identifier = "%[1]s"
+			name = "%[1]s"
+			description = "test"
+			tags = ["foo:bar"]
+		}
+
+		data "harness_platform_organization_list" "test" {
+			search_term = harness_platform_organization.test.identifier
+			filter_tags = ["foo:bar"]
+		}
+	`, id)
+}
@@ -0,0 +1,43 @@
+package organization
+
+import (
+	"fmt"
+
+	"github.com/antihax/optional"
+	openapi_client_nextgen "github.com/harness/harness-openapi-go-client/nextgen"
+	"github.com/harness/terraform-provider-harness/internal/sweep"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func init() {
+	resource.AddTestSweepers("harness_platform_organization", &resource.Sweeper{
+		Name:         "harness_platform_organization",
+		F:            testSweepOrganizations,
+		Dependencies: []string{"harness_platform_project"},
+	})
+}
+
+func testSweepOrganizations(r string) error {
+	c, ctx := sweep.GetClientWithContext()
+
+	orgs, err := sweep.ListOrgs()
+	if err != nil {
+		return err
+	}
+
+	for _, org := range orgs {
+		// Only delete organizations whose identifier starts with the sweep prefix
+		if !sweep.IsSweepable(org) {
+			continue
+		}
+
+		_, _, err := c.OrganizationApi.DeleteOrganization(ctx, org, &openapi_client_nextgen.OrganizationApiDeleteOrganizationOpts{
+			HarnessAccount: optional.NewString(c.AccountId),
+		})
+		if err != nil {
+			fmt.Printf("[ERROR] Failed to delete organization %s: %s", org, err)
+		}
+	}
+
+	return nil
+}
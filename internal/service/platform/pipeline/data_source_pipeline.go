@@ -36,8 +36,9 @@ func DataSourcePipeline() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"branch_name": {
-							Description: "Name of the branch.",
+							Description: "Name of the branch to read the Entity from. Defaults to the default branch of the repository.",
 							Type:        schema.TypeString,
+							Optional:    true,
 							Computed:    true,
 						},
 						"file_path": {
@@ -111,23 +112,25 @@ func dataSourcePipelineRead(ctx context.Context, d *schema.ResourceData, meta in
 	project_id := d.Get("project_id").(string)
 	pipeline_id := d.Get("identifier").(string)
 	template_applied := d.Get("template_applied").(bool)
-	store_type := helpers.BuildField(d, "git_details.0.store_type")
-	base_branch := helpers.BuildField(d, "git_details.0.base_branch")
-	commit_message := helpers.BuildField(d, "git_details.0.commit_message")
-	connector_ref := helpers.BuildField(d, "git_details.0.connector_ref")
+	git := helpers.BuildGitReadOptions(d)
 
 	resp, httpResp, err := c.PipelinesApi.GetPipeline(ctx,
 		org_id,
 		project_id,
 		pipeline_id,
-		&nextgen.PipelinesApiGetPipelineOpts{HarnessAccount: optional.NewString(c.AccountId)},
+		&nextgen.PipelinesApiGetPipelineOpts{
+			HarnessAccount: optional.NewString(c.AccountId),
+			BranchName:     git.BranchName,
+		},
 	)
 
 	if err != nil {
 		return helpers.HandleApiError(err, d, httpResp)
 	}
 
-	readPipeline(d, resp, org_id, project_id, template_applied, store_type, base_branch, commit_message, connector_ref)
+	store_type, connector_ref := getPipelineStoreDetails(ctx, c, d, resp, org_id, project_id)
+
+	readPipeline(d, resp, org_id, project_id, template_applied, store_type, connector_ref)
 
 	return nil
 }
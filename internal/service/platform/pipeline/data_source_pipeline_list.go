@@ -0,0 +1,153 @@
+package pipeline
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/antihax/optional"
+	"github.com/harness/harness-openapi-go-client/nextgen"
+	"github.com/harness/terraform-provider-harness/helpers"
+	"github.com/harness/terraform-provider-harness/internal"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourcePipelineList() *schema.Resource {
+	resource := &schema.Resource{
+		Description: "Data source for listing the Harness pipelines of a project. Every page of results is fetched.",
+
+		ReadContext: dataSourcePipelineListRead,
+
+		Schema: map[string]*schema.Schema{
+			"module": {
+				Description: "Filter the pipelines by the module of their stages, e.g. `cd` or `ci`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"pipelines": {
+				Description: "List of pipelines matching the filters.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identifier": {
+							Description: "Unique identifier of the pipeline.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the pipeline.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "Description of the pipeline.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"tags": {
+							Description: "Tags associated with the pipeline.",
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"modules": {
+							Description: "Modules of the stages of the pipeline.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"store_type": {
+							Description: "Specifies whether the pipeline is stored in Git or not. Possible values: INLINE, REMOTE.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"connector_ref": {
+							Description: "Identifier of the Harness Connector used for CRUD operations on the pipeline.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"repo_name": {
+							Description: "Name of the repository the pipeline is stored in.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"file_path": {
+							Description: "File path of the pipeline in the repository.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"updated": {
+							Description: "Last modification timestamp of the pipeline, in milliseconds.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	resource.Schema["org_id"] = helpers.GetOrgIdSchema(helpers.SchemaFlagTypes.Required)
+	resource.Schema["project_id"] = helpers.GetProjectIdSchema(helpers.SchemaFlagTypes.Required)
+	helpers.SetListDataSourceFilterSchema(resource.Schema)
+
+	return resource
+}
+
+func dataSourcePipelineListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, ctx := meta.(*internal.Session).GetClientWithContext(ctx)
+
+	org_id := d.Get("org_id").(string)
+	project_id := d.Get("project_id").(string)
+
+	opts := &nextgen.PipelinesApiListPipelinesOpts{
+		HarnessAccount: optional.NewString(c.AccountId),
+		SearchTerm:     helpers.BuildField(d, "search_term"),
+		Module:         helpers.BuildField(d, "module"),
+		Limit:          optional.NewInt32(helpers.ListPageSize),
+	}
+
+	pipelines := []interface{}{}
+	httpResp, err := helpers.ListAllPages(func(page int32) (bool, *http.Response, error) {
+		opts.Page = optional.NewInt32(page)
+
+		resp, httpResp, err := c.PipelinesApi.ListPipelines(ctx, org_id, project_id, opts)
+		if err != nil {
+			return false, httpResp, err
+		}
+
+		for _, v := range resp {
+			if !helpers.MatchesFilterTags(d, v.Tags) {
+				continue
+			}
+
+			pipeline := map[string]interface{}{
+				"identifier":    v.Identifier,
+				"name":          v.Name,
+				"description":   v.Description,
+				"tags":          helpers.FlattenTags(v.Tags),
+				"modules":       v.Modules,
+				"store_type":    v.StoreType,
+				"connector_ref": v.ConnectorRef,
+				"updated":       int(v.Updated),
+			}
+			if v.GitDetails != nil {
+				pipeline["repo_name"] = v.GitDetails.RepoName
+				pipeline["file_path"] = v.GitDetails.FilePath
+			}
+
+			pipelines = append(pipelines, pipeline)
+		}
+
+		return helpers.IsFullPage(len(resp)), httpResp, nil
+	})
+	if err != nil {
+		return helpers.HandleApiError(err, d, httpResp)
+	}
+
+	d.SetId(helpers.ListDataSourceId(c.AccountId, d, "org_id", "project_id", "module", "search_term", "filter_tags"))
+	d.Set("pipelines", pipelines)
+
+	return nil
+}
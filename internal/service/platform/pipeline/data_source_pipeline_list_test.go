@@ -0,0 +1,51 @@
+package pipeline_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/harness/harness-go-sdk/harness/utils"
+	"github.com/harness/terraform-provider-harness/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePipelineList(t *testing.T) {
+	id := fmt.Sprintf("%s_%s", t.Name(), utils.RandStringBytes(5))
+	resourceName := "data.harness_platform_pipeline_list.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.TestAccPreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePipelineList(id),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "pipelines.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "pipelines.0.identifier", id),
+					resource.TestCheckResourceAttr(resourceName, "pipelines.0.name", id),
+					resource.TestCheckResourceAttr(resourceName, "pipelines.0.store_type", "INLINE"),
+					resource.TestCheckResourceAttr(resourceName, "pipelines.0.tags.#", "1"),
+					resource.TestCheckResourceAttr("data.harness_platform_pipeline_list.untagged", "pipelines.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePipelineList(id string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+		data "harness_platform_pipeline_list" "test" {
+			org_id = harness_platform_pipeline.test.org_id
+			project_id = harness_platform_pipeline.test.project_id
+			filter_tags = ["foo:bar"]
+		}
+
+		data "harness_platform_pipeline_list" "untagged" {
+			org_id = harness_platform_pipeline.test.org_id
+			project_id = harness_platform_pipeline.test.project_id
+			filter_tags = ["foo:baz"]
+		}
+	`, testAccResourcePipelineTags(id, "foo", "bar"))
+}
@@ -2,6 +2,7 @@ package pipeline
 
 import (
 	"context"
+	"log"
 	"net/http"
 
 	"github.com/antihax/optional"
@@ -9,8 +10,8 @@ import (
 	"github.com/harness/terraform-provider-harness/helpers"
 	"github.com/harness/terraform-provider-harness/internal"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func ResourcePipeline() *schema.Resource {
@@ -21,7 +22,11 @@ func ResourcePipeline() *schema.Resource {
 		UpdateContext: resourcePipelineCreateOrUpdate,
 		DeleteContext: resourcePipelineDelete,
 		CreateContext: resourcePipelineCreateOrUpdate,
-		Importer:      helpers.ProjectResourceImporter,
+		CustomizeDiff: customdiff.All(
+			helpers.ValidateYamlDiff("pipeline", "yaml", helpers.YamlScopeArguments),
+			resourcePipelineCustomizeDiff,
+		),
+		Importer: helpers.ProjectResourceImporter,
 
 		Schema: map[string]*schema.Schema{
 			"yaml": {
@@ -31,72 +36,13 @@ func ResourcePipeline() *schema.Resource {
 				Computed:         true,
 				DiffSuppressFunc: helpers.YamlDiffSuppressFunction,
 			},
-			"git_details": {
-				Description: "Contains parameters related to creating an Entity for Git Experience.",
+			"yaml_changed_fields": {
+				Description: "Paths of the fields of the YAML changed by the planned update of the pipeline, e.g. `pipeline.stages[0].stage.name`. It is computed when planning, so that the plan lists the fields that change, and is empty once the pipeline matches the configuration.",
 				Type:        schema.TypeList,
-				MaxItems:    1,
-				Optional:    true,
 				Computed:    true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"branch_name": {
-							Description: "Name of the branch.",
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
-						},
-						"file_path": {
-							Description: "File path of the Entity in the repository.",
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
-						},
-						"commit_message": {
-							Description: "Commit message used for the merge commit.",
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
-						},
-						"base_branch": {
-							Description: "Name of the default branch (this checks out a new branch titled by branch_name).",
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
-						},
-						"connector_ref": {
-							Description: "Identifier of the Harness Connector used for CRUD operations on the Entity." + helpers.Descriptions.ConnectorRefText.String(),
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
-						},
-						"store_type": {
-							Description:  "Specifies whether the Entity is to be stored in Git or not. Possible values: INLINE, REMOTE.",
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringInSlice([]string{"INLINE", "REMOTE"}, false),
-							Computed:     true,
-						},
-						"repo_name": {
-							Description: "Name of the repository.",
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
-						},
-						"last_object_id": {
-							Description: "Last object identifier (for Github). To be provided only when updating Pipeline.",
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
-						},
-						"last_commit_id": {
-							Description: "Last commit identifier (for Git Repositories other than Github). To be provided only when updating Pipeline.",
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
-						},
-					},
-				},
+				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"git_details": helpers.GetGitDetailsSchema(false),
 			"template_applied": {
 				Description: "If true, returns Pipeline YAML with Templates applied on it.",
 				Type:        schema.TypeBool,
@@ -107,41 +53,8 @@ func ResourcePipeline() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
-			"import_from_git": {
-				Description: "Flag to set if importing from Git",
-				Type:        schema.TypeBool,
-				Optional:    true,
-			},
-			"git_import_info": {
-				Description: "Contains Git Information for importing entities from Git",
-				Type:        schema.TypeList,
-				MaxItems:    1,
-				Optional:    true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"branch_name": {
-							Description: "Name of the branch.",
-							Type:        schema.TypeString,
-							Optional:    true,
-						},
-						"file_path": {
-							Description: "File path of the Entity in the repository.",
-							Type:        schema.TypeString,
-							Optional:    true,
-						},
-						"connector_ref": {
-							Description: "Identifier of the Harness Connector used for importing entity from Git" + helpers.Descriptions.ConnectorRefText.String(),
-							Type:        schema.TypeString,
-							Optional:    true,
-						},
-						"repo_name": {
-							Description: "Name of the repository.",
-							Type:        schema.TypeString,
-							Optional:    true,
-						},
-					},
-				},
-			},
+			"import_from_git": helpers.GetImportFromGitSchema(),
+			"git_import_info": helpers.GetGitImportInfoSchema(),
 			"pipeline_import_request": {
 				Description: "Contains parameters for importing a pipeline",
 				Type:        schema.TypeList,
@@ -166,6 +79,7 @@ func ResourcePipeline() *schema.Resource {
 	}
 
 	helpers.SetProjectLevelResourceSchema(resource.Schema)
+	helpers.SetGitMoveConfigSchema(resource.Schema)
 
 	return resource
 }
@@ -177,21 +91,21 @@ func resourcePipelineRead(ctx context.Context, d *schema.ResourceData, meta inte
 	org_id := d.Get("org_id").(string)
 	project_id := d.Get("project_id").(string)
 	template_applied := d.Get("template_applied").(bool)
-	var branch_name optional.String
-	branch_name = helpers.BuildField(d, "git_details.0.branch_name")
-	var store_type = helpers.BuildField(d, "git_details.0.store_type")
-	var base_branch = helpers.BuildField(d, "git_details.0.base_branch")
-	var commit_message = helpers.BuildField(d, "git_details.0.commit_message")
-	var connector_ref = helpers.BuildField(d, "git_details.0.connector_ref")
-	var object_id = helpers.BuildField(d, "git_details.0.object_id")
+	git := helpers.BuildGitReadOptions(d)
 	resp, httpResp, err := c.PipelinesApi.GetPipeline(ctx,
 		org_id,
 		project_id,
 		id,
-		&nextgen.PipelinesApiGetPipelineOpts{HarnessAccount: optional.NewString(c.AccountId), BranchName: branch_name},
+		&nextgen.PipelinesApiGetPipelineOpts{
+			HarnessAccount:  optional.NewString(c.AccountId),
+			BranchName:      git.BranchName,
+			ConnectorRef:    git.ConnectorRef,
+			RepoName:        git.RepoName,
+			TemplateApplied: optional.NewBool(template_applied),
+		},
 	)
 
-	if httpResp.StatusCode == 404 {
+	if httpResp != nil && httpResp.StatusCode == 404 {
 		d.SetId("")
 		d.MarkNewResource()
 		return nil
@@ -200,23 +114,48 @@ func resourcePipelineRead(ctx context.Context, d *schema.ResourceData, meta inte
 	if err != nil {
 		return helpers.HandleApiError(err, d, httpResp)
 	}
-	print(object_id.Value())
 
-	readPipeline(d, resp, org_id, project_id, template_applied, store_type, base_branch, commit_message, connector_ref)
+	store_type, connector_ref := getPipelineStoreDetails(ctx, c, d, resp, org_id, project_id)
+
+	readPipeline(d, resp, org_id, project_id, template_applied, store_type, connector_ref)
 
 	return nil
 }
 
+// getPipelineStoreDetails returns the store type and git connector of the pipeline. They're not part of
+// the response when getting a single pipeline, so for pipelines stored in git they're read from the
+// pipeline list instead. The values already in the state are returned for inline pipelines and when the
+// pipeline can't be listed.
+func getPipelineStoreDetails(ctx context.Context, c *nextgen.APIClient, d *schema.ResourceData, pipeline nextgen.PipelineGetResponseBody, org_id string, project_id string) (string, string) {
+	store_type := d.Get("git_details.0.store_type").(string)
+	connector_ref := d.Get("git_details.0.connector_ref").(string)
+	if pipeline.GitDetails == nil || pipeline.GitDetails.FilePath == "" {
+		return store_type, connector_ref
+	}
+
+	pipelines, _, err := c.PipelinesApi.ListPipelines(ctx, org_id, project_id, &nextgen.PipelinesApiListPipelinesOpts{
+		HarnessAccount:      optional.NewString(c.AccountId),
+		PipelineIdentifiers: optional.NewInterface([]string{pipeline.Identifier}),
+	})
+	if err != nil {
+		log.Printf("[WARN] Failed to read the store type of pipeline %s: %s", pipeline.Identifier, err)
+		return store_type, connector_ref
+	}
+
+	for _, p := range pipelines {
+		if p.Identifier == pipeline.Identifier {
+			return p.StoreType, p.ConnectorRef
+		}
+	}
+
+	return store_type, connector_ref
+}
+
 func resourcePipelineCreateOrUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c, ctx := meta.(*internal.Session).GetClientWithContext(ctx)
 
 	var err error
 	var pipeline_id string
-	var branch_name string
-	var store_type optional.String
-	var base_branch optional.String
-	var commit_message optional.String
-	var connector_ref optional.String
 	var httpResp *http.Response
 	id := d.Id()
 	org_id := d.Get("org_id").(string)
@@ -224,7 +163,7 @@ func resourcePipelineCreateOrUpdate(ctx context.Context, d *schema.ResourceData,
 	template_applied := d.Get("template_applied").(bool)
 
 	if id == "" {
-		if d.Get("import_from_git").(bool) {
+		if helpers.IsImportFromGit(d) {
 			pipeline_id = d.Get("identifier").(string)
 
 			pipeline_import_request_body := createImportFromGitRequest(d)
@@ -235,30 +174,36 @@ func resourcePipelineCreateOrUpdate(ctx context.Context, d *schema.ResourceData,
 					HarnessAccount: optional.NewString(c.AccountId)})
 		} else {
 			pipeline := buildCreatePipeline(d)
-			if pipeline.GitDetails != nil {
-				base_branch = optional.NewString(pipeline.GitDetails.BaseBranch)
-				store_type = optional.NewString(pipeline.GitDetails.StoreType)
-				commit_message = optional.NewString(pipeline.GitDetails.CommitMessage)
-				connector_ref = optional.NewString(pipeline.GitDetails.ConnectorRef)
-				branch_name = pipeline.GitDetails.BranchName
-			}
-
 			pipeline_id = pipeline.Identifier
 			_, httpResp, err = c.PipelinesApi.CreatePipeline(ctx, pipeline, org_id, project_id,
 				&nextgen.PipelinesApiCreatePipelineOpts{HarnessAccount: optional.NewString(c.AccountId)})
 		}
 	} else {
 		pipeline := buildUpdatePipeline(d)
-		store_type = helpers.BuildField(d, "git_details.0.store_type")
-		connector_ref = helpers.BuildField(d, "git_details.0.connector_ref")
 		pipeline_id = pipeline.Identifier
-		if pipeline.GitDetails != nil {
-			base_branch = optional.NewString(pipeline.GitDetails.BaseBranch)
-			branch_name = pipeline.GitDetails.BranchName
-			commit_message = optional.NewString(pipeline.GitDetails.CommitMessage)
+
+		moved := false
+		if moveConfigType := helpers.GetGitMoveConfigType(d); moveConfigType != "" {
+			_, httpResp, err = c.PipelinesApi.MoveConfig(ctx, org_id, project_id, id, &nextgen.PipelinesApiMoveConfigOpts{
+				Body:           optional.NewInterface(helpers.BuildGitMoveConfigRequestBody(d, moveConfigType)),
+				HarnessAccount: optional.NewString(c.AccountId),
+			})
+			if err != nil {
+				return helpers.HandleApiError(err, d, httpResp)
+			}
+			moved = true
+		}
+
+		// Moving the pipeline commits its current yaml, the pipeline is only updated when something else changed.
+		if !moved || d.HasChangesExcept("git_details") {
+			if moved && pipeline.GitDetails != nil {
+				// The object and commit in state are the ones before the move.
+				pipeline.GitDetails.LastObjectId = ""
+				pipeline.GitDetails.LastCommitId = ""
+			}
+			_, httpResp, err = c.PipelinesApi.UpdatePipeline(ctx, pipeline, org_id, project_id, id,
+				&nextgen.PipelinesApiUpdatePipelineOpts{HarnessAccount: optional.NewString(c.AccountId)})
 		}
-		_, httpResp, err = c.PipelinesApi.UpdatePipeline(ctx, pipeline, org_id, project_id, id,
-			&nextgen.PipelinesApiUpdatePipelineOpts{HarnessAccount: optional.NewString(c.AccountId)})
 	}
 
 	if err != nil {
@@ -266,34 +211,56 @@ func resourcePipelineCreateOrUpdate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	// The create/update methods don't return the yaml in the response, so we need to query for it again.
+	git := helpers.BuildGitReadOptions(d)
 	resp, httpResp, err := c.PipelinesApi.GetPipeline(ctx, org_id, project_id, pipeline_id,
-		&nextgen.PipelinesApiGetPipelineOpts{HarnessAccount: optional.NewString(c.AccountId), BranchName: optional.NewString(branch_name)})
+		&nextgen.PipelinesApiGetPipelineOpts{
+			HarnessAccount:  optional.NewString(c.AccountId),
+			BranchName:      git.BranchName,
+			ConnectorRef:    git.ConnectorRef,
+			RepoName:        git.RepoName,
+			TemplateApplied: optional.NewBool(template_applied),
+		})
 	if err != nil {
 		return helpers.HandleApiError(err, d, httpResp)
 	}
 
-	readPipeline(d, resp, org_id, project_id, template_applied, store_type, base_branch, commit_message, connector_ref)
+	store_type, connector_ref := getPipelineStoreDetails(ctx, c, d, resp, org_id, project_id)
+
+	readPipeline(d, resp, org_id, project_id, template_applied, store_type, connector_ref)
 
 	return nil
 }
 
+// resourcePipelineCustomizeDiff sets the fields of the pipeline yaml that change, as the plan only
+// shows the difference of the yaml as a whole.
+func resourcePipelineCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("yaml") {
+		return nil
+	}
+
+	if !d.NewValueKnown("yaml") {
+		return d.SetNewComputed("yaml_changed_fields")
+	}
+
+	old, new := d.GetChange("yaml")
+	paths, err := helpers.YamlDiffPaths(old.(string), new.(string))
+	if err != nil {
+		// Invalid yaml is reported by the yaml validation.
+		return nil
+	}
+
+	return d.SetNew("yaml_changed_fields", paths)
+}
+
 func createImportFromGitRequest(d *schema.ResourceData) *nextgen.PipelineImportRequestBody {
 
 	pipeline_git_import_info := &nextgen.GitImportInfo{}
-	if attr, ok := d.GetOk("git_import_info"); ok {
-		config := attr.([]interface{})[0].(map[string]interface{})
-		if attr, ok := config["branch_name"]; ok {
-			pipeline_git_import_info.BranchName = attr.(string)
-		}
-		if attr, ok := config["file_path"]; ok {
-			pipeline_git_import_info.FilePath = attr.(string)
-		}
-		if attr, ok := config["connector_ref"]; ok {
-			pipeline_git_import_info.ConnectorRef = attr.(string)
-		}
-		if attr, ok := config["repo_name"]; ok {
-			pipeline_git_import_info.RepoName = attr.(string)
-		}
+	if info := helpers.ExpandGitImportInfo(d); info != nil {
+		pipeline_git_import_info.BranchName = info.BranchName
+		pipeline_git_import_info.FilePath = info.FilePath
+		pipeline_git_import_info.ConnectorRef = info.ConnectorRef
+		pipeline_git_import_info.RepoName = info.RepoName
+		pipeline_git_import_info.IsForceImport = info.IsForceImport
 	}
 
 	pipeline_import_request := &nextgen.PipelineImportRequestDto{}
@@ -340,29 +307,15 @@ func buildCreatePipeline(d *schema.ResourceData) nextgen.PipelineCreateRequestBo
 		PipelineYaml: d.Get("yaml").(string),
 	}
 
-	if attr, ok := d.GetOk("git_details"); ok {
-		config := attr.([]interface{})[0].(map[string]interface{})
-		pipeline.GitDetails = &nextgen.GitCreateDetails{}
-		if attr, ok := config["branch_name"]; ok {
-			pipeline.GitDetails.BranchName = attr.(string)
-		}
-		if attr, ok := config["file_path"]; ok {
-			pipeline.GitDetails.FilePath = attr.(string)
-		}
-		if attr, ok := config["commit_message"]; ok {
-			pipeline.GitDetails.CommitMessage = attr.(string)
-		}
-		if attr, ok := config["base_branch"]; ok {
-			pipeline.GitDetails.BaseBranch = attr.(string)
-		}
-		if attr, ok := config["connector_ref"]; ok {
-			pipeline.GitDetails.ConnectorRef = attr.(string)
-		}
-		if attr, ok := config["store_type"]; ok {
-			pipeline.GitDetails.StoreType = attr.(string)
-		}
-		if attr, ok := config["repo_name"]; ok {
-			pipeline.GitDetails.RepoName = attr.(string)
+	if git := helpers.ExpandGitDetails(d); git != nil {
+		pipeline.GitDetails = &nextgen.GitCreateDetails{
+			BranchName:    git.BranchName,
+			FilePath:      git.FilePath,
+			CommitMessage: git.CommitMessage,
+			BaseBranch:    git.BaseBranch,
+			ConnectorRef:  git.ConnectorRef,
+			StoreType:     git.StoreType,
+			RepoName:      git.RepoName,
 		}
 	}
 	return pipeline
@@ -377,68 +330,46 @@ func buildUpdatePipeline(d *schema.ResourceData) nextgen.PipelineUpdateRequestBo
 		PipelineYaml: d.Get("yaml").(string),
 	}
 
-	if attr, ok := d.GetOk("git_details"); ok {
-		configs := attr.([]interface{})
-		if len(configs) > 0 {
-			config := configs[0].(map[string]interface{})
-
-			pipeline.GitDetails = &nextgen.GitUpdateDetails{}
-
-			if attr, ok := config["branch_name"]; ok {
-				pipeline.GitDetails.BranchName = attr.(string)
-			}
-			if attr, ok := config["commit_message"]; ok {
-				pipeline.GitDetails.CommitMessage = attr.(string)
-			}
-			if attr, ok := config["base_branch"]; ok {
-				pipeline.GitDetails.BaseBranch = attr.(string)
-			}
-			if attr, ok := config["last_object_id"]; ok {
-				pipeline.GitDetails.LastObjectId = attr.(string)
-			}
-			if attr, ok := config["last_commit_id"]; ok {
-				pipeline.GitDetails.LastCommitId = attr.(string)
-			}
+	if git := helpers.ExpandGitDetails(d); git != nil {
+		pipeline.GitDetails = &nextgen.GitUpdateDetails{
+			BranchName:    git.BranchName,
+			CommitMessage: git.CommitMessage,
+			BaseBranch:    git.BaseBranch,
+			LastObjectId:  git.LastObjectId,
+			LastCommitId:  git.LastCommitId,
 		}
 	}
 	return pipeline
 }
 
 // Read response from API out to the stored identifiers
-func readPipeline(d *schema.ResourceData, pipeline nextgen.PipelineGetResponseBody, org_id string, project_id string, template_applied bool, store_type optional.String, base_branch optional.String, commit_message optional.String, connector_ref optional.String) {
+func readPipeline(d *schema.ResourceData, pipeline nextgen.PipelineGetResponseBody, org_id string, project_id string, template_applied bool, store_type string, connector_ref string) {
 	d.SetId(pipeline.Identifier)
 	d.Set("identifier", pipeline.Identifier)
 	d.Set("name", pipeline.Name)
 	d.Set("org_id", org_id)
 	d.Set("project_id", project_id)
-	d.Set("yaml", pipeline.PipelineYaml)
+	// Keep the yaml as written in the configuration when the server only reformatted it, so that
+	// the plan only shows the lines that actually changed.
+	if !helpers.YamlDiffSuppressFunction("yaml", d.Get("yaml").(string), pipeline.PipelineYaml, d) {
+		d.Set("yaml", pipeline.PipelineYaml)
+	} else {
+		// The fields listed when planning the update are applied.
+		d.Set("yaml_changed_fields", []string{})
+	}
 	d.Set("description", pipeline.Description)
+	d.Set("tags", helpers.FlattenTags(pipeline.Tags))
 	d.Set("template_applied_pipeline_yaml", pipeline.TemplateAppliedPipelineYaml)
 	d.Set("template_applied", template_applied)
 	if pipeline.GitDetails != nil {
-		d.Set("git_details", []interface{}{readGitDetails(pipeline, store_type, base_branch, commit_message, connector_ref)})
-	}
-}
-
-func readGitDetails(pipeline nextgen.PipelineGetResponseBody, store_type optional.String, base_branch optional.String, commit_message optional.String, connector_ref optional.String) map[string]interface{} {
-	git_details := map[string]interface{}{
-		"branch_name":    pipeline.GitDetails.BranchName,
-		"file_path":      pipeline.GitDetails.FilePath,
-		"repo_name":      pipeline.GitDetails.RepoName,
-		"last_commit_id": pipeline.GitDetails.CommitId,
-		"last_object_id": pipeline.GitDetails.ObjectId,
-	}
-	if store_type.IsSet() {
-		git_details["store_type"] = store_type.Value()
-	}
-	if base_branch.IsSet() {
-		git_details["base_branch"] = base_branch.Value()
-	}
-	if commit_message.IsSet() {
-		git_details["commit_message"] = commit_message.Value()
-	}
-	if connector_ref.IsSet() {
-		git_details["connector_ref"] = connector_ref.Value()
+		helpers.SetGitDetails(d, &helpers.GitDetails{
+			StoreType:    store_type,
+			ConnectorRef: connector_ref,
+			BranchName:   pipeline.GitDetails.BranchName,
+			FilePath:     pipeline.GitDetails.FilePath,
+			RepoName:     pipeline.GitDetails.RepoName,
+			LastCommitId: pipeline.GitDetails.CommitId,
+			LastObjectId: pipeline.GitDetails.ObjectId,
+		})
 	}
-	return git_details
 }
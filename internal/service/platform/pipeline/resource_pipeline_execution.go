@@ -0,0 +1,416 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/antihax/optional"
+	"github.com/harness/harness-go-sdk/harness/nextgen"
+	"github.com/harness/terraform-provider-harness/helpers"
+	"github.com/harness/terraform-provider-harness/internal"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// pipelineExecutionRunning is the state reported while waiting for an execution whose status
+// is not final yet.
+const pipelineExecutionRunning = "Running"
+
+// Final statuses of a pipeline execution.
+var pipelineExecutionFinalStatuses = []string{
+	"Success",
+	"Failed",
+	"Aborted",
+	"AbortedByFreeze",
+	"Expired",
+	"Errored",
+	"IgnoreFailed",
+	"ApprovalRejected",
+	"Skipped",
+}
+
+func ResourcePipelineExecution() *schema.Resource {
+	resource := &schema.Resource{
+		Description: "Resource for executing a Harness pipeline. An execution is started when the resource is created and whenever an argument that forces replacement changes, e.g. `triggers`. Destroying the resource only removes it from the state unless `abort_on_destroy` is set.",
+
+		ReadContext:   resourcePipelineExecutionRead,
+		CreateContext: resourcePipelineExecutionCreate,
+		UpdateContext: resourcePipelineExecutionRead,
+		DeleteContext: resourcePipelineExecutionDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				parts := strings.Split(d.Id(), "/")
+				if len(parts) != 3 {
+					return nil, fmt.Errorf("invalid import id %q, expected <org_id>/<project_id>/<execution_id>", d.Id())
+				}
+				d.Set("org_id", parts[0])
+				d.Set("project_id", parts[1])
+				d.Set("wait_for_completion", true)
+				d.Set("abort_on_destroy", false)
+				d.SetId(parts[2])
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id":     helpers.GetOrgIdSchema(helpers.SchemaFlagTypes.Required),
+			"project_id": helpers.GetProjectIdSchema(helpers.SchemaFlagTypes.Required),
+			"pipeline_id": {
+				Description: "Identifier of the pipeline to execute.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"input_set_ids": {
+				Description: "Identifiers of the input sets used to execute the pipeline. The input sets are merged in the given order.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"runtime_input_yaml": {
+				Description:      "Runtime inputs of the execution. When `input_set_ids` is also set the runtime inputs are merged on top of the input sets." + helpers.Descriptions.YamlText.String(),
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: helpers.YamlDiffSuppressFunction,
+			},
+			"branch": {
+				Description: "Branch to load the pipeline from. Only applicable to pipelines stored in Git.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"triggers": {
+				Description: "Arbitrary map of values that, when changed, executes the pipeline again.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"wait_for_completion": {
+				Description: "Wait for the execution to finish. The apply fails and the resource is tainted when the execution does not succeed, so that the next apply executes the pipeline again. The maximum wait is controlled by the `create` timeout.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"abort_on_destroy": {
+				Description: "Abort the execution when the resource is destroyed while the execution is still running.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"execution_id": {
+				Description: "Identifier of the execution.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"status": {
+				Description: "Status of the execution.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"stages": {
+				Description: "Stages of the execution.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identifier": {
+							Description: "Identifier of the stage.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the stage.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"status": {
+							Description: "Status of the stage.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"outputs": {
+							Description: "Outcomes published by the stage, keyed by outcome name. Values are JSON encoded.",
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return resource
+}
+
+func resourcePipelineExecutionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
+
+	orgId := d.Get("org_id").(string)
+	projectId := d.Get("project_id").(string)
+	pipelineId := d.Get("pipeline_id").(string)
+	runtimeInputYaml := d.Get("runtime_input_yaml").(string)
+	branch := helpers.BuildField(d, "branch")
+
+	var resp nextgen.ResponseDtoPlanExecutionResponseDto
+	var err error
+	var httpResp *http.Response
+
+	if inputSetIds := expandInputSetIds(d.Get("input_set_ids").([]interface{})); len(inputSetIds) > 0 {
+		resp, httpResp, err = c.PipelineExecuteApi.PostPipelineExecuteWithInputSetList(ctx, nextgen.MergeInputSetRequest{
+			InputSetReferences: inputSetIds,
+			LastYamlToMerge:    runtimeInputYaml,
+		}, c.AccountId, orgId, projectId, pipelineId, &nextgen.PipelineExecuteApiPostPipelineExecuteWithInputSetListOpts{
+			Branch: branch,
+		})
+	} else {
+		resp, httpResp, err = c.PipelineExecuteApi.PostPipelineExecuteWithInputSetYaml(ctx, c.AccountId, orgId, projectId, pipelineId, &nextgen.PipelineExecuteApiPostPipelineExecuteWithInputSetYamlOpts{
+			Body:   optional.NewString(runtimeInputYaml),
+			Branch: branch,
+		})
+	}
+
+	if err != nil {
+		return helpers.HandleApiError(err, d, httpResp)
+	}
+
+	if resp.Data == nil || resp.Data.PlanExecution == nil {
+		return diag.Errorf("the execution of pipeline %s did not return an execution id", pipelineId)
+	}
+
+	executionId := resp.Data.PlanExecution.Uuid
+	d.SetId(executionId)
+	d.Set("execution_id", executionId)
+	log.Printf("[INFO] Started execution %s of pipeline %s", executionId, pipelineId)
+
+	if d.Get("wait_for_completion").(bool) {
+		status, err := waitForPipelineExecution(ctx, c, orgId, projectId, executionId, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.Errorf("error waiting for execution %s of pipeline %s: %s", executionId, pipelineId, err)
+		}
+
+		if status != "Success" && status != "IgnoreFailed" {
+			diags := resourcePipelineExecutionRead(ctx, d, meta)
+			return append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Execution %s of pipeline %s finished with status %s", executionId, pipelineId, status),
+				Detail:   "The resource is marked as tainted, so the next apply executes the pipeline again.",
+			})
+		}
+	}
+
+	return resourcePipelineExecutionRead(ctx, d, meta)
+}
+
+func resourcePipelineExecutionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
+
+	orgId := d.Get("org_id").(string)
+	projectId := d.Get("project_id").(string)
+
+	// The full graph holds the nodes of every stage, so the outputs of all the stages are read from a single call.
+	resp, httpResp, err := c.PipelineExecutionDetailsApi.GetExecutionDetailV2(ctx, c.AccountId, orgId, projectId, d.Id(), &nextgen.PipelineExecutionDetailsApiGetExecutionDetailV2Opts{
+		RenderFullBottomGraph: optional.NewBool(true),
+	})
+
+	if httpResp != nil && httpResp.StatusCode == 404 {
+		d.SetId("")
+		d.MarkNewResource()
+		return nil
+	}
+
+	if err != nil {
+		return helpers.HandleReadApiError(err, d, httpResp)
+	}
+
+	if resp.Data == nil || resp.Data.PipelineExecutionSummary == nil {
+		d.SetId("")
+		d.MarkNewResource()
+		return nil
+	}
+
+	summary := resp.Data.PipelineExecutionSummary
+	d.Set("execution_id", summary.PlanExecutionId)
+	d.Set("pipeline_id", summary.PipelineIdentifier)
+	d.Set("status", summary.Status)
+
+	stages, err := readPipelineExecutionStages(summary, resp.Data.ExecutionGraph)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("stages", stages)
+
+	return nil
+}
+
+func resourcePipelineExecutionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.Get("abort_on_destroy").(bool) {
+		return nil
+	}
+
+	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
+
+	orgId := d.Get("org_id").(string)
+	projectId := d.Get("project_id").(string)
+
+	status, err := getPipelineExecutionStatus(ctx, c, orgId, projectId, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if status != pipelineExecutionRunning {
+		return nil
+	}
+
+	_, httpResp, err := c.PipelineExecuteApi.PutHandleInterrupt(ctx, c.AccountId, orgId, projectId, "AbortAll", d.Id())
+	if err != nil {
+		return helpers.HandleApiError(err, d, httpResp)
+	}
+
+	if _, err := waitForPipelineExecution(ctx, c, orgId, projectId, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for execution %s to be aborted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// waitForPipelineExecution waits until the execution reaches a final status and returns it.
+func waitForPipelineExecution(ctx context.Context, c *nextgen.APIClient, orgId string, projectId string, executionId string, timeout time.Duration) (string, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{pipelineExecutionRunning},
+		Target:     pipelineExecutionFinalStatuses,
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			status, err := getPipelineExecutionStatus(ctx, c, orgId, projectId, executionId)
+			if err != nil {
+				return nil, "", err
+			}
+			return status, status, nil
+		},
+	}
+
+	status, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	return status.(string), nil
+}
+
+// getPipelineExecutionStatus returns the final status of the execution, or pipelineExecutionRunning
+// while the execution is in progress.
+func getPipelineExecutionStatus(ctx context.Context, c *nextgen.APIClient, orgId string, projectId string, executionId string) (string, error) {
+	resp, _, err := c.PipelineExecutionDetailsApi.GetExecutionDetailV2(ctx, c.AccountId, orgId, projectId, executionId, &nextgen.PipelineExecutionDetailsApiGetExecutionDetailV2Opts{})
+	if err != nil {
+		return "", err
+	}
+
+	if resp.Data == nil || resp.Data.PipelineExecutionSummary == nil {
+		return "", fmt.Errorf("execution %s not found", executionId)
+	}
+
+	status := resp.Data.PipelineExecutionSummary.Status
+	for _, s := range pipelineExecutionFinalStatuses {
+		if s == status {
+			return status, nil
+		}
+	}
+
+	log.Printf("[DEBUG] Execution %s is %s", executionId, status)
+	return pipelineExecutionRunning, nil
+}
+
+// readPipelineExecutionStages returns the stages of the execution with the outcomes published
+// by each stage in the execution graph.
+func readPipelineExecutionStages(summary *nextgen.PipelineExecutionSummary, graph *nextgen.ExecutionGraph) ([]interface{}, error) {
+	var nodes []nextgen.GraphLayoutNode
+	for _, node := range summary.LayoutNodeMap {
+		if node.NodeGroup == "STAGE" {
+			nodes = append(nodes, node)
+		}
+	}
+
+	// The layout map isn't ordered, sort the stages by their start time to match the execution.
+	sort.SliceStable(nodes, func(i, j int) bool {
+		if nodes[i].StartTs != nodes[j].StartTs {
+			return nodes[i].StartTs < nodes[j].StartTs
+		}
+		return nodes[i].NodeIdentifier < nodes[j].NodeIdentifier
+	})
+
+	stages := make([]interface{}, 0, len(nodes))
+	for _, node := range nodes {
+		outputs, err := readPipelineExecutionStageOutputs(graph, node)
+		if err != nil {
+			return nil, err
+		}
+
+		stages = append(stages, map[string]interface{}{
+			"identifier": node.NodeIdentifier,
+			"name":       node.Name,
+			"status":     node.Status,
+			"outputs":    outputs,
+		})
+	}
+
+	return stages, nil
+}
+
+func readPipelineExecutionStageOutputs(graph *nextgen.ExecutionGraph, node nextgen.GraphLayoutNode) (map[string]interface{}, error) {
+	outputs := map[string]interface{}{}
+
+	// Stages that haven't started don't have nodes in the execution graph yet.
+	if node.NodeExecutionId == "" || graph == nil {
+		return outputs, nil
+	}
+
+	fqn := "pipeline.stages." + node.NodeIdentifier
+	for _, n := range graph.NodeMap {
+		if n.BaseFqn != fqn {
+			continue
+		}
+
+		for name, outcome := range n.Outcomes {
+			value, err := json.Marshal(outcome)
+			if err != nil {
+				return nil, err
+			}
+			outputs[name] = string(value)
+		}
+	}
+
+	return outputs, nil
+}
+
+func expandInputSetIds(ids []interface{}) []string {
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id != nil {
+			result = append(result, id.(string))
+		}
+	}
+	return result
+}
@@ -0,0 +1,79 @@
+package pipeline_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/harness/harness-go-sdk/harness/utils"
+	"github.com/harness/terraform-provider-harness/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourcePipelineExecution(t *testing.T) {
+	id := fmt.Sprintf("%s_%s", t.Name(), utils.RandStringBytes(6))
+	resourceName := "harness_platform_pipeline_execution.test"
+
+	var executionId string
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.TestAccPreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePipelineExecution(id, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", "Success"),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_id", id),
+					resource.TestCheckResourceAttr(resourceName, "stages.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stages.0.identifier", "custom"),
+					resource.TestCheckResourceAttr(resourceName, "stages.0.status", "Success"),
+					resource.TestCheckResourceAttrPair(resourceName, "id", resourceName, "execution_id"),
+					testAccGetExecutionId(resourceName, &executionId),
+				),
+			},
+			{
+				Config: testAccResourcePipelineExecution(id, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", "Success"),
+					func(state *terraform.State) error {
+						if state.RootModule().Resources[resourceName].Primary.ID == executionId {
+							return fmt.Errorf("expected a new execution after changing the triggers")
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       acctest.ProjectResourceImportStateIdFunc(resourceName),
+				ImportStateVerifyIgnore: []string{"runtime_input_yaml", "triggers"},
+			},
+		},
+	})
+}
+
+func testAccGetExecutionId(resourceName string, executionId *string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		*executionId = state.RootModule().Resources[resourceName].Primary.ID
+		return nil
+	}
+}
+
+func testAccResourcePipelineExecution(id string, run string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+		resource "harness_platform_pipeline_execution" "test" {
+			org_id = harness_platform_pipeline.test.org_id
+			project_id = harness_platform_pipeline.test.project_id
+			pipeline_id = harness_platform_pipeline.test.id
+
+			triggers = {
+				run = "%[2]s"
+			}
+		}
+	`, testAccResourcePipelineTags(id, "foo", "bar"), run)
+}
@@ -2,6 +2,7 @@ package pipeline_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/antihax/optional"
@@ -45,7 +46,7 @@ func TestAccResourcePipeline(t *testing.T) {
 				ImportState:             true,
 				ImportStateVerify:       true,
 				ImportStateIdFunc:       acctest.ProjectResourceImportStateIdFunc(resourceName),
-				ImportStateVerifyIgnore: []string{"git_details.0.commit_message", "git_details.0.connector_ref", "git_details.0.store_type"},
+				ImportStateVerifyIgnore: []string{"git_details.0.commit_message", "git_details.0.connector_ref", "git_details.0.store_type", "yaml_changed_fields"},
 			},
 		},
 	})
@@ -78,10 +79,110 @@ func TestAccResourcePipelineInline(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
-				ImportStateIdFunc: acctest.ProjectResourceImportStateIdFunc(resourceName),
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       acctest.ProjectResourceImportStateIdFunc(resourceName),
+				ImportStateVerifyIgnore: []string{"yaml_changed_fields"},
+			},
+		},
+	})
+}
+
+func TestAccResourcePipeline_moveToRemote(t *testing.T) {
+	id := fmt.Sprintf("%s_%s", t.Name(), utils.RandStringBytes(6))
+	name := id
+
+	resourceName := "harness_platform_pipeline.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.TestAccPreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccPipelineDestroy(resourceName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePipelineInline(id, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", id),
+				),
+			},
+			{
+				Config: testAccResourcePipeline(id, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", id),
+					resource.TestCheckResourceAttr(resourceName, "git_details.0.store_type", "REMOTE"),
+					resource.TestCheckResourceAttr(resourceName, "git_details.0.file_path", fmt.Sprintf(".harness/GitEnabledPipeline%s.yaml", id)),
+					resource.TestCheckResourceAttrSet(resourceName, "git_details.0.last_object_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourcePipeline_tags(t *testing.T) {
+	id := fmt.Sprintf("%s_%s", t.Name(), utils.RandStringBytes(6))
+	resourceName := "harness_platform_pipeline.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.TestAccPreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccPipelineDestroy(resourceName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePipelineTags(id, "foo", "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "tags.*", "foo:bar"),
+				),
+			},
+			{
+				Config: testAccResourcePipelineTags(id, "foo", "baz"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "tags.*", "foo:baz"),
+					// The changed fields are only listed in the plan, they're cleared once the pipeline is updated.
+					resource.TestCheckResourceAttr(resourceName, "yaml_changed_fields.#", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       acctest.ProjectResourceImportStateIdFunc(resourceName),
+				ImportStateVerifyIgnore: []string{"yaml_changed_fields"},
+			},
+		},
+	})
+}
+
+func TestAccResourcePipeline_invalidYaml(t *testing.T) {
+	id := fmt.Sprintf("%s_%s", t.Name(), utils.RandStringBytes(6))
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.TestAccPreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "harness_platform_pipeline" "test" {
+						identifier = "%[1]s"
+						org_id = "default"
+						project_id = "%[1]s"
+						name = "%[1]s"
+						yaml = <<-EOT
+							pipeline:
+							  name: %[1]s
+							  identifier: %[1]s_typo
+							  orgIdentifier: default
+							  stages:
+							    - stag:
+							        name: custom
+							        identifier: custom
+						EOT
+					}
+				`, id),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`line 3: pipeline.identifier: "[^"]+_typo" does not match`),
 			},
 		},
 	})
@@ -291,6 +392,58 @@ func testAccResourcePipeline(id string, name string) string {
         `, id, name)
 }
 
+func testAccResourcePipelineTags(id string, tagKey string, tagValue string) string {
+	return fmt.Sprintf(`
+		resource "harness_platform_organization" "test" {
+			identifier = "%[1]s"
+			name = "%[1]s"
+		}
+
+		resource "harness_platform_project" "test" {
+			identifier = "%[1]s"
+			name = "%[1]s"
+			org_id = harness_platform_organization.test.id
+		}
+
+		resource "harness_platform_pipeline" "test" {
+			identifier = "%[1]s"
+			org_id = harness_platform_project.test.org_id
+			project_id = harness_platform_project.test.id
+			name = "%[1]s"
+			tags = ["%[2]s:%[3]s"]
+			yaml = <<-EOT
+				pipeline:
+				  name: %[1]s
+				  identifier: %[1]s
+				  projectIdentifier: ${harness_platform_project.test.id}
+				  orgIdentifier: ${harness_platform_project.test.org_id}
+				  tags:
+				    %[2]s: %[3]s
+				  stages:
+				    - stage:
+				        name: custom
+				        identifier: custom
+				        type: Custom
+				        spec:
+				          execution:
+				            steps:
+				              - step:
+				                  name: echo
+				                  identifier: echo
+				                  type: ShellScript
+				                  timeout: 10m
+				                  spec:
+				                    shell: Bash
+				                    onDelegate: true
+				                    source:
+				                      type: Inline
+				                      spec:
+				                        script: echo hello
+			EOT
+		}
+	`, id, tagKey, tagValue)
+}
+
 func testAccResourcePipelineInline(id string, name string) string {
 	return fmt.Sprintf(`
 				resource "harness_platform_organization" "test" {
@@ -0,0 +1,59 @@
+package pipeline
+
+import (
+	"fmt"
+
+	"github.com/antihax/optional"
+	nextgen "github.com/harness/harness-openapi-go-client/nextgen"
+	"github.com/harness/terraform-provider-harness/internal/sweep"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func init() {
+	resource.AddTestSweepers("harness_platform_pipeline", &resource.Sweeper{
+		Name: "harness_platform_pipeline",
+		F:    testSweepPipelines,
+	})
+}
+
+func testSweepPipelines(r string) error {
+	c, ctx := sweep.GetClientWithContext()
+
+	projects, err := sweep.ListProjectScopes()
+	if err != nil {
+		return err
+	}
+
+	for _, project := range projects {
+		for page := int32(0); ; page++ {
+			pipelines, _, err := c.PipelinesApi.ListPipelines(ctx, project.Org, project.Project, &nextgen.PipelinesApiListPipelinesOpts{
+				HarnessAccount: optional.NewString(c.AccountId),
+				Page:           optional.NewInt32(page),
+				Limit:          optional.NewInt32(sweep.PageSize),
+			})
+			if err != nil {
+				return err
+			}
+
+			for _, pipeline := range pipelines {
+				// Only delete pipelines whose identifier or name starts with the sweep prefix
+				if !sweep.IsSweepable(pipeline.Identifier, pipeline.Name) {
+					continue
+				}
+
+				_, err := c.PipelinesApi.DeletePipeline(ctx, project.Org, project.Project, pipeline.Identifier, &nextgen.PipelinesApiDeletePipelineOpts{
+					HarnessAccount: optional.NewString(c.AccountId),
+				})
+				if err != nil {
+					fmt.Printf("[ERROR] Failed to delete pipeline %s: %s", pipeline.Identifier, err)
+				}
+			}
+
+			if len(pipelines) < sweep.PageSize {
+				break
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package project
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/antihax/optional"
+	"github.com/harness/harness-go-sdk/harness/nextgen"
+	"github.com/harness/terraform-provider-harness/helpers"
+	"github.com/harness/terraform-provider-harness/internal"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceProjectList() *schema.Resource {
+	resource := &schema.Resource{
+		Description: "Data source for listing Harness projects. Every page of results is fetched.",
+
+		ReadContext: dataSourceProjectListRead,
+
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Description: "Unique identifier of the organization. When not set the projects of every organization are returned.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"module_type": {
+				Description: "Filter the projects by module, e.g. `CD` or `CI`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"projects": {
+				Description: "List of projects matching the filters.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identifier": {
+							Description: "Unique identifier of the project.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"org_id": {
+							Description: "Unique identifier of the organization.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the project.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "Description of the project.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"color": {
+							Description: "Color of the project.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"modules": {
+							Description: "Modules in the project.",
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"tags": {
+							Description: "Tags associated with the project.",
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	helpers.SetListDataSourceFilterSchema(resource.Schema)
+
+	return resource
+}
+
+func dataSourceProjectListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
+
+	opts := &nextgen.ProjectApiGetProjectListOpts{
+		OrgIdentifier: helpers.BuildField(d, "org_id"),
+		ModuleType:    helpers.BuildField(d, "module_type"),
+		SearchTerm:    helpers.BuildField(d, "search_term"),
+		PageSize:      optional.NewInt32(helpers.ListPageSize),
+	}
+	if _, ok := d.GetOk("module_type"); ok {
+		opts.HasModule = optional.NewBool(true)
+	}
+
+	projects := []interface{}{}
+	httpResp, err := helpers.ListAllPages(func(page int32) (bool, *http.Response, error) {
+		opts.PageIndex = optional.NewInt32(page)
+
+		resp, httpResp, err := c.ProjectApi.GetProjectList(ctx, c.AccountId, opts)
+		if err != nil {
+			return false, httpResp, err
+		}
+
+		if resp.Data == nil {
+			return false, httpResp, nil
+		}
+
+		for _, v := range resp.Data.Content {
+			if v.Project == nil || !helpers.MatchesFilterTags(d, v.Project.Tags) {
+				continue
+			}
+
+			projects = append(projects, map[string]interface{}{
+				"identifier":  v.Project.Identifier,
+				"org_id":      v.Project.OrgIdentifier,
+				"name":        v.Project.Name,
+				"description": v.Project.Description,
+				"color":       v.Project.Color,
+				"modules":     v.Project.Modules,
+				"tags":        helpers.FlattenTags(v.Project.Tags),
+			})
+		}
+
+		return helpers.HasMorePages(page, resp.Data.TotalPages), httpResp, nil
+	})
+	if err != nil {
+		return helpers.HandleApiError(err, d, httpResp)
+	}
+
+	d.SetId(helpers.ListDataSourceId(c.AccountId, d, "org_id", "module_type", "search_term", "filter_tags"))
+	d.Set("projects", projects)
+
+	return nil
+}
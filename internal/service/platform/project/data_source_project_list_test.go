@@ -0,0 +1,55 @@
+package project_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/harness/harness-go-sdk/harness/utils"
+	"github.com/harness/terraform-provider-harness/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceProjectList(t *testing.T) {
+
+	id := fmt.Sprintf("%s_%s", t.Name(), utils.RandStringBytes(5))
+	resourceName := "data.harness_platform_project_list.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.TestAccPreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceProjectList(id),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "projects.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "projects.0.identifier", id),
+					resource.TestCheckResourceAttr(resourceName, "projects.0.org_id", id),
+					resource.TestCheckResourceAttr(resourceName, "projects.0.color", "#472848"),
+					resource.TestCheckResourceAttr(resourceName, "projects.0.tags.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceProjectList(id string) string {
+	return fmt.Sprintf(`
+		resource "harness_platform_organization" "test" {
+			identifier = "%[1]s"
+			name = "%[1]s"
+		}
+
+		resource "harness_platform_project" "test" {
+			identifier = "%[1]s"
+			name = "%[1]s"
+			org_id = harness_platform_organization.test.id
+			color = "#472848"
+			tags = ["foo:bar"]
+		}
+
+		data "harness_platform_project_list" "test" {
+			org_id = harness_platform_project.test.org_id
+			filter_tags = ["foo:bar"]
+		}
+	`, id)
+}
@@ -0,0 +1,49 @@
+package project
+
+import (
+	"fmt"
+
+	"github.com/antihax/optional"
+	openapi_client_nextgen "github.com/harness/harness-openapi-go-client/nextgen"
+	"github.com/harness/terraform-provider-harness/internal/sweep"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func init() {
+	resource.AddTestSweepers("harness_platform_project", &resource.Sweeper{
+		Name: "harness_platform_project",
+		F:    testSweepProjects,
+		Dependencies: []string{
+			"harness_platform_pipeline",
+			"harness_platform_connector",
+			"harness_platform_secret",
+			"harness_platform_feature_flag",
+			"harness_platform_gitops_agent",
+		},
+	})
+}
+
+func testSweepProjects(r string) error {
+	c, ctx := sweep.GetClientWithContext()
+
+	projects, err := sweep.ListProjectScopes()
+	if err != nil {
+		return err
+	}
+
+	for _, project := range projects {
+		// Only delete projects whose identifier starts with the sweep prefix
+		if !sweep.IsSweepable(project.Project) {
+			continue
+		}
+
+		_, _, err := c.OrgProjectApi.DeleteOrgScopedProject(ctx, project.Org, project.Project, &openapi_client_nextgen.OrgProjectApiDeleteOrgScopedProjectOpts{
+			HarnessAccount: optional.NewString(c.AccountId),
+		})
+		if err != nil {
+			fmt.Printf("[ERROR] Failed to delete project %s/%s: %s", project.Org, project.Project, err)
+		}
+	}
+
+	return nil
+}
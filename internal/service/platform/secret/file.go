@@ -37,12 +37,13 @@ func ResourceSecretFile() *schema.Resource {
 		},
 	}
 	helpers.SetMultiLevelResourceSchema(resource.Schema)
+	setSecretTimestampsSchema(resource.Schema)
 
 	return resource
 }
 
 func resourceSecretFileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	secret, err := resourceSecretReadBase(ctx, d, meta, nextgen.SecretTypes.SecretFile)
+	secret, err := resourceSecretReadBase(ctx, d, meta, nextgen.SecretTypes.SecretFile, resetSecretFileContent)
 	if err != nil {
 		return err
 	}
@@ -83,6 +84,7 @@ func resourceSecretFileCreateOrUpdate(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
+	readSecretTimestamps(d, resp.Data)
 	if err := readSecretFile(d, resp.Data.Secret); err != nil {
 		return diag.FromErr(err)
 	}
@@ -148,6 +150,12 @@ func buildTag(no_of_tags int, tags *schema.Set) string {
 	return result
 }
 
+// resetSecretFileContent clears the path of the file after the secret was updated outside of Terraform
+// so that the next apply uploads the configured file again.
+func resetSecretFileContent(d *schema.ResourceData) {
+	d.Set("file_path", "")
+}
+
 func readSecretFile(d *schema.ResourceData, secret *nextgen.Secret) error {
 	if secret == nil {
 		return nil
@@ -0,0 +1,174 @@
+package secret
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	rotationVersionPlaceholder = "{version}"
+
+	alphanumericCharacters = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+	specialCharacters      = "!#$%&*()-_=+[]{}<>:?"
+)
+
+func getRotationSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Rotate the value of the secret. A new value is generated on creation and then on the first apply after `rotation_days` have elapsed since the last rotation. The generated value is never stored in the Terraform state.",
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"rotation_days": {
+					Description:  "Number of days after which the value is rotated.",
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"random": {
+					Description:  "Generate a random value. Only valid when `value_type` is `Inline`.",
+					Type:         schema.TypeList,
+					Optional:     true,
+					MaxItems:     1,
+					ExactlyOneOf: []string{"rotation.0.random", "rotation.0.reference"},
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"length": {
+								Description:  "Length of the generated value.",
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Default:      32,
+								ValidateFunc: validation.IntBetween(8, 256),
+							},
+							"special": {
+								Description: "Include special characters in the generated value.",
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     true,
+							},
+						},
+					},
+				},
+				"reference": {
+					Description: "Reference a version of a secret stored in the external secret manager. Only valid when `value_type` is `Reference`.",
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"path": {
+								Description: fmt.Sprintf("Path of the secret in the secret manager. `%s` is replaced by `rotation_version`, e.g. `vault/my-secret#%s`.", rotationVersionPlaceholder, rotationVersionPlaceholder),
+								Type:        schema.TypeString,
+								Required:    true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// setRotationSchema adds the `rotation` block and the attributes tracking the rotations to the schema of a secret.
+// The tracking attributes are top level attributes since only those can be set while computing the diff.
+func setRotationSchema(s map[string]*schema.Schema) {
+	s["rotation"] = getRotationSchema()
+	s["rotation_version"] = &schema.Schema{
+		Description: "Number of times the value was rotated.",
+		Type:        schema.TypeInt,
+		Computed:    true,
+	}
+	s["rotated_at"] = &schema.Schema{
+		Description: "Time of the last rotation, in milliseconds.",
+		Type:        schema.TypeInt,
+		Computed:    true,
+	}
+	s["next_rotation_at"] = &schema.Schema{
+		Description: "Time after which the next apply rotates the value, in milliseconds.",
+		Type:        schema.TypeInt,
+		Computed:    true,
+	}
+}
+
+// customizeRotationDiff plans a rotation when the secret is created, when the rotation settings change or when
+// the rotation window has elapsed.
+func customizeRotationDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if len(d.Get("rotation").([]interface{})) == 0 {
+		return nil
+	}
+
+	switch valueType := d.Get("value_type").(string); {
+	case valueType == "Inline" && d.Get("rotation.0.reference.#").(int) > 0:
+		return fmt.Errorf("rotation.0.reference can't be used with an Inline secret, use rotation.0.random")
+	case valueType == "Reference" && d.Get("rotation.0.random.#").(int) > 0:
+		return fmt.Errorf("rotation.0.random can't be used with a Reference secret, use rotation.0.reference")
+	}
+
+	rotatedAt := int64(d.Get("rotated_at").(int))
+	window := time.Duration(d.Get("rotation.0.rotation_days").(int)) * 24 * time.Hour
+
+	due := d.Id() == "" || rotatedAt == 0 || d.HasChange("rotation.0.random") || d.HasChange("rotation.0.reference") ||
+		!time.Now().Before(time.UnixMilli(rotatedAt).Add(window))
+
+	if !due {
+		if d.HasChange("rotation.0.rotation_days") {
+			return d.SetNew("next_rotation_at", time.UnixMilli(rotatedAt).Add(window).UnixMilli())
+		}
+		return nil
+	}
+
+	if err := d.SetNew("rotation_version", d.Get("rotation_version").(int)+1); err != nil {
+		return err
+	}
+	if err := d.SetNewComputed("rotated_at"); err != nil {
+		return err
+	}
+	return d.SetNewComputed("next_rotation_at")
+}
+
+// rotateValue returns the value of the current rotation and records the time of the rotation.
+func rotateValue(d *schema.ResourceData) (string, error) {
+	var value string
+	if d.Get("rotation.0.reference.#").(int) > 0 {
+		value = strings.ReplaceAll(d.Get("rotation.0.reference.0.path").(string), rotationVersionPlaceholder, strconv.Itoa(d.Get("rotation_version").(int)))
+	} else {
+		characters := alphanumericCharacters
+		if d.Get("rotation.0.random.0.special").(bool) {
+			characters += specialCharacters
+		}
+
+		var err error
+		value, err = randomString(d.Get("rotation.0.random.0.length").(int), characters)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate the value of the secret: %w", err)
+		}
+	}
+
+	now := time.Now()
+	d.Set("rotated_at", now.UnixMilli())
+	d.Set("next_rotation_at", now.Add(time.Duration(d.Get("rotation.0.rotation_days").(int))*24*time.Hour).UnixMilli())
+
+	return value, nil
+}
+
+func randomString(length int, characters string) (string, error) {
+	max := big.NewInt(int64(len(characters)))
+	b := make([]byte, length)
+	for i := range b {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b[i] = characters[n.Int64()]
+	}
+	return string(b), nil
+}
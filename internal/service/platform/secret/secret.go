@@ -3,6 +3,7 @@ package secret
 import (
 	"context"
 	"fmt"
+	"log"
 	"net/http"
 
 	"github.com/antihax/optional"
@@ -15,7 +16,10 @@ import (
 
 type ReadSecretData func(*schema.ResourceData, *nextgen.Secret) error
 
-func resourceSecretReadBase(ctx context.Context, d *schema.ResourceData, meta interface{}, secretType nextgen.SecretType) (*nextgen.Secret, diag.Diagnostics) {
+// resourceSecretReadBase reads a secret and its common attributes. onDrift, if not nil, is called when the secret
+// was updated outside of Terraform so that the resource can reset the attributes that can't be read back. It isn't
+// called when the update changed the metadata of the secret, as those are edits of the metadata only.
+func resourceSecretReadBase(ctx context.Context, d *schema.ResourceData, meta interface{}, secretType nextgen.SecretType, onDrift func(*schema.ResourceData)) (*nextgen.Secret, diag.Diagnostics) {
 	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
 
 	id := d.Id()
@@ -36,6 +40,15 @@ func resourceSecretReadBase(ctx context.Context, d *schema.ResourceData, meta in
 		return nil, diag.FromErr(fmt.Errorf("expected secret to be of type %s, but got %s", secretType, resp.Data.Secret.Type_))
 	}
 
+	if readSecretTimestamps(d, resp.Data) && onDrift != nil {
+		if secretMetadataChanged(d, resp.Data.Secret) {
+			log.Printf("[INFO] The metadata of secret %s was updated outside of Terraform", id)
+		} else {
+			log.Printf("[WARN] Secret %s was updated outside of Terraform", id)
+			onDrift(d)
+		}
+	}
+
 	readCommonSecretData(d, resp.Data.Secret)
 
 	return resp.Data.Secret, nil
@@ -76,6 +89,7 @@ func resourceSecretCreateOrUpdateBase(ctx context.Context, d *schema.ResourceDat
 		return nil, helpers.HandleApiError(err, d, httpResp)
 	}
 
+	readSecretTimestamps(d, resp.Data)
 	readCommonSecretData(d, resp.Data.Secret)
 
 	return resp.Data.Secret, nil
@@ -128,6 +142,51 @@ func buildSecret(d *schema.ResourceData, secret *nextgen.Secret) {
 	}
 }
 
+// setSecretTimestampsSchema adds the timestamps of the secret to the schema of a secret resource.
+func setSecretTimestampsSchema(s map[string]*schema.Schema) {
+	s["created_at"] = &schema.Schema{
+		Description: "Creation time of the secret, in milliseconds.",
+		Type:        schema.TypeInt,
+		Computed:    true,
+	}
+	s["updated_at"] = &schema.Schema{
+		Description: "Time of the last update of the secret, in milliseconds. Updates made outside of Terraform are reported as a change of this attribute.",
+		Type:        schema.TypeInt,
+		Computed:    true,
+	}
+}
+
+// readSecretTimestamps sets the timestamps of a secret and reports whether it was updated since it was
+// last applied or read, i.e. outside of Terraform.
+func readSecretTimestamps(d *schema.ResourceData, secret *nextgen.SecretResponseWrapper) bool {
+	lastUpdatedAt := int64(d.Get("updated_at").(int))
+
+	d.Set("created_at", secret.CreatedAt)
+	d.Set("updated_at", secret.UpdatedAt)
+
+	return lastUpdatedAt != 0 && secret.UpdatedAt > lastUpdatedAt
+}
+
+// secretMetadataChanged reports whether the name, description or tags of the secret differ from the state. The
+// API doesn't tell which fields an update changed, so an update that left the metadata as is is taken as an update
+// of the value.
+func secretMetadataChanged(d *schema.ResourceData, secret *nextgen.Secret) bool {
+	if secret.Name != d.Get("name").(string) || secret.Description != d.Get("description").(string) {
+		return true
+	}
+
+	tags := helpers.ExpandTags(d.Get("tags").(*schema.Set).List())
+	if len(tags) != len(secret.Tags) {
+		return true
+	}
+	for k, v := range tags {
+		if value, ok := secret.Tags[k]; !ok || value != v {
+			return true
+		}
+	}
+	return false
+}
+
 func readCommonSecretData(d *schema.ResourceData, secret *nextgen.Secret) {
 	d.SetId(secret.Identifier)
 	d.Set("identifier", secret.Identifier)
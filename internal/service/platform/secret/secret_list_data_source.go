@@ -0,0 +1,141 @@
+package secret
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/antihax/optional"
+	"github.com/harness/harness-go-sdk/harness/nextgen"
+	"github.com/harness/terraform-provider-harness/helpers"
+	"github.com/harness/terraform-provider-harness/internal"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceSecretList() *schema.Resource {
+	resource := &schema.Resource{
+		Description: "Data source for listing the Harness secrets of an account, organization or project. Secret values are never returned. Every page of results is fetched.",
+
+		ReadContext: dataSourceSecretListRead,
+
+		Schema: map[string]*schema.Schema{
+			"types": {
+				Description: "Filter the secrets by type, e.g. `SecretText`, `SecretFile`, `SSHKey` or `WinRmCredentials`.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"secrets": {
+				Description: "List of secrets matching the filters.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identifier": {
+							Description: "Unique identifier of the secret.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the secret.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "Description of the secret.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"org_id": {
+							Description: "Unique identifier of the organization.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"project_id": {
+							Description: "Unique identifier of the project.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"tags": {
+							Description: "Tags associated with the secret.",
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"type": {
+							Description: "Type of the secret.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	helpers.SetOptionalOrgAndProjectLevelDataSourceSchema(resource.Schema)
+	helpers.SetListDataSourceFilterSchema(resource.Schema)
+
+	return resource
+}
+
+func dataSourceSecretListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
+
+	opts := &nextgen.SecretsApiListSecretsV2Opts{
+		OrgIdentifier:     buildField(d, "org_id"),
+		ProjectIdentifier: buildField(d, "project_id"),
+		SearchTerm:        buildField(d, "search_term"),
+		PageSize:          optional.NewInt32(helpers.ListPageSize),
+	}
+
+	types := map[string]bool{}
+	for _, t := range d.Get("types").(*schema.Set).List() {
+		types[t.(string)] = true
+	}
+
+	secrets := []interface{}{}
+	httpResp, err := helpers.ListAllPages(func(page int32) (bool, *http.Response, error) {
+		opts.PageIndex = optional.NewInt32(page)
+
+		resp, httpResp, err := c.SecretsApi.ListSecretsV2(ctx, c.AccountId, opts)
+		if err != nil {
+			return false, httpResp, err
+		}
+
+		if resp.Data == nil {
+			return false, httpResp, nil
+		}
+
+		for _, v := range resp.Data.Content {
+			secret := v.Secret
+			if secret == nil || !helpers.MatchesFilterTags(d, secret.Tags) {
+				continue
+			}
+
+			if len(types) > 0 && !types[secret.Type_.String()] {
+				continue
+			}
+
+			secrets = append(secrets, map[string]interface{}{
+				"identifier":  secret.Identifier,
+				"name":        secret.Name,
+				"description": secret.Description,
+				"org_id":      secret.OrgIdentifier,
+				"project_id":  secret.ProjectIdentifier,
+				"tags":        helpers.FlattenTags(secret.Tags),
+				"type":        secret.Type_.String(),
+			})
+		}
+
+		return helpers.HasMorePages(page, resp.Data.TotalPages), httpResp, nil
+	})
+	if err != nil {
+		return helpers.HandleApiError(err, d, httpResp)
+	}
+
+	d.SetId(helpers.ListDataSourceId(c.AccountId, d, "org_id", "project_id", "types", "search_term", "filter_tags"))
+	d.Set("secrets", secrets)
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package secret_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/harness/harness-go-sdk/harness/utils"
+	"github.com/harness/terraform-provider-harness/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSecretList(t *testing.T) {
+	var (
+		name        = fmt.Sprintf("%s_%s", t.Name(), utils.RandStringBytes(4))
+		secretValue = fmt.Sprintf("%s_%s", t.Name(), utils.RandStringBytes(5))
+
+		resourceName = "data.harness_platform_secret_list.test"
+	)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.TestAccPreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSecretList(name, secretValue),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "secrets.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "secrets.0.identifier", name),
+					resource.TestCheckResourceAttr(resourceName, "secrets.0.description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "secrets.0.type", "SecretText"),
+					resource.TestCheckResourceAttr(resourceName, "secrets.0.tags.#", "1"),
+					resource.TestCheckResourceAttr("data.harness_platform_secret_list.files", "secrets.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSecretList(name string, secretValue string) string {
+	return fmt.Sprintf(`
+		resource "harness_platform_secret_text" "test" {
+			identifier = "%[1]s"
+			name = "%[1]s"
+			description = "test"
+			tags = ["foo:bar"]
+
+			secret_manager_identifier = "harnessSecretManager"
+			value_type = "Inline"
+			value = "%[2]s"
+		}
+
+		data "harness_platform_secret_list" "test" {
+			search_term = harness_platform_secret_text.test.identifier
+			types = ["SecretText"]
+			filter_tags = ["foo:bar"]
+		}
+
+		data "harness_platform_secret_list" "files" {
+			search_term = harness_platform_secret_text.test.identifier
+			types = ["SecretFile"]
+		}
+`, name, secretValue)
+}
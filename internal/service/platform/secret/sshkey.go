@@ -176,12 +176,13 @@ func ResourceSecretSSHKey() *schema.Resource {
 		},
 	}
 	helpers.SetMultiLevelResourceSchema(resource.Schema)
+	setSecretTimestampsSchema(resource.Schema)
 
 	return resource
 }
 
 func resourceSecretSSHKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	secret, err := resourceSecretReadBase(ctx, d, meta, nextgen.SecretTypes.SSHKey)
+	secret, err := resourceSecretReadBase(ctx, d, meta, nextgen.SecretTypes.SSHKey, nil)
 	if err != nil {
 		return err
 	}
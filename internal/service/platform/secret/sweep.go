@@ -0,0 +1,107 @@
+package secret
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/antihax/optional"
+	openapi_client_nextgen "github.com/harness/harness-openapi-go-client/nextgen"
+	"github.com/harness/terraform-provider-harness/internal/sweep"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func init() {
+	resource.AddTestSweepers("harness_platform_secret", &resource.Sweeper{
+		Name:         "harness_platform_secret",
+		F:            testSweepSecrets,
+		Dependencies: []string{"harness_platform_connector"},
+	})
+}
+
+func testSweepSecrets(r string) error {
+	c, ctx := sweep.GetClientWithContext()
+
+	scopes, err := sweep.ListScopes()
+	if err != nil {
+		return err
+	}
+
+	for _, scope := range scopes {
+		for page := int32(0); ; page++ {
+			secrets, err := listSecrets(ctx, c, scope, page)
+			if err != nil {
+				return err
+			}
+
+			for _, secret := range secrets {
+				if secret.Secret == nil {
+					continue
+				}
+
+				// Only delete secrets whose identifier or name starts with the sweep prefix
+				if !sweep.IsSweepable(secret.Secret.Slug, secret.Secret.Name) {
+					continue
+				}
+
+				if err := deleteSecret(ctx, c, scope, secret.Secret.Slug); err != nil {
+					fmt.Printf("[ERROR] Failed to delete secret %s: %s", secret.Secret.Slug, err)
+				}
+			}
+
+			if len(secrets) < sweep.PageSize {
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+func listSecrets(ctx context.Context, c *openapi_client_nextgen.APIClient, scope sweep.Scope, page int32) ([]openapi_client_nextgen.SecretResponse, error) {
+	var resp []openapi_client_nextgen.SecretResponse
+	var err error
+
+	switch {
+	case scope.Project != "":
+		resp, _, err = c.ProjectSecretApi.GetProjectScopedSecrets(ctx, scope.Org, scope.Project, &openapi_client_nextgen.ProjectSecretApiGetProjectScopedSecretsOpts{
+			HarnessAccount: optional.NewString(c.AccountId),
+			Page:           optional.NewInt32(page),
+			Limit:          optional.NewInt32(sweep.PageSize),
+		})
+	case scope.Org != "":
+		resp, _, err = c.OrgSecretApi.GetOrgScopedSecrets(ctx, scope.Org, &openapi_client_nextgen.OrgSecretApiGetOrgScopedSecretsOpts{
+			HarnessAccount: optional.NewString(c.AccountId),
+			Page:           optional.NewInt32(page),
+			Limit:          optional.NewInt32(sweep.PageSize),
+		})
+	default:
+		resp, _, err = c.AccountSecretApi.GetAccountScopedSecrets(ctx, &openapi_client_nextgen.AccountSecretApiGetAccountScopedSecretsOpts{
+			HarnessAccount: optional.NewString(c.AccountId),
+			Page:           optional.NewInt32(page),
+			Limit:          optional.NewInt32(sweep.PageSize),
+		})
+	}
+
+	return resp, err
+}
+
+func deleteSecret(ctx context.Context, c *openapi_client_nextgen.APIClient, scope sweep.Scope, id string) error {
+	var err error
+
+	switch {
+	case scope.Project != "":
+		_, _, err = c.ProjectSecretApi.DeleteProjectScopedSecret(ctx, scope.Org, scope.Project, id, &openapi_client_nextgen.ProjectSecretApiDeleteProjectScopedSecretOpts{
+			HarnessAccount: optional.NewString(c.AccountId),
+		})
+	case scope.Org != "":
+		_, _, err = c.OrgSecretApi.DeleteOrgScopedSecret(ctx, scope.Org, id, &openapi_client_nextgen.OrgSecretApiDeleteOrgScopedSecretOpts{
+			HarnessAccount: optional.NewString(c.AccountId),
+		})
+	default:
+		_, _, err = c.AccountSecretApi.DeleteAccountScopedSecret(ctx, id, &openapi_client_nextgen.AccountSecretApiDeleteAccountScopedSecretOpts{
+			HarnessAccount: optional.NewString(c.AccountId),
+		})
+	}
+
+	return err
+}
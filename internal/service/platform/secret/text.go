@@ -17,6 +17,7 @@ func ResourceSecretText() *schema.Resource {
 		CreateContext: resourceSecretTextCreateOrUpdate,
 		UpdateContext: resourceSecretTextCreateOrUpdate,
 		DeleteContext: resourceSecretDelete,
+		CustomizeDiff: customizeRotationDiff,
 		Importer:      helpers.MultiLevelResourceImporter,
 
 		Schema: map[string]*schema.Schema{
@@ -33,10 +34,24 @@ func ResourceSecretText() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{"Reference", "Inline"}, false),
 			},
 			"value": {
-				Description: "Value of the Secret",
+				Description:  "Value of the Secret. The value is stored in the Terraform state, use `value_wo` or `rotation` to keep it out of the state.",
+				Sensitive:    true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"value", "value_wo", "rotation"},
+			},
+			"value_wo": {
+				Description: "Write-only value of the Secret. The value is sent to Harness but never stored in the Terraform state, so changes to it are not detected: increment `value_wo_version` to update the secret with the current value.",
 				Sensitive:   true,
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				StateFunc:   helpers.WriteOnlyStateFunc,
+			},
+			"value_wo_version": {
+				Description:  "Version of `value_wo`. Changing it updates the secret with the current value of `value_wo`.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"value_wo"},
 			},
 			"additional_metadata": {
 				Description: "Additional Metadata for the Secret",
@@ -64,12 +79,14 @@ func ResourceSecretText() *schema.Resource {
 		},
 	}
 	helpers.SetMultiLevelResourceSchema(resource.Schema)
+	setSecretTimestampsSchema(resource.Schema)
+	setRotationSchema(resource.Schema)
 
 	return resource
 }
 
 func resourceSecretTextRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	secret, err := resourceSecretReadBase(ctx, d, meta, nextgen.SecretTypes.SecretText)
+	secret, err := resourceSecretReadBase(ctx, d, meta, nextgen.SecretTypes.SecretText, resetSecretTextValue)
 	if err != nil {
 		return err
 	}
@@ -82,7 +99,10 @@ func resourceSecretTextRead(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceSecretTextCreateOrUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	secret := buildSecretText(d)
+	secret, buildErr := buildSecretText(d)
+	if buildErr != nil {
+		return diag.FromErr(buildErr)
+	}
 
 	newSecret, err := resourceSecretCreateOrUpdateBase(ctx, d, meta, secret)
 	if err != nil {
@@ -96,7 +116,7 @@ func resourceSecretTextCreateOrUpdate(ctx context.Context, d *schema.ResourceDat
 	return nil
 }
 
-func buildSecretText(d *schema.ResourceData) *nextgen.Secret {
+func buildSecretText(d *schema.ResourceData) (*nextgen.Secret, error) {
 	secret := &nextgen.Secret{
 		Type_: nextgen.SecretTypes.SecretText,
 		Text:  &nextgen.SecretTextSpec{},
@@ -114,22 +134,47 @@ func buildSecretText(d *schema.ResourceData) *nextgen.Secret {
 		secret.Text.Value = attr.(string)
 	}
 
+	if attr := helpers.GetWriteOnlyString(d, "value_wo"); attr != "" {
+		secret.Text.Value = attr
+	}
+
+	if len(d.Get("rotation").([]interface{})) > 0 && d.HasChange("rotation_version") {
+		value, err := rotateValue(d)
+		if err != nil {
+			return nil, err
+		}
+		secret.Text.Value = value
+	}
+
 	if attr, ok := d.GetOk("additional_metadata"); ok {
 		secret.Text.AdditionalMetadata = readAdditionalMetadata(attr)
 	}
 
-	return secret
+	return secret, nil
+}
+
+// resetSecretTextValue resets the attributes tracking the inline value after the secret was updated outside of
+// Terraform, since the value can't be read back, so that the next apply sets the configured value again.
+func resetSecretTextValue(d *schema.ResourceData) {
+	if d.Get("value_type").(string) != "Inline" {
+		return
+	}
+
+	d.Set("value", "")
+	d.Set("value_wo_version", 0)
+	d.Set("rotated_at", 0)
 }
 
 func readSecretText(d *schema.ResourceData, secret *nextgen.Secret) error {
 	if secret == nil {
 		return nil
 	}
-	d.Set("secret_manager_identifier", secret.Text.SecretManagerIdentifier)
-	d.Set("value_type", secret.Text.ValueType)
-	if secret.Text.ValueType == "Reference" {
+	// A reference is only read back when it is set with `value`, or on import.
+	if secret.Text.ValueType == "Reference" && (d.Get("value").(string) != "" || d.Get("value_type").(string) == "") {
 		d.Set("value", secret.Text.Value)
 	}
+	d.Set("secret_manager_identifier", secret.Text.SecretManagerIdentifier)
+	d.Set("value_type", secret.Text.ValueType)
 	if secret.Text.AdditionalMetadata.Values != nil {
 		d.Set("additional_metadata", importAdditionalMetadata_2(&secret.Text.AdditionalMetadata))
 	}
@@ -60,6 +60,84 @@ func TestAccSecretText_inline(t *testing.T) {
 	})
 }
 
+func TestAccSecretText_writeOnly(t *testing.T) {
+
+	id := fmt.Sprintf("%s_%s", t.Name(), utils.RandStringBytes(5))
+	name := id
+	secretValue := fmt.Sprintf("%s_%s", t.Name(), utils.RandStringBytes(5))
+	updatedValue := secretValue + "updated"
+	resourceName := "harness_platform_secret_text.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.TestAccPreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccSecretDestroy(resourceName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecret_text_writeOnly(id, name, secretValue, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", id),
+					resource.TestCheckResourceAttr(resourceName, "value_type", "Inline"),
+					resource.TestCheckResourceAttr(resourceName, "value_wo", ""),
+					resource.TestCheckResourceAttr(resourceName, "value_wo_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "value", ""),
+				),
+			},
+			{
+				Config:             testAccResourceSecret_text_writeOnly(id, name, updatedValue, 1),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				Config: testAccResourceSecret_text_writeOnly(id, name, updatedValue, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", id),
+					resource.TestCheckResourceAttr(resourceName, "value_wo", ""),
+					resource.TestCheckResourceAttr(resourceName, "value_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSecretText_rotation(t *testing.T) {
+
+	id := fmt.Sprintf("%s_%s", t.Name(), utils.RandStringBytes(5))
+	name := id
+	resourceName := "harness_platform_secret_text.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.TestAccPreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccSecretDestroy(resourceName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecret_text_rotation(id, name, 30, 24),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", id),
+					resource.TestCheckResourceAttr(resourceName, "value", ""),
+					resource.TestCheckResourceAttr(resourceName, "rotation_version", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "rotated_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "next_rotation_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "updated_at"),
+				),
+			},
+			{
+				Config:             testAccResourceSecret_text_rotation(id, name, 60, 24),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccResourceSecret_text_rotation(id, name, 30, 48),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rotation_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceSecretText_reference(t *testing.T) {
 	t.Skip()
 	id := fmt.Sprintf("%s_%s", t.Name(), utils.RandStringBytes(5))
@@ -316,6 +394,43 @@ func testAccResourceSecret_text_inline(id string, name string, secretValue strin
 `, id, name, secretValue)
 }
 
+func testAccResourceSecret_text_writeOnly(id string, name string, secretValue string, version int) string {
+	return fmt.Sprintf(`
+		resource "harness_platform_secret_text" "test" {
+			identifier = "%[1]s"
+			name = "%[2]s"
+			description = "test"
+			tags = ["foo:bar"]
+			secret_manager_identifier = "harnessSecretManager"
+			value_type = "Inline"
+			value_wo = "%[3]s"
+			value_wo_version = %[4]d
+		}
+`, id, name, secretValue, version)
+}
+
+func testAccResourceSecret_text_rotation(id string, name string, days int, length int) string {
+	return fmt.Sprintf(`
+		resource "harness_platform_secret_text" "test" {
+			identifier = "%[1]s"
+			name = "%[2]s"
+			description = "test"
+			tags = ["foo:bar"]
+			secret_manager_identifier = "harnessSecretManager"
+			value_type = "Inline"
+
+			rotation {
+				rotation_days = %[3]d
+
+				random {
+					length = %[4]d
+					special = false
+				}
+			}
+		}
+`, id, name, days, length)
+}
+
 func testAccResourceSecret_text_reference(id string, name string, secretValue string, secretManagerIdentifier string) string {
 	return fmt.Sprintf(`
 		resource "harness_platform_secret_text" "test" {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/antihax/optional"
 	"github.com/harness/harness-go-sdk/harness/nextgen"
 	"github.com/harness/terraform-provider-harness/helpers"
 	"github.com/harness/terraform-provider-harness/internal"
@@ -13,23 +14,52 @@ import (
 
 func DataSourceServiceList() *schema.Resource {
 	resource := &schema.Resource{
-		Description: "Data source for retrieving a Harness service List.",
+		Description: "Data source for retrieving a Harness service List. Every page of results is fetched.",
 
 		ReadContext: dataSourceServiceListRead,
 
 		Schema: map[string]*schema.Schema{
 			"services": {
-				Type:     schema.TypeList,
-				Computed: true,
+				Description: "List of services matching the filters.",
+				Type:        schema.TypeList,
+				Computed:    true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"identifier": {
-							Type:     schema.TypeString,
-							Computed: true,
+							Description: "Unique identifier of the service.",
+							Type:        schema.TypeString,
+							Computed:    true,
 						},
 						"name": {
-							Type:     schema.TypeString,
-							Computed: true,
+							Description: "Name of the service.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "Description of the service.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"org_id": {
+							Description: "Unique identifier of the organization.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"project_id": {
+							Description: "Unique identifier of the project.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"tags": {
+							Description: "Tags associated with the service.",
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"yaml": {
+							Description: "Service YAML.",
+							Type:        schema.TypeString,
+							Computed:    true,
 						},
 					},
 				},
@@ -38,6 +68,7 @@ func DataSourceServiceList() *schema.Resource {
 	}
 
 	helpers.SetOptionalOrgAndProjectLevelDataSourceSchema(resource.Schema)
+	helpers.SetListDataSourceFilterSchema(resource.Schema)
 
 	return resource
 }
@@ -45,36 +76,49 @@ func DataSourceServiceList() *schema.Resource {
 func dataSourceServiceListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
 
-	var err error
-	var httpResp *http.Response
-
-	var resp nextgen.ResponseDtoPageResponseServiceResponse
-	resp, httpResp, err = c.ServicesApi.GetServiceList(ctx, c.AccountId, &nextgen.ServicesApiGetServiceListOpts{
+	opts := &nextgen.ServicesApiGetServiceListOpts{
 		OrgIdentifier:     helpers.BuildField(d, "org_id"),
 		ProjectIdentifier: helpers.BuildField(d, "project_id"),
-	})
-	var output []nextgen.ServiceResponse = resp.Data.Content
-	var services []map[string]interface{}
-	for _, v := range output {
-		newService := map[string]interface{}{
-			"identifier": v.Service.Identifier,
-			"name":       v.Service.Name,
+		SearchTerm:        helpers.BuildField(d, "search_term"),
+		Size:              optional.NewInt32(helpers.ListPageSize),
+	}
+
+	services := []interface{}{}
+	httpResp, err := helpers.ListAllPages(func(page int32) (bool, *http.Response, error) {
+		opts.Page = optional.NewInt32(page)
+
+		resp, httpResp, err := c.ServicesApi.GetServiceList(ctx, c.AccountId, opts)
+		if err != nil {
+			return false, httpResp, err
 		}
 
-		services = append(services, newService)
-	}
+		if resp.Data == nil {
+			return false, httpResp, nil
+		}
 
+		for _, v := range resp.Data.Content {
+			if v.Service == nil || !helpers.MatchesFilterTags(d, v.Service.Tags) {
+				continue
+			}
+
+			services = append(services, map[string]interface{}{
+				"identifier":  v.Service.Identifier,
+				"name":        v.Service.Name,
+				"description": v.Service.Description,
+				"org_id":      v.Service.OrgIdentifier,
+				"project_id":  v.Service.ProjectIdentifier,
+				"tags":        helpers.FlattenTags(v.Service.Tags),
+				"yaml":        v.Service.Yaml,
+			})
+		}
+
+		return helpers.HasMorePages(page, resp.Data.TotalPages), httpResp, nil
+	})
 	if err != nil {
 		return helpers.HandleApiError(err, d, httpResp)
 	}
 
-	if services == nil {
-		d.SetId("")
-		d.MarkNewResource()
-		return nil
-	}
-
-	d.SetId(resp.CorrelationId)
+	d.SetId(helpers.ListDataSourceId(c.AccountId, d, "org_id", "project_id", "search_term", "filter_tags"))
 	d.Set("services", services)
 
 	return nil
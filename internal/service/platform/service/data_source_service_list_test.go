@@ -22,6 +22,7 @@ func TestAccDataSourceServiceList(t *testing.T) {
 			{
 				Config: testAccDataSourceServiceList(id, name),
 				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "services.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "services.0.identifier", id),
 					resource.TestCheckResourceAttr(resourceName, "services.0.name", name),
 				),
@@ -43,6 +44,7 @@ func TestAccDataSourceServiceListOrgLevel(t *testing.T) {
 			{
 				Config: testAccDataSourceServiceListOrgLevel(id, name),
 				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "services.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "services.0.identifier", id),
 					resource.TestCheckResourceAttr(resourceName, "services.0.name", name),
 				),
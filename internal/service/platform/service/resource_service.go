@@ -20,6 +20,7 @@ func ResourceService() *schema.Resource {
 		UpdateContext: resourceServiceCreateOrUpdate,
 		DeleteContext: resourceServiceDelete,
 		CreateContext: resourceServiceCreateOrUpdate,
+		CustomizeDiff: helpers.ValidateYamlDiff("service", "yaml", helpers.YamlScopeArguments),
 		Importer:      helpers.MultiLevelResourceImporter,
 
 		Schema: map[string]*schema.Schema{
@@ -40,6 +41,7 @@ func ResourceService() *schema.Resource {
 	}
 
 	helpers.SetMultiLevelResourceSchema(resource.Schema)
+	helpers.SetGitExperienceSchema(resource.Schema)
 
 	return resource
 }
@@ -49,20 +51,28 @@ func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta inter
 
 	id := d.Id()
 
-	resp, httpResp, err := c.ServicesApi.GetServiceV2(ctx, id, c.AccountId, &nextgen.ServicesApiGetServiceV2Opts{
-		OrgIdentifier:     helpers.BuildField(d, "org_id"),
-		ProjectIdentifier: helpers.BuildField(d, "project_id"),
-	})
-
+	resp, httpResp, err := getService(ctx, c, d, id)
 	if err != nil {
 		return helpers.HandleReadApiError(err, d, httpResp)
 	}
 
 	readService(d, resp.Data.Service)
+	readServiceGitDetails(d, resp.Data.Service)
 
 	return nil
 }
 
+func getService(ctx context.Context, c *nextgen.APIClient, d *schema.ResourceData, id string) (nextgen.ResponseDtoServiceResponse, *http.Response, error) {
+	git := helpers.BuildGitReadOptions(d)
+
+	return c.ServicesApi.GetServiceV2(ctx, id, c.AccountId, &nextgen.ServicesApiGetServiceV2Opts{
+		OrgIdentifier:     helpers.BuildField(d, "org_id"),
+		ProjectIdentifier: helpers.BuildField(d, "project_id"),
+		Branch:            git.BranchName,
+		RepoName:          git.RepoName,
+	})
+}
+
 func resourceServiceCreateOrUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
 
@@ -72,13 +82,48 @@ func resourceServiceCreateOrUpdate(ctx context.Context, d *schema.ResourceData,
 	svc := buildService(d)
 	id := d.Id()
 
-	if id == "" {
+	git := helpers.BuildGitOptions(d)
+
+	if id == "" && helpers.IsImportFromGit(d) {
+		gitImport := helpers.BuildGitImportOptions(d)
+		_, httpResp, err = c.ServicesApi.ImportService(ctx, c.AccountId, &nextgen.ServicesApiImportServiceOpts{
+			OrgIdentifier:     helpers.BuildField(d, "org_id"),
+			ProjectIdentifier: helpers.BuildField(d, "project_id"),
+			ServiceIdentifier: optional.NewString(svc.Identifier),
+			ConnectorRef:      gitImport.ConnectorRef,
+			RepoName:          gitImport.RepoName,
+			Branch:            gitImport.BranchName,
+			FilePath:          gitImport.FilePath,
+			IsForceImport:     gitImport.IsForceImport,
+		})
+		if err == nil {
+			// The import only returns the identifier of the service.
+			resp, httpResp, err = getService(ctx, c, d, svc.Identifier)
+		}
+	} else if id == "" {
 		resp, httpResp, err = c.ServicesApi.CreateServiceV2(ctx, c.AccountId, &nextgen.ServicesApiCreateServiceV2Opts{
-			Body: optional.NewInterface(svc),
+			Body:         optional.NewInterface(svc),
+			StoreType:    git.StoreType,
+			ConnectorRef: git.ConnectorRef,
+			RepoName:     git.RepoName,
+			FilePath:     git.FilePath,
+			BranchName:   git.BranchName,
+			BaseBranch:   git.BaseBranch,
+			IsNewBranch:  git.IsNewBranch,
+			CommitMsg:    git.CommitMessage,
 		})
 	} else {
 		resp, httpResp, err = c.ServicesApi.UpdateServiceV2(ctx, c.AccountId, &nextgen.ServicesApiUpdateServiceV2Opts{
-			Body: optional.NewInterface(svc),
+			Body:         optional.NewInterface(svc),
+			ConnectorRef: git.ConnectorRef,
+			RepoName:     git.RepoName,
+			FilePath:     git.FilePath,
+			BranchName:   git.BranchName,
+			BaseBranch:   git.BaseBranch,
+			IsNewBranch:  git.IsNewBranch,
+			CommitMsg:    git.CommitMessage,
+			LastObjectId: git.LastObjectId,
+			LastCommitId: git.LastCommitId,
 		})
 	}
 
@@ -87,6 +132,7 @@ func resourceServiceCreateOrUpdate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	readService(d, resp.Data.Service)
+	readServiceGitDetails(d, resp.Data.Service)
 
 	return nil
 }
@@ -128,3 +174,19 @@ func readService(d *schema.ResourceData, project *nextgen.ServiceResponseDetails
 	d.Set("tags", helpers.FlattenTags(project.Tags))
 	d.Set("yaml", project.Yaml)
 }
+
+func readServiceGitDetails(d *schema.ResourceData, svc *nextgen.ServiceResponseDetails) {
+	if svc.EntityGitDetails == nil {
+		return
+	}
+
+	helpers.SetGitDetails(d, &helpers.GitDetails{
+		StoreType:    svc.StoreType,
+		ConnectorRef: svc.ConnectorRef,
+		BranchName:   svc.EntityGitDetails.BranchName,
+		FilePath:     svc.EntityGitDetails.FilePath,
+		RepoName:     svc.EntityGitDetails.RepoName,
+		LastCommitId: svc.EntityGitDetails.CommitId,
+		LastObjectId: svc.EntityGitDetails.ObjectId,
+	})
+}
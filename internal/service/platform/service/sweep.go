@@ -0,0 +1,60 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/antihax/optional"
+	openapi_client_nextgen "github.com/harness/harness-openapi-go-client/nextgen"
+	"github.com/harness/terraform-provider-harness/internal/sweep"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func init() {
+	resource.AddTestSweepers("harness_platform_service", &resource.Sweeper{
+		Name:         "harness_platform_service",
+		F:            testSweepServices,
+		Dependencies: []string{"harness_platform_pipeline"},
+	})
+}
+
+func testSweepServices(r string) error {
+	c, ctx := sweep.GetClientWithContext()
+
+	projects, err := sweep.ListProjectScopes()
+	if err != nil {
+		return err
+	}
+
+	for _, project := range projects {
+		for page := int32(0); ; page++ {
+			services, _, err := c.ProjectServicesApi.GetServices(ctx, project.Org, project.Project, &openapi_client_nextgen.ProjectServicesApiGetServicesOpts{
+				HarnessAccount: optional.NewString(c.AccountId),
+				Page:           optional.NewInt32(page),
+				Limit:          optional.NewInt32(sweep.PageSize),
+			})
+			if err != nil {
+				return err
+			}
+
+			for _, svc := range services {
+				// Only delete services whose identifier or name starts with the sweep prefix
+				if svc.Service == nil || !sweep.IsSweepable(svc.Service.Identifier, svc.Service.Name) {
+					continue
+				}
+
+				_, _, err := c.ProjectServicesApi.DeleteService(ctx, project.Org, project.Project, svc.Service.Identifier, &openapi_client_nextgen.ProjectServicesApiDeleteServiceOpts{
+					HarnessAccount: optional.NewString(c.AccountId),
+				})
+				if err != nil {
+					fmt.Printf("[ERROR] Failed to delete service %s: %s", svc.Service.Identifier, err)
+				}
+			}
+
+			if len(services) < sweep.PageSize {
+				break
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package service_account
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/antihax/optional"
+	"github.com/harness/harness-go-sdk/harness/nextgen"
+	"github.com/harness/terraform-provider-harness/helpers"
+	"github.com/harness/terraform-provider-harness/internal"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceServiceAccountList() *schema.Resource {
+	resource := &schema.Resource{
+		Description: "Data source for listing the service accounts of an account, organization or project. Every page of results is fetched.",
+
+		ReadContext: dataSourceServiceAccountListRead,
+
+		Schema: map[string]*schema.Schema{
+			"service_accounts": {
+				Description: "List of service accounts matching the filters.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identifier": {
+							Description: "Unique identifier of the Service Account.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the Service Account.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "Description of the Service Account.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"email": {
+							Description: "Email of the Service Account.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"org_id": {
+							Description: "Unique identifier of the organization.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"project_id": {
+							Description: "Unique identifier of the project.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"tags": {
+							Description: "Tags associated with the Service Account.",
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	helpers.SetOptionalOrgAndProjectLevelDataSourceSchema(resource.Schema)
+	helpers.SetListDataSourceFilterSchema(resource.Schema)
+
+	return resource
+}
+
+func dataSourceServiceAccountListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
+
+	opts := &nextgen.ServiceAccountApiListAggregatedServiceAccountsOpts{
+		OrgIdentifier:     helpers.BuildField(d, "org_id"),
+		ProjectIdentifier: helpers.BuildField(d, "project_id"),
+		SearchTerm:        helpers.BuildField(d, "search_term"),
+		PageSize:          optional.NewInt32(helpers.ListPageSize),
+	}
+
+	serviceAccounts := []interface{}{}
+	httpResp, err := helpers.ListAllPages(func(page int32) (bool, *http.Response, error) {
+		opts.PageIndex = optional.NewInt32(page)
+
+		resp, httpResp, err := c.ServiceAccountApi.ListAggregatedServiceAccounts(ctx, c.AccountId, opts)
+		if err != nil {
+			return false, httpResp, err
+		}
+
+		if resp.Data == nil {
+			return false, httpResp, nil
+		}
+
+		for _, v := range resp.Data.Content {
+			sa := v.ServiceAccount
+			if sa == nil || !helpers.MatchesFilterTags(d, sa.Tags) {
+				continue
+			}
+
+			serviceAccounts = append(serviceAccounts, map[string]interface{}{
+				"identifier":  sa.Identifier,
+				"name":        sa.Name,
+				"description": sa.Description,
+				"email":       sa.Email,
+				"org_id":      sa.OrgIdentifier,
+				"project_id":  sa.ProjectIdentifier,
+				"tags":        helpers.FlattenTags(sa.Tags),
+			})
+		}
+
+		return helpers.HasMorePages(page, resp.Data.TotalPages), httpResp, nil
+	})
+	if err != nil {
+		return helpers.HandleApiError(err, d, httpResp)
+	}
+
+	d.SetId(helpers.ListDataSourceId(c.AccountId, d, "org_id", "project_id", "search_term", "filter_tags"))
+	d.Set("service_accounts", serviceAccounts)
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package service_account_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/harness/harness-go-sdk/harness/utils"
+	"github.com/harness/terraform-provider-harness/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceServiceAccountList(t *testing.T) {
+
+	id := fmt.Sprintf("%s_%s", t.Name(), utils.RandStringBytes(6))
+	name := id
+	resourceName := "data.harness_platform_service_account_list.test"
+	accountId := os.Getenv("HARNESS_ACCOUNT_ID")
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.TestAccPreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceServiceAccountList(id, name, accountId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "service_accounts.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "service_accounts.0.identifier", id),
+					resource.TestCheckResourceAttr(resourceName, "service_accounts.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "service_accounts.0.email", "email@service.harness.io"),
+					resource.TestCheckResourceAttr(resourceName, "service_accounts.0.tags.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceServiceAccountList(id string, name string, accountId string) string {
+	return fmt.Sprintf(`
+	resource "harness_platform_organization" "test" {
+		identifier = "%[1]s"
+		name = "%[2]s"
+	}
+
+	resource "harness_platform_service_account" "test" {
+		identifier = "%[1]s"
+		name = "%[2]s"
+		email = "email@service.harness.io"
+		description = "test"
+		tags = ["foo:bar"]
+		account_id = "%[3]s"
+		org_id = harness_platform_organization.test.identifier
+	}
+
+	data "harness_platform_service_account_list" "test" {
+		org_id = harness_platform_service_account.test.org_id
+		filter_tags = ["foo:bar"]
+	}
+	`, id, name, accountId)
+}
@@ -66,6 +66,7 @@ func ResourceServiceOverrides() *schema.Resource {
 	}
 
 	SetScopedResourceSchemaForServiceOverride(resource.Schema)
+	helpers.SetGitExperienceSchema(resource.Schema)
 
 	return resource
 }
@@ -74,11 +75,7 @@ func resourceServiceOverridesV2Read(ctx context.Context, d *schema.ResourceData,
 	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
 	identifier := d.Id()
 
-	resp, httpResp, err := c.ServiceOverridesApi.GetServiceOverridesV2(ctx, identifier, c.AccountId,
-		&nextgen.ServiceOverridesApiGetServiceOverridesV2Opts{
-			OrgIdentifier:     helpers.BuildField(d, "org_id"),
-			ProjectIdentifier: helpers.BuildField(d, "project_id"),
-		})
+	resp, httpResp, err := getServiceOverridesV2(ctx, c, d, identifier)
 	if err != nil {
 		return helpers.HandleReadApiError(err, d, httpResp)
 	}
@@ -92,10 +89,23 @@ func resourceServiceOverridesV2Read(ctx context.Context, d *schema.ResourceData,
 	}
 
 	readServiceOverridesV2(d, resp.Data)
+	readServiceOverridesV2GitDetails(d, resp.Data)
 
 	return nil
 }
 
+func getServiceOverridesV2(ctx context.Context, c *nextgen.APIClient, d *schema.ResourceData, identifier string) (nextgen.ResponseServiceOverridesResponseDtov2, *http.Response, error) {
+	git := helpers.BuildGitReadOptions(d)
+
+	return c.ServiceOverridesApi.GetServiceOverridesV2(ctx, identifier, c.AccountId,
+		&nextgen.ServiceOverridesApiGetServiceOverridesV2Opts{
+			OrgIdentifier:     helpers.BuildField(d, "org_id"),
+			ProjectIdentifier: helpers.BuildField(d, "project_id"),
+			Branch:            git.BranchName,
+			RepoName:          git.RepoName,
+		})
+}
+
 func resourceServiceOverridesV2CreateOrUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
 
@@ -106,13 +116,52 @@ func resourceServiceOverridesV2CreateOrUpdate(ctx context.Context, d *schema.Res
 
 	id := d.Id()
 
-	if id == "" {
+	git := helpers.BuildGitOptions(d)
+
+	if id == "" && helpers.IsImportFromGit(d) {
+		gitImport := helpers.BuildGitImportOptions(d)
+		var importResp nextgen.ResponseServiceOverrideImportResponseDto
+		importResp, httpResp, err = c.ServiceOverridesApi.ImportServiceOverridesV2(ctx, c.AccountId, &nextgen.ServiceOverridesApiImportServiceOverridesV2Opts{
+			OrgIdentifier:     helpers.BuildField(d, "org_id"),
+			ProjectIdentifier: helpers.BuildField(d, "project_id"),
+			EnvironmentRef:    optional.NewString(env.EnvironmentRef),
+			ServiceRef:        helpers.BuildField(d, "service_id"),
+			InfraIdentifier:   helpers.BuildField(d, "infra_id"),
+			Type_:             optional.NewString(env.Type_),
+			ConnectorRef:      gitImport.ConnectorRef,
+			RepoName:          gitImport.RepoName,
+			Branch:            gitImport.BranchName,
+			FilePath:          gitImport.FilePath,
+			IsForceImport:     gitImport.IsForceImport,
+		})
+		if err == nil && importResp.Data != nil {
+			// The import only returns the identifier of the override.
+			resp, httpResp, err = getServiceOverridesV2(ctx, c, d, importResp.Data.Identifier)
+		}
+	} else if id == "" {
 		resp, httpResp, err = c.ServiceOverridesApi.CreateServiceOverrideV2(ctx, c.AccountId, &nextgen.ServiceOverridesApiCreateServiceOverrideV2Opts{
-			Body: optional.NewInterface(env),
+			Body:         optional.NewInterface(env),
+			StoreType:    git.StoreType,
+			ConnectorRef: git.ConnectorRef,
+			RepoName:     git.RepoName,
+			FilePath:     git.FilePath,
+			BranchName:   git.BranchName,
+			BaseBranch:   git.BaseBranch,
+			IsNewBranch:  git.IsNewBranch,
+			CommitMsg:    git.CommitMessage,
 		})
 	} else {
 		resp, httpResp, err = c.ServiceOverridesApi.UpdateServiceOverrideV2(ctx, c.AccountId, &nextgen.ServiceOverridesApiUpdateServiceOverrideV2Opts{
-			Body: optional.NewInterface(env),
+			Body:         optional.NewInterface(env),
+			ConnectorRef: git.ConnectorRef,
+			RepoName:     git.RepoName,
+			FilePath:     git.FilePath,
+			BranchName:   git.BranchName,
+			BaseBranch:   git.BaseBranch,
+			IsNewBranch:  git.IsNewBranch,
+			CommitMsg:    git.CommitMessage,
+			LastObjectId: git.LastObjectId,
+			LastCommitId: git.LastCommitId,
 		})
 	}
 
@@ -129,6 +178,7 @@ func resourceServiceOverridesV2CreateOrUpdate(ctx context.Context, d *schema.Res
 	}
 
 	readServiceOverridesV2(d, resp.Data)
+	readServiceOverridesV2GitDetails(d, resp.Data)
 
 	return nil
 }
@@ -174,6 +224,22 @@ func readServiceOverridesV2(d *schema.ResourceData, so *nextgen.ServiceOverrides
 	d.Set("identifier", so.Identifier)
 }
 
+func readServiceOverridesV2GitDetails(d *schema.ResourceData, so *nextgen.ServiceOverridesResponseDtov2) {
+	if so.EntityGitInfo == nil {
+		return
+	}
+
+	helpers.SetGitDetails(d, &helpers.GitDetails{
+		StoreType:    so.StoreType,
+		ConnectorRef: so.ConnectorRef,
+		BranchName:   so.EntityGitInfo.Branch,
+		FilePath:     so.EntityGitInfo.FilePath,
+		RepoName:     so.EntityGitInfo.RepoName,
+		LastCommitId: so.EntityGitInfo.CommitId,
+		LastObjectId: so.EntityGitInfo.ObjectId,
+	})
+}
+
 func SetScopedResourceSchemaForServiceOverride(s map[string]*schema.Schema) {
 	s["project_id"] = helpers.GetProjectIdSchema(helpers.SchemaFlagTypes.Optional)
 	s["org_id"] = helpers.GetOrgIdSchema(helpers.SchemaFlagTypes.Optional)
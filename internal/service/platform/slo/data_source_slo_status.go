@@ -0,0 +1,138 @@
+package slo
+
+import (
+	"context"
+	"encoding/json"
+	hh "github.com/harness/harness-go-sdk/harness/helpers"
+	"github.com/harness/harness-go-sdk/harness/nextgen"
+	"github.com/harness/terraform-provider-harness/helpers"
+	"github.com/harness/terraform-provider-harness/internal"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceSloStatus() *schema.Resource {
+	resource := &schema.Resource{
+		Description: "Data source for retrieving the current status of an SLO: its SLI, remaining error budget and burn rate.",
+
+		ReadContext: dataSourceSloStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Description: "Identifier of the organization in which the SLO is configured.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"project_id": {
+				Description: "Identifier of the project in which the SLO is configured.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"identifier": {
+				Description: "Identifier of the SLO.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"sli_value": {
+				Description: "Current value of the SLI over the period of the SLO, in percent.",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+			"slo_target_percentage": {
+				Description: "Target of the SLO, in percent.",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+			"total_error_budget": {
+				Description: "Error budget of the period of the SLO, in minutes for window based SLOs or in requests for request based SLOs.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"error_budget_remaining": {
+				Description: "Remaining error budget, in minutes for window based SLOs or in requests for request based SLOs.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"error_budget_remaining_percentage": {
+				Description: "Remaining error budget, in percent of the error budget.",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+			"error_budget_burn_rate": {
+				Description: "Current burn rate of the error budget, in percent of the error budget per day.",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+			"error_budget_risk": {
+				Description: "Risk of exhausting the error budget: `HEALTHY`, `OBSERVE`, `NEED_ATTENTION`, `UNHEALTHY` or `EXHAUSTED`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"time_remaining_days": {
+				Description: "Number of days remaining in the period of the SLO.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+
+	return resource
+}
+
+// sloStatus holds the fields of the SLO dashboard widget exposed by the data source.
+type sloStatus struct {
+	SloDashboardWidget struct {
+		SloTargetPercentage            float64 `json:"sloTargetPercentage"`
+		TotalErrorBudget               int     `json:"totalErrorBudget"`
+		ErrorBudgetRemaining           int     `json:"errorBudgetRemaining"`
+		ErrorBudgetRemainingPercentage float64 `json:"errorBudgetRemainingPercentage"`
+		ErrorBudgetRisk                string  `json:"errorBudgetRisk"`
+		TimeRemainingDays              int     `json:"timeRemainingDays"`
+		BurnRate                       struct {
+			CurrentRatePercentage float64 `json:"currentRatePercentage"`
+		} `json:"burnRate"`
+		SloPerformanceTrend []struct {
+			Timestamp int64   `json:"timestamp"`
+			Value     float64 `json:"value"`
+		} `json:"sloPerformanceTrend"`
+	} `json:"sloDashboardWidget"`
+}
+
+func dataSourceSloStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
+	ctx = context.WithValue(ctx, nextgen.ContextAccessToken, hh.EnvVars.BearerToken.Get())
+	identifier := d.Get("identifier").(string)
+	resp, httpResp, err := c.SloDashboardApi.GetSloDashboardDetail(ctx, identifier, c.AccountId, d.Get("org_id").(string), d.Get("project_id").(string),
+		&nextgen.SloDashboardApiGetSloDashboardDetailOpts{})
+
+	if err != nil {
+		return helpers.HandleApiError(err, d, httpResp)
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	status := sloStatus{}
+	if err := json.Unmarshal(data, &status); err != nil {
+		return diag.FromErr(err)
+	}
+	widget := status.SloDashboardWidget
+
+	// The performance trend holds the SLI over the period of the SLO, its last point is the current value.
+	sliValue := 0.0
+	if n := len(widget.SloPerformanceTrend); n > 0 {
+		sliValue = widget.SloPerformanceTrend[n-1].Value
+	}
+
+	d.SetId(identifier)
+	d.Set("sli_value", sliValue)
+	d.Set("slo_target_percentage", widget.SloTargetPercentage)
+	d.Set("total_error_budget", widget.TotalErrorBudget)
+	d.Set("error_budget_remaining", widget.ErrorBudgetRemaining)
+	d.Set("error_budget_remaining_percentage", widget.ErrorBudgetRemainingPercentage)
+	d.Set("error_budget_burn_rate", widget.BurnRate.CurrentRatePercentage)
+	d.Set("error_budget_risk", widget.ErrorBudgetRisk)
+	d.Set("time_remaining_days", widget.TimeRemainingDays)
+	return nil
+}
@@ -0,0 +1,47 @@
+package slo_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/harness/harness-go-sdk/harness/utils"
+	"github.com/harness/terraform-provider-harness/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSloStatus(t *testing.T) {
+
+	id := fmt.Sprintf("%s_%s", t.Name(), utils.RandStringBytes(6))
+	name := id
+	resourceName := "data.harness_platform_slo_status.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.TestAccPreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"time": {},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSloStatus(id, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "identifier", id),
+					resource.TestCheckResourceAttr(resourceName, "slo_target_percentage", "10"),
+					resource.TestCheckResourceAttrSet(resourceName, "error_budget_remaining_percentage"),
+					resource.TestCheckResourceAttrSet(resourceName, "error_budget_burn_rate"),
+					resource.TestCheckResourceAttrSet(resourceName, "sli_value"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSloStatus(id string, name string) string {
+	return testAccResourceSloForTimeWindow(id, name) + `
+		data "harness_platform_slo_status" "test" {
+			identifier = harness_platform_slo.test.id
+			org_id = harness_platform_slo.test.org_id
+			project_id = harness_platform_slo.test.project_id
+		}
+`
+}
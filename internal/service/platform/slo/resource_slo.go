@@ -3,6 +3,8 @@ package slo
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"math"
 	"github.com/antihax/optional"
 	hh "github.com/harness/harness-go-sdk/harness/helpers"
 	"github.com/harness/harness-go-sdk/harness/nextgen"
@@ -10,6 +12,7 @@ import (
 	"github.com/harness/terraform-provider-harness/internal"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"strings"
 )
 
@@ -22,6 +25,7 @@ func ResourceSloService() *schema.Resource {
 		UpdateContext: resourceSloUpdate,
 		DeleteContext: resourceSloDelete,
 		Importer:      helpers.MultiLevelResourceImporter,
+		CustomizeDiff: validateSloRequest,
 
 		Schema: map[string]*schema.Schema{
 			"org_id": {
@@ -80,9 +84,10 @@ func ResourceSloService() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"type": {
-										Description: "Type of the SLO target.",
-										Type:        schema.TypeString,
-										Required:    true,
+										Description:  "Type of the SLO target, `Rolling` or `Calender`.",
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{"Rolling", "Calender"}, false),
 									},
 									"slo_target_percentage": {
 										Description: "Target percentage for the SLO.",
@@ -90,22 +95,73 @@ func ResourceSloService() *schema.Resource {
 										Required:    true,
 									},
 									"spec": {
-										Description: "Specification of the SLO Target.",
-										Type:        schema.TypeString,
-										Required:    true,
+										Description:  "Specification of the SLO Target.",
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsJSON,
 									},
 								},
 							},
 						},
 						"type": {
-							Description: "Type of the SLO.",
-							Type:        schema.TypeString,
-							Required:    true,
+							Description:  "Type of the SLO, `Simple` or `Composite`.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"Simple", "Composite"}, false),
 						},
 						"spec": {
-							Description: "Specification of the SLO.",
-							Type:        schema.TypeString,
-							Required:    true,
+							Description:  "Specification of the SLO as JSON. Required for simple SLOs. Composite SLOs can use `composite_slo` instead.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsJSON,
+						},
+						"composite_slo": {
+							Description: "SLOs combined by a composite SLO, as an alternative to `spec` for SLOs of type `Composite`.",
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"evaluation_type": {
+										Description:  "Evaluation type of the composite SLO, `Window` or `Request`. All the referenced SLOs must use the same evaluation type.",
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "Window",
+										ValidateFunc: validation.StringInSlice([]string{"Window", "Request"}, false),
+									},
+									"slo_reference": {
+										Description: "SLOs combined by the composite SLO. The weights of the SLOs must add up to 100.",
+										Type:        schema.TypeList,
+										Required:    true,
+										MinItems:    1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"slo_ref": {
+													Description: "Identifier of the SLO.",
+													Type:        schema.TypeString,
+													Required:    true,
+												},
+												"weightage_percentage": {
+													Description:  "Weight of the SLO in the composite SLO, in percent.",
+													Type:         schema.TypeFloat,
+													Required:     true,
+													ValidateFunc: validation.FloatBetween(0, 100),
+												},
+												"org_id": {
+													Description: "Identifier of the organization of the SLO. Defaults to the organization of the composite SLO.",
+													Type:        schema.TypeString,
+													Optional:    true,
+												},
+												"project_id": {
+													Description: "Identifier of the project of the SLO. Defaults to the project of the composite SLO.",
+													Type:        schema.TypeString,
+													Optional:    true,
+												},
+											},
+										},
+									},
+								},
+							},
 						},
 						"notification_rule_refs": {
 							Description: "Notification rule references for the SLO.",
@@ -149,7 +205,10 @@ func resourceSloCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 	if attr, ok := d.GetOk("identifier"); ok {
 		identifier = attr.(string)
 	}
-	createSloRequest := buildSloRequest(d, identifier)
+	createSloRequest, err := buildSloRequest(d, accountIdentifier, identifier)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	respCreate, httpRespCreate, errCreate := c.SloApi.SaveSLODataNg(ctx, accountIdentifier, orgIdentifier, projectIdentifier,
 		&nextgen.SloApiSaveSLODataNgOpts{
 			Body: optional.NewInterface(createSloRequest),
@@ -212,7 +271,10 @@ func resourceSloUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 	if attr, ok := d.GetOk("identifier"); ok {
 		identifier = attr.(string)
 	}
-	updateSloRequest := buildSloRequest(d, identifier)
+	updateSloRequest, err := buildSloRequest(d, accountIdentifier, identifier)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	respCreate, httpRespCreate, errCreate := c.SloApi.UpdateSLODataNg(ctx, accountIdentifier, orgIdentifier, projectIdentifier, identifier,
 		&nextgen.SloApiUpdateSLODataNgOpts{
 			Body: optional.NewInterface(updateSloRequest),
@@ -247,7 +309,7 @@ func resourceSloDelete(ctx context.Context, d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func buildSloRequest(d *schema.ResourceData, identifier string) *nextgen.ServiceLevelObjectiveV2Dto {
+func buildSloRequest(d *schema.ResourceData, accountIdentifier string, identifier string) (*nextgen.ServiceLevelObjectiveV2Dto, error) {
 	serviceLevelObjectiveV2Dto := &nextgen.ServiceLevelObjectiveV2Dto{}
 
 	if attr, ok := d.GetOk("org_id"); ok {
@@ -287,7 +349,9 @@ func buildSloRequest(d *schema.ResourceData, identifier string) *nextgen.Service
 		sloTargetSpec := sloTarget["spec"].(string)
 		if sloTargetType == "Rolling" {
 			data := nextgen.RollingSloTargetSpec{}
-			json.Unmarshal([]byte(sloTargetSpec), &data)
+			if err := json.Unmarshal([]byte(sloTargetSpec), &data); err != nil {
+				return nil, fmt.Errorf("invalid SLO target spec: %w", err)
+			}
 
 			sloTargetDto := nextgen.SloTargetDto{
 				Type_: nextgen.SLOTargetType(sloTargetType),
@@ -299,7 +363,9 @@ func buildSloRequest(d *schema.ResourceData, identifier string) *nextgen.Service
 		}
 		if sloTargetType == "Calender" {
 			data := nextgen.CalenderSloTargetSpec{}
-			json.Unmarshal([]byte(sloTargetSpec), &data)
+			if err := json.Unmarshal([]byte(sloTargetSpec), &data); err != nil {
+				return nil, fmt.Errorf("invalid SLO target spec: %w", err)
+			}
 
 			sloTargetDto := nextgen.SloTargetDto{
 				Type_: nextgen.SLOTargetType(sloTargetType),
@@ -312,16 +378,27 @@ func buildSloRequest(d *schema.ResourceData, identifier string) *nextgen.Service
 
 		sloType := request["type"].(string)
 		sloSpec := request["spec"].(string)
+		if composite := request["composite_slo"].([]interface{}); len(composite) > 0 && composite[0] != nil {
+			spec, err := expandCompositeSloSpec(composite[0].(map[string]interface{}), accountIdentifier, serviceLevelObjectiveV2Dto.OrgIdentifier, serviceLevelObjectiveV2Dto.ProjectIdentifier)
+			if err != nil {
+				return nil, err
+			}
+			sloSpec = spec
+		}
 		if sloType == "Simple" {
 			data := nextgen.SimpleServiceLevelObjectiveSpec{}
-			json.Unmarshal([]byte(sloSpec), &data)
+			if err := json.Unmarshal([]byte(sloSpec), &data); err != nil {
+				return nil, fmt.Errorf("invalid SLO spec: %w", err)
+			}
 
 			serviceLevelObjectiveV2Dto.Type_ = nextgen.SLOType(sloType)
 			serviceLevelObjectiveV2Dto.Simple = &data
 		}
 		if sloType == "Composite" {
 			data := nextgen.CompositeServiceLevelObjectiveSpec{}
-			json.Unmarshal([]byte(sloSpec), &data)
+			if err := json.Unmarshal([]byte(sloSpec), &data); err != nil {
+				return nil, fmt.Errorf("invalid SLO spec: %w", err)
+			}
 
 			serviceLevelObjectiveV2Dto.Type_ = nextgen.SLOType(sloType)
 			serviceLevelObjectiveV2Dto.Composite = &data
@@ -340,7 +417,77 @@ func buildSloRequest(d *schema.ResourceData, identifier string) *nextgen.Service
 		serviceLevelObjectiveV2Dto.NotificationRuleRefs = notificationRuleRefs
 	}
 
-	return serviceLevelObjectiveV2Dto
+	return serviceLevelObjectiveV2Dto, nil
+}
+
+// expandCompositeSloSpec converts the composite_slo block to the JSON spec of a composite SLO.
+func expandCompositeSloSpec(composite map[string]interface{}, accountIdentifier string, orgIdentifier string, projectIdentifier string) (string, error) {
+	details := []interface{}{}
+	for _, r := range composite["slo_reference"].([]interface{}) {
+		reference := r.(map[string]interface{})
+		org := orgIdentifier
+		if v := reference["org_id"].(string); v != "" {
+			org = v
+		}
+		project := projectIdentifier
+		if v := reference["project_id"].(string); v != "" {
+			project = v
+		}
+		details = append(details, map[string]interface{}{
+			"accountId":                accountIdentifier,
+			"orgIdentifier":            org,
+			"projectIdentifier":        project,
+			"serviceLevelObjectiveRef": reference["slo_ref"],
+			"weightagePercentage":      reference["weightage_percentage"],
+		})
+	}
+
+	spec, err := json.Marshal(map[string]interface{}{
+		"evaluationType":                composite["evaluation_type"],
+		"serviceLevelObjectivesDetails": details,
+	})
+	if err != nil {
+		return "", fmt.Errorf("invalid composite SLO: %w", err)
+	}
+	return string(spec), nil
+}
+
+// validateSloRequest checks at plan time that the SLO is configured with exactly one of spec or composite_slo and
+// that the weights of a composite SLO add up to 100. Values that are not known yet are checked by the API.
+func validateSloRequest(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	requests := d.Get("request").([]interface{})
+	if len(requests) == 0 || requests[0] == nil {
+		return nil
+	}
+	request := requests[0].(map[string]interface{})
+	sloType := request["type"].(string)
+	composite := request["composite_slo"].([]interface{})
+
+	if len(composite) == 0 || composite[0] == nil {
+		if sloType != "" && d.NewValueKnown("request.0.spec") && request["spec"].(string) == "" {
+			return fmt.Errorf("spec is required for SLOs of type %s", sloType)
+		}
+		return nil
+	}
+
+	if sloType != "" && sloType != "Composite" {
+		return fmt.Errorf("composite_slo can only be used with SLOs of type Composite, got %s", sloType)
+	}
+	if d.NewValueKnown("request.0.spec") && request["spec"].(string) != "" {
+		return fmt.Errorf("only one of spec or composite_slo can be set")
+	}
+
+	total := 0.0
+	for i, r := range composite[0].(map[string]interface{})["slo_reference"].([]interface{}) {
+		if !d.NewValueKnown(fmt.Sprintf("request.0.composite_slo.0.slo_reference.%d.weightage_percentage", i)) {
+			return nil
+		}
+		total += r.(map[string]interface{})["weightage_percentage"].(float64)
+	}
+	if math.Abs(total-100) > 0.001 {
+		return fmt.Errorf("the weights of the SLOs of a composite SLO must add up to 100, got %v", total)
+	}
+	return nil
 }
 
 func readSlo(d *schema.ResourceData, serviceLevelObjectiveV2Response **nextgen.ServiceLevelObjectiveV2Response) {
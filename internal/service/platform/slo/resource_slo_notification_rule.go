@@ -0,0 +1,356 @@
+package slo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/antihax/optional"
+	hh "github.com/harness/harness-go-sdk/harness/helpers"
+	"github.com/harness/harness-go-sdk/harness/nextgen"
+	"github.com/harness/terraform-provider-harness/helpers"
+	"github.com/harness/terraform-provider-harness/internal"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceSloNotificationRule() *schema.Resource {
+	resource := &schema.Resource{
+		Description: "Resource for creating an error budget notification rule for SLOs. The rule is attached to an SLO with the `notification_rule_refs` of the SLO.",
+
+		CreateContext: resourceSloNotificationRuleCreate,
+		ReadContext:   resourceSloNotificationRuleRead,
+		UpdateContext: resourceSloNotificationRuleUpdate,
+		DeleteContext: resourceSloNotificationRuleDelete,
+		Importer:      helpers.MultiLevelResourceImporter,
+
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Description: "Identifier of the organization in which the notification rule is configured.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"project_id": {
+				Description: "Identifier of the project in which the notification rule is configured.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"identifier": {
+				Description: "Identifier of the notification rule.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"name": {
+				Description: "Name of the notification rule.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"condition": {
+				Description: "Conditions of the error budget triggering the notification. The notification is sent when any of the conditions is met.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Description: "Type of the condition, `ErrorBudgetRemainingPercentage`, `ErrorBudgetRemainingMinutes` or `ErrorBudgetBurnRate`.",
+							Type:        schema.TypeString,
+							Required:    true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"ErrorBudgetRemainingPercentage", "ErrorBudgetRemainingMinutes", "ErrorBudgetBurnRate",
+							}, false),
+						},
+						"threshold": {
+							Description:  "Threshold of the condition: the remaining error budget in percent or in minutes under which the notification is sent, or the burn rate, in percent of the error budget per day, above which it is sent.",
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ValidateFunc: validation.FloatAtLeast(0),
+						},
+						"lookback_duration": {
+							Description: "Period over which the burn rate is computed, e.g. `10m` or `1h`. Required for conditions of type `ErrorBudgetBurnRate`.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"notification_method": {
+				Description: "Channel through which the notification is sent.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Description:  "Type of the channel, `Email`, `Slack`, `PagerDuty` or `MsTeams`.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"Email", "Slack", "PagerDuty", "MsTeams"}, false),
+						},
+						"user_groups": {
+							Description: "User groups notified.",
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"recipients": {
+							Description: "Email addresses notified, for channels of type `Email`.",
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"webhook_url": {
+							Description: "Webhook URL of the Slack channel, for channels of type `Slack`.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"integration_key": {
+							Description: "Integration key of the PagerDuty service, for channels of type `PagerDuty`.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"ms_team_keys": {
+							Description: "Webhook URLs of the Microsoft Teams channels, for channels of type `MsTeams`.",
+							Type:        schema.TypeList,
+							Optional:    true,
+							Sensitive:   true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return resource
+}
+
+func resourceSloNotificationRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
+	ctx = context.WithValue(ctx, nextgen.ContextAccessToken, hh.EnvVars.BearerToken.Get())
+	createNotificationRuleRequest, err := buildSloNotificationRuleRequest(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	respCreate, httpRespCreate, errCreate := c.NotificationRuleApi.SaveNotificationRuleData(ctx, c.AccountId,
+		&nextgen.NotificationRuleApiSaveNotificationRuleDataOpts{
+			Body: optional.NewInterface(createNotificationRuleRequest),
+		})
+
+	if errCreate != nil {
+		return helpers.HandleApiError(errCreate, d, httpRespCreate)
+	}
+
+	if respCreate.Resource == nil || respCreate.Resource.NotificationRule == nil {
+		return diag.Errorf("SLO notification rule %s was not returned by the server", d.Get("identifier").(string))
+	}
+
+	return readSloNotificationRule(d, respCreate.Resource.NotificationRule)
+}
+
+func resourceSloNotificationRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
+	ctx = context.WithValue(ctx, nextgen.ContextAccessToken, hh.EnvVars.BearerToken.Get())
+	identifier := d.Get("identifier").(string)
+	resp, httpResp, err := c.NotificationRuleApi.GetNotificationRuleData(ctx, identifier, c.AccountId, d.Get("org_id").(string), d.Get("project_id").(string))
+
+	if err != nil {
+		if err.Error() == "404 Not Found" {
+			d.SetId("")
+			d.MarkNewResource()
+			return nil
+		}
+		return helpers.HandleReadApiError(err, d, httpResp)
+	}
+
+	if resp.Resource == nil || resp.Resource.NotificationRule == nil {
+		d.SetId("")
+		d.MarkNewResource()
+		return nil
+	}
+
+	return readSloNotificationRule(d, resp.Resource.NotificationRule)
+}
+
+func resourceSloNotificationRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
+	ctx = context.WithValue(ctx, nextgen.ContextAccessToken, hh.EnvVars.BearerToken.Get())
+	identifier := d.Get("identifier").(string)
+	updateNotificationRuleRequest, err := buildSloNotificationRuleRequest(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	respUpdate, httpRespUpdate, errUpdate := c.NotificationRuleApi.UpdateNotificationRuleData(ctx, c.AccountId, identifier,
+		&nextgen.NotificationRuleApiUpdateNotificationRuleDataOpts{
+			Body: optional.NewInterface(updateNotificationRuleRequest),
+		})
+
+	if errUpdate != nil {
+		return helpers.HandleApiError(errUpdate, d, httpRespUpdate)
+	}
+
+	if respUpdate.Resource == nil || respUpdate.Resource.NotificationRule == nil {
+		return diag.Errorf("SLO notification rule %s was not returned by the server", identifier)
+	}
+
+	return readSloNotificationRule(d, respUpdate.Resource.NotificationRule)
+}
+
+func resourceSloNotificationRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
+	ctx = context.WithValue(ctx, nextgen.ContextAccessToken, hh.EnvVars.BearerToken.Get())
+	identifier := d.Get("identifier").(string)
+
+	_, httpResp, err := c.NotificationRuleApi.DeleteNotificationRule(ctx, c.AccountId, d.Get("org_id").(string), d.Get("project_id").(string), identifier)
+
+	if err != nil {
+		return helpers.HandleApiError(err, d, httpResp)
+	}
+	return nil
+}
+
+// buildSloNotificationRuleRequest builds the notification rule in the JSON format of the API, so that the conditions
+// and the channel are decoded like the specs of the SLOs.
+func buildSloNotificationRuleRequest(d *schema.ResourceData) (*nextgen.NotificationRuleDto, error) {
+	conditions := []interface{}{}
+	for i, c := range d.Get("condition").([]interface{}) {
+		condition := c.(map[string]interface{})
+		conditionType := condition["type"].(string)
+		spec := map[string]interface{}{
+			"threshold": condition["threshold"],
+		}
+		if conditionType == "ErrorBudgetBurnRate" {
+			lookbackDuration := condition["lookback_duration"].(string)
+			if lookbackDuration == "" {
+				return nil, fmt.Errorf("condition.%d: lookback_duration is required for conditions of type ErrorBudgetBurnRate", i)
+			}
+			spec["lookBackDuration"] = lookbackDuration
+		}
+		conditions = append(conditions, map[string]interface{}{
+			"type": conditionType,
+			"spec": spec,
+		})
+	}
+
+	method := d.Get("notification_method").([]interface{})[0].(map[string]interface{})
+	methodType := method["type"].(string)
+	methodSpec := map[string]interface{}{
+		"userGroups": method["user_groups"],
+	}
+	switch methodType {
+	case "Email":
+		methodSpec["recipients"] = method["recipients"]
+	case "Slack":
+		if method["webhook_url"].(string) == "" {
+			return nil, fmt.Errorf("notification_method: webhook_url is required for channels of type Slack")
+		}
+		methodSpec["webhookUrl"] = method["webhook_url"]
+	case "PagerDuty":
+		if method["integration_key"].(string) == "" {
+			return nil, fmt.Errorf("notification_method: integration_key is required for channels of type PagerDuty")
+		}
+		methodSpec["integrationKey"] = method["integration_key"]
+	case "MsTeams":
+		methodSpec["msTeamKeys"] = method["ms_team_keys"]
+	}
+
+	data, err := json.Marshal(map[string]interface{}{
+		"orgIdentifier":     d.Get("org_id"),
+		"projectIdentifier": d.Get("project_id"),
+		"identifier":        d.Get("identifier"),
+		"name":              d.Get("name"),
+		"type":              "ServiceLevelObjective",
+		"conditions":        conditions,
+		"notificationMethod": map[string]interface{}{
+			"type": methodType,
+			"spec": methodSpec,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	notificationRule := &nextgen.NotificationRuleDto{}
+	if err := json.Unmarshal(data, notificationRule); err != nil {
+		return nil, fmt.Errorf("invalid notification rule: %w", err)
+	}
+	return notificationRule, nil
+}
+
+// sloNotificationRule holds the fields of the notification rule read into the state, in the JSON format of the API.
+type sloNotificationRule struct {
+	Conditions []struct {
+		Type string `json:"type"`
+		Spec struct {
+			Threshold        float64 `json:"threshold"`
+			LookBackDuration string  `json:"lookBackDuration"`
+		} `json:"spec"`
+	} `json:"conditions"`
+	NotificationMethod struct {
+		Type string `json:"type"`
+		Spec struct {
+			UserGroups     []string `json:"userGroups"`
+			Recipients     []string `json:"recipients"`
+			WebhookUrl     string   `json:"webhookUrl"`
+			IntegrationKey string   `json:"integrationKey"`
+			MsTeamKeys     []string `json:"msTeamKeys"`
+		} `json:"spec"`
+	} `json:"notificationMethod"`
+}
+
+func readSloNotificationRule(d *schema.ResourceData, notificationRule *nextgen.NotificationRuleDto) diag.Diagnostics {
+	data, err := json.Marshal(notificationRule)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	rule := sloNotificationRule{}
+	if err := json.Unmarshal(data, &rule); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(notificationRule.Identifier)
+
+	d.Set("org_id", notificationRule.OrgIdentifier)
+	d.Set("project_id", notificationRule.ProjectIdentifier)
+	d.Set("identifier", notificationRule.Identifier)
+	d.Set("name", notificationRule.Name)
+
+	conditions := []interface{}{}
+	for _, condition := range rule.Conditions {
+		conditions = append(conditions, map[string]interface{}{
+			"type":              condition.Type,
+			"threshold":         condition.Spec.Threshold,
+			"lookback_duration": condition.Spec.LookBackDuration,
+		})
+	}
+	d.Set("condition", conditions)
+
+	// The secrets of the channel are kept from the state when the API doesn't return them.
+	spec := rule.NotificationMethod.Spec
+	webhookUrl, integrationKey, msTeamKeys := spec.WebhookUrl, spec.IntegrationKey, spec.MsTeamKeys
+	if webhookUrl == "" {
+		webhookUrl = d.Get("notification_method.0.webhook_url").(string)
+	}
+	if integrationKey == "" {
+		integrationKey = d.Get("notification_method.0.integration_key").(string)
+	}
+	if len(msTeamKeys) == 0 {
+		for _, key := range d.Get("notification_method.0.ms_team_keys").([]interface{}) {
+			msTeamKeys = append(msTeamKeys, key.(string))
+		}
+	}
+	d.Set("notification_method", []interface{}{
+		map[string]interface{}{
+			"type":            rule.NotificationMethod.Type,
+			"user_groups":     spec.UserGroups,
+			"recipients":      spec.Recipients,
+			"webhook_url":     webhookUrl,
+			"integration_key": integrationKey,
+			"ms_team_keys":    msTeamKeys,
+		},
+	})
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package slo_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/harness/harness-go-sdk/harness/nextgen"
+	"github.com/harness/harness-go-sdk/harness/utils"
+	"github.com/harness/terraform-provider-harness/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceSloNotificationRule(t *testing.T) {
+	name := t.Name()
+	id := fmt.Sprintf("%s_%s", name, utils.RandStringBytes(5))
+	updatedName := fmt.Sprintf("%s_updated", name)
+	resourceName := "harness_platform_slo_notification_rule.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.TestAccPreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccSloNotificationRuleDestroy(resourceName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSloNotificationRule(id, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", id),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "condition.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "condition.1.lookback_duration", "1h"),
+					resource.TestCheckResourceAttr(resourceName, "notification_method.0.recipients.0", "sre@harness.io"),
+				),
+			},
+			{
+				Config: testAccResourceSloNotificationRule(id, updatedName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", id),
+					resource.TestCheckResourceAttr(resourceName, "name", updatedName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: acctest.ProjectResourceImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccGetSloNotificationRule(resourceName string, state *terraform.State) (*nextgen.NotificationRuleDto, error) {
+	r := acctest.TestAccGetResource(resourceName, state)
+	c, ctx := acctest.TestAccGetPlatformClientWithContext()
+	id := r.Primary.ID
+
+	resp, _, err := c.NotificationRuleApi.GetNotificationRuleData(ctx, id, c.AccountId, buildField(r, "org_id").Value(), buildField(r, "project_id").Value())
+	if err != nil {
+		return nil, err
+	}
+	if resp.Resource == nil {
+		return nil, nil
+	}
+
+	return resp.Resource.NotificationRule, nil
+}
+
+func testAccSloNotificationRuleDestroy(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		notificationRule, _ := testAccGetSloNotificationRule(resourceName, state)
+		if notificationRule != nil {
+			return fmt.Errorf("Found SLO notification rule: %s", notificationRule.Identifier)
+		}
+
+		return nil
+	}
+}
+
+func testAccResourceSloNotificationRule(id string, name string) string {
+	return fmt.Sprintf(`
+		resource "harness_platform_organization" "test" {
+			identifier = "%[1]s"
+			name = "%[2]s"
+		}
+
+		resource "harness_platform_project" "test" {
+			identifier = "%[1]s"
+			name = "%[2]s"
+			org_id = harness_platform_organization.test.id
+			color = "#472848"
+		}
+
+		resource "harness_platform_slo_notification_rule" "test" {
+			org_id = harness_platform_project.test.org_id
+			project_id = harness_platform_project.test.id
+			identifier = "%[1]s"
+			name = "%[2]s"
+			condition {
+				type = "ErrorBudgetRemainingPercentage"
+				threshold = 20
+			}
+			condition {
+				type = "ErrorBudgetBurnRate"
+				threshold = 5
+				lookback_duration = "1h"
+			}
+			notification_method {
+				type = "Email"
+				recipients = ["sre@harness.io"]
+			}
+		}
+`, id, name)
+}
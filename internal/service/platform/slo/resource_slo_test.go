@@ -5,6 +5,7 @@ import (
 	"github.com/antihax/optional"
 	"github.com/harness/terraform-provider-harness/internal"
 	"github.com/stretchr/testify/require"
+	"regexp"
 	"testing"
 
 	"github.com/harness/harness-go-sdk/harness/nextgen"
@@ -392,3 +393,118 @@ func testAccResourceSloForRequest(id string, name string) string {
 		}
 `, id, name)
 }
+
+func TestAccResourceSloComposite(t *testing.T) {
+	name := t.Name()
+	id := fmt.Sprintf("%s_%s", name, utils.RandStringBytes(5))
+	resourceName := "harness_platform_slo.composite"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.TestAccPreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"time": {},
+		},
+		CheckDestroy: testAccSloDestroy(resourceName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSloComposite(id, name, 50, 50),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s_composite", id)),
+					resource.TestCheckResourceAttr(resourceName, "request.0.composite_slo.0.slo_reference.#", "2"),
+				),
+			},
+			{
+				Config: testAccResourceSloComposite(id, name, 70, 30),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "request.0.composite_slo.0.slo_reference.0.weightage_percentage", "70"),
+				),
+			},
+			{
+				Config:      testAccResourceSloComposite(id, name, 70, 20),
+				ExpectError: regexp.MustCompile("must add up to 100"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: acctest.ProjectResourceImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccResourceSloComposite(id string, name string, weight1 float64, weight2 float64) string {
+	return testAccResourceSloForTimeWindow(id, name) + fmt.Sprintf(`
+		resource "harness_platform_slo" "test2" {
+			org_id = harness_platform_monitored_service.test.org_id
+			project_id = harness_platform_monitored_service.test.project_id
+			identifier = "%[1]s_2"
+			request {
+				  name = "%[2]s_2"
+				  user_journey_refs = ["one"]
+				  slo_target {
+						type = "Calender"
+						slo_target_percentage = 10
+						spec = jsonencode({
+							type = "Monthly"
+							spec = {
+								dayOfMonth = 5
+							}
+						})
+				  }
+				  type = "Simple"
+				  spec = jsonencode({
+						monitoredServiceRef = harness_platform_monitored_service.test.id
+						serviceLevelIndicatorType = "Latency"
+						serviceLevelIndicators = [
+							{
+								name = "name"
+								identifier = "%[1]s_2"
+								type = "Window"
+								spec = {
+									type = "Threshold"
+									spec = {
+										metric1 = "metric1"
+										thresholdValue = 10
+										thresholdType = ">"
+									}
+									sliMissingDataType = "Good"
+								}
+							}
+						]
+				  })
+			}
+		}
+
+		resource "harness_platform_slo" "composite" {
+			org_id = harness_platform_slo.test.org_id
+			project_id = harness_platform_slo.test.project_id
+			identifier = "%[1]s_composite"
+			request {
+				  name = "%[2]s_composite"
+				  user_journey_refs = ["one"]
+				  slo_target {
+						type = "Calender"
+						slo_target_percentage = 10
+						spec = jsonencode({
+							type = "Monthly"
+							spec = {
+								dayOfMonth = 5
+							}
+						})
+				  }
+				  type = "Composite"
+				  composite_slo {
+						slo_reference {
+							slo_ref = harness_platform_slo.test.id
+							weightage_percentage = %[3]v
+						}
+						slo_reference {
+							slo_ref = harness_platform_slo.test2.id
+							weightage_percentage = %[4]v
+						}
+				  }
+			}
+		}
+`, id, name, weight1, weight2)
+}
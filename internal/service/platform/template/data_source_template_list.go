@@ -0,0 +1,205 @@
+package template
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/antihax/optional"
+	"github.com/harness/harness-openapi-go-client/nextgen"
+	"github.com/harness/terraform-provider-harness/helpers"
+	"github.com/harness/terraform-provider-harness/internal"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceTemplateList() *schema.Resource {
+	resource := &schema.Resource{
+		Description: "Data source for listing the Harness templates of an account, organization or project. The stable version of each template is returned. Every page of results is fetched.",
+
+		ReadContext: dataSourceTemplateListRead,
+
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Description: "Organization Identifier for the Entity",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"project_id": {
+				Description:  "Project Identifier for the Entity",
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"org_id"},
+			},
+			"types": {
+				Description: "Filter the templates by type, e.g. `Step`, `Stage` or `Pipeline`.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"templates": {
+				Description: "List of templates matching the filters.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identifier": {
+							Description: "Unique identifier of the template.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the template.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "Description of the template.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"org_id": {
+							Description: "Organization Identifier for the Entity",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"project_id": {
+							Description: "Project Identifier for the Entity",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"tags": {
+							Description: "Tags associated with the template.",
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"version": {
+							Description: "Version label of the stable version of the template.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"type": {
+							Description: "Type of the template, e.g. `Step`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"child_type": {
+							Description: "Type of the entity defined by the template, e.g. `ShellScript` for a step template.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"store_type": {
+							Description: "Specifies whether the Entity is to be stored in Git or not. Possible values: INLINE, REMOTE.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"connector_ref": {
+							Description: "Identifier of the Harness Connector used for CRUD operations on the Entity.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"updated": {
+							Description: "Last modification timestamp of the template, in milliseconds.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	helpers.SetListDataSourceFilterSchema(resource.Schema)
+
+	return resource
+}
+
+func dataSourceTemplateListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, ctx := meta.(*internal.Session).GetClientWithContext(ctx)
+
+	org_id := d.Get("org_id").(string)
+	project_id := d.Get("project_id").(string)
+	searchTerm := helpers.BuildField(d, "search_term")
+
+	types := map[string]bool{}
+	for _, t := range d.Get("types").(*schema.Set).List() {
+		types[t.(string)] = true
+	}
+
+	templates := []interface{}{}
+	httpResp, err := helpers.ListAllPages(func(page int32) (bool, *http.Response, error) {
+		var resp []nextgen.TemplateMetadataSummaryResponse
+		var httpResp *http.Response
+		var err error
+
+		if project_id != "" {
+			resp, httpResp, err = c.ProjectTemplateApi.GetTemplatesListProject(ctx, org_id, project_id, &nextgen.ProjectTemplateApiGetTemplatesListProjectOpts{
+				HarnessAccount: optional.NewString(c.AccountId),
+				Type_:          optional.NewString("STABLE_TEMPLATE"),
+				SearchTerm:     searchTerm,
+				Page:           optional.NewInt32(page),
+				Limit:          optional.NewInt32(helpers.ListPageSize),
+			})
+		} else if org_id != "" {
+			resp, httpResp, err = c.OrgTemplateApi.GetTemplatesListOrg(ctx, org_id, &nextgen.OrgTemplateApiGetTemplatesListOrgOpts{
+				HarnessAccount: optional.NewString(c.AccountId),
+				Type_:          optional.NewString("STABLE_TEMPLATE"),
+				SearchTerm:     searchTerm,
+				Page:           optional.NewInt32(page),
+				Limit:          optional.NewInt32(helpers.ListPageSize),
+			})
+		} else {
+			resp, httpResp, err = c.AccountTemplateApi.GetTemplatesListAcc(ctx, &nextgen.AccountTemplateApiGetTemplatesListAccOpts{
+				HarnessAccount: optional.NewString(c.AccountId),
+				Type_:          optional.NewString("STABLE_TEMPLATE"),
+				SearchTerm:     searchTerm,
+				Page:           optional.NewInt32(page),
+				Limit:          optional.NewInt32(helpers.ListPageSize),
+			})
+		}
+
+		if err != nil {
+			return false, httpResp, err
+		}
+
+		for _, v := range resp {
+			if !helpers.MatchesFilterTags(d, v.Tags) {
+				continue
+			}
+
+			if len(types) > 0 && !types[v.EntityType] {
+				continue
+			}
+
+			id := v.Identifier
+			if id == "" {
+				id = v.Slug
+			}
+
+			templates = append(templates, map[string]interface{}{
+				"identifier":    id,
+				"name":          v.Name,
+				"description":   v.Description,
+				"org_id":        v.Org,
+				"project_id":    v.Project,
+				"tags":          helpers.FlattenTags(v.Tags),
+				"version":       v.VersionLabel,
+				"type":          v.EntityType,
+				"child_type":    v.ChildType,
+				"store_type":    v.StoreType,
+				"connector_ref": v.ConnectorRef,
+				"updated":       int(v.Updated),
+			})
+		}
+
+		return helpers.IsFullPage(len(resp)), httpResp, nil
+	})
+	if err != nil {
+		return helpers.HandleApiError(err, d, httpResp)
+	}
+
+	d.SetId(helpers.ListDataSourceId(c.AccountId, d, "org_id", "project_id", "types", "search_term", "filter_tags"))
+	d.Set("templates", templates)
+
+	return nil
+}
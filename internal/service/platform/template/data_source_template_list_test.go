@@ -0,0 +1,52 @@
+package template_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/harness/harness-go-sdk/harness/utils"
+	"github.com/harness/terraform-provider-harness/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceTemplateList(t *testing.T) {
+
+	id := fmt.Sprintf("%s_%s", t.Name(), utils.RandStringBytes(6))
+	resourceName := "data.harness_platform_template_list.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.TestAccPreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTemplateList(id),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "templates.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "templates.0.identifier", id),
+					resource.TestCheckResourceAttr(resourceName, "templates.0.version", "v1"),
+					resource.TestCheckResourceAttr(resourceName, "templates.0.type", "Step"),
+					resource.TestCheckResourceAttr(resourceName, "templates.0.org_id", id),
+					resource.TestCheckResourceAttr("data.harness_platform_template_list.stages", "templates.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceTemplateList(id string) string {
+	return fmt.Sprintf(`
+		%[2]s
+
+		data "harness_platform_template_list" "test" {
+			org_id = harness_platform_template.test.org_id
+			search_term = harness_platform_template.test.identifier
+			types = ["Step"]
+		}
+
+		data "harness_platform_template_list" "stages" {
+			org_id = harness_platform_template.test.org_id
+			search_term = harness_platform_template.test.identifier
+			types = ["Stage"]
+		}
+	`, id, testAccResourceTemplateOrgScopeVersion(id, "v1", true))
+}
@@ -0,0 +1,181 @@
+package template
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/antihax/optional"
+	"github.com/harness/harness-openapi-go-client/nextgen"
+	"github.com/harness/terraform-provider-harness/helpers"
+	"github.com/harness/terraform-provider-harness/internal"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceTemplateVersions() *schema.Resource {
+	resource := &schema.Resource{
+		Description: "Data source for listing the versions of a Harness template.",
+
+		ReadContext: dataSourceTemplateVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"identifier": {
+				Description: "Unique identifier of the template.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"org_id": {
+				Description: "Organization Identifier for the Entity",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"project_id": {
+				Description: "Project Identifier for the Entity",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"stable_version": {
+				Description: "Version label of the stable version of the template.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"versions": {
+				Description: "Versions of the template, sorted by version label.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"version": {
+							Description: "Version label of the template.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the template.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"is_stable": {
+							Description: "True if this version is the stable version of the template.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"store_type": {
+							Description: "Specifies whether the Entity is to be stored in Git or not. Possible values: INLINE, REMOTE.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"updated": {
+							Description: "Last modification timestamp of the version, in milliseconds.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return resource
+}
+
+func dataSourceTemplateVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, ctx := meta.(*internal.Session).GetClientWithContext(ctx)
+
+	id := d.Get("identifier").(string)
+	org_id := d.Get("org_id").(string)
+	project_id := d.Get("project_id").(string)
+
+	versions, httpResp, err := listTemplateVersions(ctx, c, org_id, project_id, id)
+	if err != nil {
+		return helpers.HandleReadApiError(err, d, httpResp)
+	}
+
+	if len(versions) == 0 {
+		return diag.Errorf("template %s not found", id)
+	}
+
+	stable_version := ""
+	result := make([]interface{}, 0, len(versions))
+	for _, v := range versions {
+		if v.StableTemplate {
+			stable_version = v.VersionLabel
+		}
+
+		result = append(result, map[string]interface{}{
+			"version":    v.VersionLabel,
+			"name":       v.Name,
+			"is_stable":  v.StableTemplate,
+			"store_type": v.StoreType,
+			"updated":    int(v.Updated),
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", org_id, project_id, id))
+	d.Set("stable_version", stable_version)
+	d.Set("versions", result)
+
+	return nil
+}
+
+// listTemplateVersions returns every version of the template with the given identifier, sorted
+// by version label.
+func listTemplateVersions(ctx context.Context, c *nextgen.APIClient, org_id string, project_id string, id string) ([]nextgen.TemplateMetadataSummaryResponse, *http.Response, error) {
+	var versions []nextgen.TemplateMetadataSummaryResponse
+
+	httpResp, err := helpers.ListAllPages(func(page int32) (bool, *http.Response, error) {
+		var resp []nextgen.TemplateMetadataSummaryResponse
+		var httpResp *http.Response
+		var err error
+
+		if project_id != "" {
+			resp, httpResp, err = c.ProjectTemplateApi.GetTemplatesListProject(ctx, org_id, project_id, &nextgen.ProjectTemplateApiGetTemplatesListProjectOpts{
+				HarnessAccount: optional.NewString(c.AccountId),
+				Type_:          optional.NewString("ALL"),
+				Identifiers:    optional.NewInterface([]string{id}),
+				Page:           optional.NewInt32(page),
+				Limit:          optional.NewInt32(helpers.ListPageSize),
+			})
+		} else if org_id != "" {
+			resp, httpResp, err = c.OrgTemplateApi.GetTemplatesListOrg(ctx, org_id, &nextgen.OrgTemplateApiGetTemplatesListOrgOpts{
+				HarnessAccount: optional.NewString(c.AccountId),
+				Type_:          optional.NewString("ALL"),
+				Identifiers:    optional.NewInterface([]string{id}),
+				Page:           optional.NewInt32(page),
+				Limit:          optional.NewInt32(helpers.ListPageSize),
+			})
+		} else {
+			resp, httpResp, err = c.AccountTemplateApi.GetTemplatesListAcc(ctx, &nextgen.AccountTemplateApiGetTemplatesListAccOpts{
+				HarnessAccount: optional.NewString(c.AccountId),
+				Type_:          optional.NewString("ALL"),
+				Identifiers:    optional.NewInterface([]string{id}),
+				Page:           optional.NewInt32(page),
+				Limit:          optional.NewInt32(helpers.ListPageSize),
+			})
+		}
+
+		if err != nil {
+			return false, httpResp, err
+		}
+
+		for _, v := range resp {
+			// The identifiers filter isn't applied by every version of the API.
+			if v.Identifier == id || (v.Identifier == "" && v.Slug == id) {
+				versions = append(versions, v)
+			}
+		}
+
+		return helpers.IsFullPage(len(resp)), httpResp, nil
+	})
+	if err != nil {
+		return nil, httpResp, err
+	}
+
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versions[i].VersionLabel < versions[j].VersionLabel
+	})
+
+	return versions, nil, nil
+}
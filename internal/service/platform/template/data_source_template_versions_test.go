@@ -0,0 +1,35 @@
+package template_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/harness/harness-go-sdk/harness/utils"
+	"github.com/harness/terraform-provider-harness/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceTemplateVersions(t *testing.T) {
+
+	id := fmt.Sprintf("%s_%s", t.Name(), utils.RandStringBytes(6))
+	resourceName := "data.harness_platform_template_versions.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.TestAccPreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTemplateOrgScopeVersion(id, "v1", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "identifier", id),
+					resource.TestCheckResourceAttr(resourceName, "stable_version", "v1"),
+					resource.TestCheckResourceAttr(resourceName, "versions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "versions.0.version", "v1"),
+					resource.TestCheckResourceAttr(resourceName, "versions.0.name", id),
+					resource.TestCheckResourceAttr(resourceName, "versions.0.is_stable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "versions.0.store_type", "INLINE"),
+				),
+			},
+		},
+	})
+}
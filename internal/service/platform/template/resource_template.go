@@ -6,12 +6,12 @@ import (
 	"net/http"
 
 	"github.com/antihax/optional"
+	platform_client_nextgen "github.com/harness/harness-go-sdk/harness/nextgen"
 	"github.com/harness/harness-openapi-go-client/nextgen"
 	"github.com/harness/terraform-provider-harness/helpers"
 	"github.com/harness/terraform-provider-harness/internal"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func ResourceTemplate() *schema.Resource {
@@ -22,7 +22,13 @@ func ResourceTemplate() *schema.Resource {
 		UpdateContext: resourceTemplateCreateOrUpdate,
 		DeleteContext: resourceTemplateDelete,
 		CreateContext: resourceTemplateCreateOrUpdate,
-		Importer:      helpers.MultiLevelResourceImporter,
+		CustomizeDiff: helpers.ValidateYamlDiff("template", "template_yaml", map[string]string{
+			"identifier":        "identifier",
+			"orgIdentifier":     "org_id",
+			"projectIdentifier": "project_id",
+			"versionLabel":      "version",
+		}),
+		Importer: helpers.MultiLevelResourceImporter,
 
 		Schema: map[string]*schema.Schema{
 			"template_yaml": {
@@ -32,87 +38,28 @@ func ResourceTemplate() *schema.Resource {
 				Computed:    true,
 			},
 			"version": {
-				Description: "Version Label for Template.",
+				Description: "Version Label for Template. Changing the version creates a new version of the template, the previous version is kept unless `delete_older_versions` is set when the resource is destroyed.",
 				Type:        schema.TypeString,
 				Required:    true,
 			},
 			"is_stable": {
-				Description: "True if given version for template to be set as stable.",
+				Description: "True if given version for template to be set as stable. Marking a version as stable doesn't require any change to the other versions of the template.",
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Computed:    true,
 			},
+			"delete_older_versions": {
+				Description: "When the resource is destroyed, also delete every other version of the template, e.g. the versions created before `version` was changed. By default only `version` is deleted.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
 			"comments": {
 				Description: "Specify comment with respect to changes.",
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
-			"git_details": {
-				Description: "Contains parameters related to creating an Entity for Git Experience.",
-				Type:        schema.TypeList,
-				MaxItems:    1,
-				Optional:    true,
-				Computed:    true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"branch_name": {
-							Description: "Name of the branch.",
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
-						},
-						"file_path": {
-							Description: "File path of the Entity in the repository.",
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
-						},
-						"commit_message": {
-							Description: "Commit message used for the merge commit.",
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
-						},
-						"base_branch": {
-							Description: "Name of the default branch (this checks out a new branch titled by branch_name).",
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
-						},
-						"connector_ref": {
-							Description: "Identifier of the Harness Connector used for CRUD operations on the Entity." + helpers.Descriptions.ConnectorRefText.String(),
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
-						},
-						"store_type": {
-							Description:  "Specifies whether the Entity is to be stored in Git or not. Possible values: INLINE, REMOTE.",
-							Type:         schema.TypeString,
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: validation.StringInSlice([]string{"INLINE", "REMOTE"}, false),
-						},
-						"repo_name": {
-							Description: "Name of the repository.",
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
-						},
-						"last_object_id": {
-							Description: "Last object identifier (for Github). To be provided only when updating Pipeline.",
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
-						},
-						"last_commit_id": {
-							Description: "Last commit identifier (for Git Repositories other than Github). To be provided only when updating Pipeline.",
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
-						},
-					},
-				},
-			},
+			"git_details": helpers.GetGitDetailsSchema(false),
 			"force_delete": {
 				Description: "Enable this flag for force deletion of template. It will delete the Harness entity even if your pipelines or other entities reference it",
 				Type:        schema.TypeString,
@@ -153,46 +100,8 @@ func ResourceTemplate() *schema.Resource {
 				},
 				Optional: true,
 			},
-			"import_from_git": {
-				Description: "Flag to set if importing from Git",
-				Type:        schema.TypeBool,
-				Optional:    true,
-			},
-			"git_import_details": {
-				Description: "Contains Git Information for importing entities from Git",
-				Type:        schema.TypeList,
-				MaxItems:    1,
-				Optional:    true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"branch_name": {
-							Description: "Name of the branch.",
-							Type:        schema.TypeString,
-							Optional:    true,
-						},
-						"file_path": {
-							Description: "File path of the Entity in the repository.",
-							Type:        schema.TypeString,
-							Optional:    true,
-						},
-						"connector_ref": {
-							Description: "Identifier of the Harness Connector used for importing entity from Git" + helpers.Descriptions.ConnectorRefText.String(),
-							Type:        schema.TypeString,
-							Optional:    true,
-						},
-						"repo_name": {
-							Description: "Name of the repository.",
-							Type:        schema.TypeString,
-							Optional:    true,
-						},
-						"is_force_import": {
-							Description: "",
-							Type:        schema.TypeBool,
-							Optional:    true,
-						},
-					},
-				},
-			},
+			"import_from_git":    helpers.GetImportFromGitSchema(),
+			"git_import_details": helpers.GetGitImportInfoSchema(),
 			"template_import_request": {
 				Description: "Contains parameters for importing template.",
 				Type:        schema.TypeList,
@@ -221,6 +130,8 @@ func ResourceTemplate() *schema.Resource {
 		},
 	}
 
+	helpers.SetGitMoveConfigSchema(resource.Schema)
+
 	return resource
 }
 
@@ -231,12 +142,7 @@ func resourceTemplateRead(ctx context.Context, d *schema.ResourceData, meta inte
 	org_id := d.Get("org_id").(string)
 	project_id := d.Get("project_id").(string)
 	var comments = helpers.BuildField(d, "comments")
-	var store_type = helpers.BuildField(d, "git_details.0.store_type")
-	var base_branch = helpers.BuildField(d, "git_details.0.base_branch")
-	var commit_message = helpers.BuildField(d, "git_details.0.commit_message")
-	var connector_ref = helpers.BuildField(d, "git_details.0.connector_ref")
-	var branch_name optional.String
-	branch_name = helpers.BuildField(d, "git_details.0.branch_name")
+	branch_name := helpers.BuildGitReadOptions(d).BranchName
 	version := d.Get("version").(string)
 
 	var err error
@@ -279,7 +185,7 @@ func resourceTemplateRead(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 
-	if httpResp.StatusCode == 404 {
+	if httpResp != nil && httpResp.StatusCode == 404 {
 		d.SetId("")
 		d.MarkNewResource()
 		return nil
@@ -289,7 +195,7 @@ func resourceTemplateRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return helpers.HandleApiError(err, d, httpResp)
 	}
 
-	readTemplate(d, resp, comments.Value(), store_type, base_branch, commit_message, connector_ref)
+	readTemplate(d, resp, comments.Value())
 
 	return nil
 }
@@ -299,11 +205,6 @@ func resourceTemplateCreateOrUpdate(ctx context.Context, d *schema.ResourceData,
 
 	var err error
 	var template_id string
-	var branch_name string
-	var store_type optional.String
-	var base_branch optional.String
-	var commit_message optional.String
-	var connector_ref optional.String
 	var resp nextgen.TemplateResponse
 	var httpResp *http.Response
 	id := d.Id()
@@ -316,7 +217,7 @@ func resourceTemplateCreateOrUpdate(ctx context.Context, d *schema.ResourceData,
 
 	if id == "" {
 
-		if d.Get("import_from_git").(bool) {
+		if helpers.IsImportFromGit(d) {
 
 			template_id = d.Get("identifier").(string)
 
@@ -341,56 +242,41 @@ func resourceTemplateCreateOrUpdate(ctx context.Context, d *schema.ResourceData,
 
 		} else {
 			template := buildCreateTemplate(d)
-			if template.GitDetails != nil {
-				base_branch = optional.NewString(template.GitDetails.BaseBranch)
-				store_type = optional.NewString(template.GitDetails.StoreType)
-				commit_message = optional.NewString(template.GitDetails.CommitMessage)
-				connector_ref = optional.NewString(template.GitDetails.ConnectorRef)
-				branch_name = template.GitDetails.BranchName
-			}
-			if project_id != "" {
-				resp, httpResp, err = c.ProjectTemplateApi.CreateTemplatesProject(ctx, org_id, project_id, &nextgen.ProjectTemplateApiCreateTemplatesProjectOpts{
-					Body:           optional.NewInterface(template),
-					HarnessAccount: optional.NewString(c.AccountId),
-				})
-				if resp.Identifier != "" {
-					template_id = resp.Identifier
-				} else {
-					template_id = resp.Slug
-				}
-			} else if org_id != "" && project_id == "" {
-				resp, httpResp, err = c.OrgTemplateApi.CreateTemplatesOrg(ctx, org_id, &nextgen.OrgTemplateApiCreateTemplatesOrgOpts{
-					HarnessAccount: optional.NewString(c.AccountId),
-					Body:           optional.NewInterface(template),
-				})
-				if resp.Identifier != "" {
-					template_id = resp.Identifier
-				} else {
-					template_id = resp.Slug
-				}
-			} else {
-				resp, httpResp, err = c.AccountTemplateApi.CreateTemplatesAcc(ctx, &nextgen.AccountTemplateApiCreateTemplatesAccOpts{
-					HarnessAccount: optional.NewString(c.AccountId),
-					Body:           optional.NewInterface(template),
-				})
-				if resp.Identifier != "" {
-					template_id = resp.Identifier
-				} else {
-					template_id = resp.Slug
-				}
-			}
+			resp, httpResp, err = createTemplateVersion(ctx, c, org_id, project_id, template)
+			template_id = getTemplateId(resp)
 		}
 	} else {
 		template := buildUpdateTemplate(d)
-		if template.GitDetails != nil {
-			base_branch = optional.NewString(template.GitDetails.BaseBranch)
-			branch_name = template.GitDetails.BranchName
-			store_type = optional.NewString(template.GitDetails.StoreType)
-			commit_message = optional.NewString(template.GitDetails.CommitMessage)
-			connector_ref = optional.NewString(template.GitDetails.ConnectorRef)
+
+		moved := false
+		if moveConfigType := helpers.GetGitMoveConfigType(d); moveConfigType != "" {
+			// The version in state is the one on the server, a new version label is created after the move.
+			old_version, _ := d.GetChange("version")
+			httpResp, err = moveTemplate(ctx, d, meta, id, old_version.(string), moveConfigType)
+			if err != nil {
+				return helpers.HandleApiError(err, d, httpResp)
+			}
+			moved = true
+			template_id = id
+
+			if template.GitDetails != nil {
+				// The object in state is the one before the move.
+				template.GitDetails.LastObjectId = ""
+			}
 		}
 
-		if template_yaml != "" {
+		// Moving the template commits its current yaml, the template is only updated when something else changed.
+		update := !moved || d.HasChangesExcept("git_details")
+
+		if update && d.HasChange("version") {
+			// A new version label is a new version of the template, the previous version is kept
+			// so that the pipelines referencing it keep working.
+			old_version, _ := d.GetChange("version")
+			log.Printf("[DEBUG] Creating version %s of template %s, keeping version %s", version, id, old_version)
+
+			resp, httpResp, err = createTemplateVersion(ctx, c, org_id, project_id, buildCreateTemplate(d))
+			template_id = getTemplateId(resp)
+		} else if update && template_yaml != "" {
 			if project_id != "" {
 				resp, httpResp, err = c.ProjectTemplateApi.UpdateTemplateProject(ctx, project_id, id, org_id, version, &nextgen.ProjectTemplateApiUpdateTemplateProjectOpts{
 					Body:           optional.NewInterface(template),
@@ -424,7 +310,7 @@ func resourceTemplateCreateOrUpdate(ctx context.Context, d *schema.ResourceData,
 			}
 		}
 
-		if is_stable == true {
+		if err == nil && is_stable == true {
 			if project_id != "" {
 				_, httpResp, err = c.ProjectTemplateApi.UpdateTemplateStableProject(ctx, org_id, project_id, id, version, &nextgen.ProjectTemplateApiUpdateTemplateStableProjectOpts{
 					Body:           optional.NewInterface(template),
@@ -450,39 +336,40 @@ func resourceTemplateCreateOrUpdate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	var respGet nextgen.TemplateWithInputsResponse
+	branch_name := helpers.BuildGitReadOptions(d).BranchName
 
 	if project_id != "" {
 		if version == "" {
 			respGet, httpResp, err = c.ProjectTemplateApi.GetTemplateStableProject(ctx, org_id, project_id, template_id, &nextgen.ProjectTemplateApiGetTemplateStableProjectOpts{
 				HarnessAccount: optional.NewString(c.AccountId),
-				BranchName:     optional.NewString(branch_name)})
+				BranchName:     branch_name})
 		} else {
 			respGet, httpResp, err = c.ProjectTemplateApi.GetTemplateProject(ctx, project_id, template_id, org_id, version, &nextgen.ProjectTemplateApiGetTemplateProjectOpts{
 				HarnessAccount: optional.NewString(c.AccountId),
-				BranchName:     optional.NewString(branch_name)})
+				BranchName:     branch_name})
 		}
 	} else if org_id != "" && project_id == "" {
 		if version == "" {
 			respGet, httpResp, err = c.OrgTemplateApi.GetTemplateStableOrg(ctx, org_id, template_id, &nextgen.OrgTemplateApiGetTemplateStableOrgOpts{
 				HarnessAccount: optional.NewString(c.AccountId),
-				BranchName:     optional.NewString(branch_name),
+				BranchName:     branch_name,
 			})
 		} else {
 			respGet, httpResp, err = c.OrgTemplateApi.GetTemplateOrg(ctx, template_id, org_id, version, &nextgen.OrgTemplateApiGetTemplateOrgOpts{
 				HarnessAccount: optional.NewString(c.AccountId),
-				BranchName:     optional.NewString(branch_name),
+				BranchName:     branch_name,
 			})
 		}
 	} else {
 		if version == "" {
 			respGet, httpResp, err = c.AccountTemplateApi.GetTemplateStableAcc(ctx, template_id, &nextgen.AccountTemplateApiGetTemplateStableAccOpts{
 				HarnessAccount: optional.NewString(c.AccountId),
-				BranchName:     optional.NewString(branch_name),
+				BranchName:     branch_name,
 			})
 		} else {
 			respGet, httpResp, err = c.AccountTemplateApi.GetTemplateAcc(ctx, template_id, version, &nextgen.AccountTemplateApiGetTemplateAccOpts{
 				HarnessAccount: optional.NewString(c.AccountId),
-				BranchName:     optional.NewString(branch_name),
+				BranchName:     branch_name,
 			})
 		}
 	}
@@ -491,7 +378,7 @@ func resourceTemplateCreateOrUpdate(ctx context.Context, d *schema.ResourceData,
 		return helpers.HandleApiError(err, d, httpResp)
 	}
 
-	readTemplate(d, respGet, comments, store_type, base_branch, commit_message, connector_ref)
+	readTemplate(d, respGet, comments)
 
 	return nil
 }
@@ -499,23 +386,12 @@ func resourceTemplateCreateOrUpdate(ctx context.Context, d *schema.ResourceData,
 func createImportFromGitRequestForTemplates(d *schema.ResourceData) *nextgen.TemplatesImportRequestBody {
 
 	template_git_import_details := &nextgen.GitImportDetails{}
-	if attr, ok := d.GetOk("git_import_details"); ok {
-		config := attr.([]interface{})[0].(map[string]interface{})
-		if attr, ok := config["branch_name"]; ok {
-			template_git_import_details.BranchName = attr.(string)
-		}
-		if attr, ok := config["file_path"]; ok {
-			template_git_import_details.FilePath = attr.(string)
-		}
-		if attr, ok := config["connector_ref"]; ok {
-			template_git_import_details.ConnectorRef = attr.(string)
-		}
-		if attr, ok := config["repo_name"]; ok {
-			template_git_import_details.RepoName = attr.(string)
-		}
-		if attr, ok := config["is_force_import"]; ok {
-			template_git_import_details.IsForceImport = attr.(bool)
-		}
+	if info := helpers.ExpandGitImportInfo(d); info != nil {
+		template_git_import_details.BranchName = info.BranchName
+		template_git_import_details.FilePath = info.FilePath
+		template_git_import_details.ConnectorRef = info.ConnectorRef
+		template_git_import_details.RepoName = info.RepoName
+		template_git_import_details.IsForceImport = info.IsForceImport
 	}
 
 	template_import_request := &nextgen.TemplatesImportRequestDto{}
@@ -547,35 +423,110 @@ func resourceTemplateDelete(ctx context.Context, d *schema.ResourceData, meta in
 	org_id := d.Get("org_id").(string)
 	project_id := d.Get("project_id").(string)
 	version := d.Get("version").(string)
-	var httpResp *http.Response
-	var err error
-	log.Printf("[DEBUG] Deleting template with identifier %s and version %s", id, version)
 
+	versions := []string{version}
+	if d.Get("delete_older_versions").(bool) {
+		all, httpResp, err := listTemplateVersions(ctx, c, org_id, project_id, id)
+		if err != nil {
+			return helpers.HandleApiError(err, d, httpResp)
+		}
+
+		// The stable version is deleted last, Harness doesn't allow deleting it while other
+		// versions of the template exist.
+		versions = nil
+		stable := ""
+		for _, v := range all {
+			if v.StableTemplate {
+				stable = v.VersionLabel
+			} else {
+				versions = append(versions, v.VersionLabel)
+			}
+		}
+		if stable != "" {
+			versions = append(versions, stable)
+		}
+	}
+
+	for _, v := range versions {
+		log.Printf("[DEBUG] Deleting template with identifier %s and version %s", id, v)
+
+		httpResp, err := deleteTemplateVersion(ctx, c, d, org_id, project_id, id, v)
+		if err != nil {
+			return helpers.HandleApiError(err, d, httpResp)
+		}
+	}
+
+	return nil
+}
+
+func createTemplateVersion(ctx context.Context, c *nextgen.APIClient, org_id string, project_id string, template nextgen.TemplateCreateRequestBody) (nextgen.TemplateResponse, *http.Response, error) {
 	if project_id != "" {
-		httpResp, err = c.ProjectTemplateApi.DeleteTemplateProject(ctx, project_id, id, org_id, version, &nextgen.ProjectTemplateApiDeleteTemplateProjectOpts{
+		return c.ProjectTemplateApi.CreateTemplatesProject(ctx, org_id, project_id, &nextgen.ProjectTemplateApiCreateTemplatesProjectOpts{
+			Body:           optional.NewInterface(template),
 			HarnessAccount: optional.NewString(c.AccountId),
-			Comments:       helpers.BuildField(d, "comments"),
-			ForceDelete:    helpers.BuildFieldForBoolean(d, "force_delete"),
 		})
-	} else if org_id != "" && project_id == "" {
-		httpResp, err = c.OrgTemplateApi.DeleteTemplateOrg(ctx, id, org_id, version, &nextgen.OrgTemplateApiDeleteTemplateOrgOpts{
+	} else if org_id != "" {
+		return c.OrgTemplateApi.CreateTemplatesOrg(ctx, org_id, &nextgen.OrgTemplateApiCreateTemplatesOrgOpts{
+			HarnessAccount: optional.NewString(c.AccountId),
+			Body:           optional.NewInterface(template),
+		})
+	}
+
+	return c.AccountTemplateApi.CreateTemplatesAcc(ctx, &nextgen.AccountTemplateApiCreateTemplatesAccOpts{
+		HarnessAccount: optional.NewString(c.AccountId),
+		Body:           optional.NewInterface(template),
+	})
+}
+
+func deleteTemplateVersion(ctx context.Context, c *nextgen.APIClient, d *schema.ResourceData, org_id string, project_id string, id string, version string) (*http.Response, error) {
+	if project_id != "" {
+		return c.ProjectTemplateApi.DeleteTemplateProject(ctx, project_id, id, org_id, version, &nextgen.ProjectTemplateApiDeleteTemplateProjectOpts{
 			HarnessAccount: optional.NewString(c.AccountId),
 			Comments:       helpers.BuildField(d, "comments"),
 			ForceDelete:    helpers.BuildFieldForBoolean(d, "force_delete"),
 		})
-	} else {
-		httpResp, err = c.AccountTemplateApi.DeleteTemplateAcc(ctx, id, version, &nextgen.AccountTemplateApiDeleteTemplateAccOpts{
+	} else if org_id != "" {
+		return c.OrgTemplateApi.DeleteTemplateOrg(ctx, id, org_id, version, &nextgen.OrgTemplateApiDeleteTemplateOrgOpts{
 			HarnessAccount: optional.NewString(c.AccountId),
 			Comments:       helpers.BuildField(d, "comments"),
 			ForceDelete:    helpers.BuildFieldForBoolean(d, "force_delete"),
 		})
-
 	}
-	if err != nil {
-		return helpers.HandleApiError(err, d, httpResp)
+
+	return c.AccountTemplateApi.DeleteTemplateAcc(ctx, id, version, &nextgen.AccountTemplateApiDeleteTemplateAccOpts{
+		HarnessAccount: optional.NewString(c.AccountId),
+		Comments:       helpers.BuildField(d, "comments"),
+		ForceDelete:    helpers.BuildFieldForBoolean(d, "force_delete"),
+	})
+}
+
+func getTemplateId(resp nextgen.TemplateResponse) string {
+	if resp.Identifier != "" {
+		return resp.Identifier
 	}
+	return resp.Slug
+}
 
-	return nil
+// moveTemplate moves a version of the template between Harness and git. The v1 API can't move templates, the
+// template service API is used instead.
+func moveTemplate(ctx context.Context, d *schema.ResourceData, meta interface{}, id string, version string, moveConfigType string) (*http.Response, error) {
+	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
+
+	git := helpers.BuildGitOptions(d)
+	_, httpResp, err := c.TemplatesApi.MoveTemplateConfigs(ctx, c.AccountId, id, &platform_client_nextgen.TemplatesApiMoveTemplateConfigsOpts{
+		OrgIdentifier:     helpers.BuildField(d, "org_id"),
+		ProjectIdentifier: helpers.BuildField(d, "project_id"),
+		VersionLabel:      optional.NewString(version),
+		ConnectorRef:      git.ConnectorRef,
+		RepoName:          git.RepoName,
+		Branch:            git.BranchName,
+		FilePath:          git.FilePath,
+		CommitMsg:         git.CommitMessage,
+		IsNewBranch:       git.IsNewBranch,
+		BaseBranch:        git.BaseBranch,
+		MoveConfigType:    optional.NewString(moveConfigType),
+	})
+	return httpResp, err
 }
 
 func buildUpdateTemplate(d *schema.ResourceData) nextgen.TemplateUpdateRequestBody {
@@ -584,45 +535,16 @@ func buildUpdateTemplate(d *schema.ResourceData) nextgen.TemplateUpdateRequestBo
 		Comments:     d.Get("comments").(string),
 	}
 
-	if attr, ok := d.GetOk("git_details"); ok {
-		config := attr.([]interface{})[0].(map[string]interface{})
-
-		if attr, ok := config["store_type"]; ok {
-			if attr != "" {
-				template.GitDetails = &nextgen.GitUpdateDetails1{}
-
-				if attr, ok := config["branch_name"]; ok {
-					template.GitDetails.BranchName = attr.(string)
-				}
-
-				if attr, ok := config["file_path"]; ok {
-					template.GitDetails.FilePath = attr.(string)
-				}
-
-				if attr, ok := config["last_object_id"]; ok {
-					template.GitDetails.LastObjectId = attr.(string)
-				}
-
-				if attr, ok := config["commit_message"]; ok {
-					template.GitDetails.CommitMessage = attr.(string)
-				}
-
-				if attr, ok := config["base_branch"]; ok {
-					template.GitDetails.BaseBranch = attr.(string)
-				}
-
-				if attr, ok := config["connector_ref"]; ok {
-					template.GitDetails.ConnectorRef = attr.(string)
-				}
-
-				if attr, ok := config["store_type"]; ok {
-					template.GitDetails.StoreType = attr.(string)
-				}
-
-				if attr, ok := config["repo_name"]; ok {
-					template.GitDetails.RepoName = attr.(string)
-				}
-			}
+	if git := helpers.ExpandGitDetails(d); git != nil && git.StoreType != "" {
+		template.GitDetails = &nextgen.GitUpdateDetails1{
+			BranchName:    git.BranchName,
+			FilePath:      git.FilePath,
+			LastObjectId:  git.LastObjectId,
+			CommitMessage: git.CommitMessage,
+			BaseBranch:    git.BaseBranch,
+			ConnectorRef:  git.ConnectorRef,
+			StoreType:     git.StoreType,
+			RepoName:      git.RepoName,
 		}
 	}
 
@@ -636,44 +558,22 @@ func buildCreateTemplate(d *schema.ResourceData) nextgen.TemplateCreateRequestBo
 		Comments:     d.Get("comments").(string),
 	}
 
-	if attr, ok := d.GetOk("git_details"); ok {
-		config := attr.([]interface{})[0].(map[string]interface{})
-		template.GitDetails = &nextgen.GitCreateDetails1{}
-
-		if attr, ok := config["branch_name"]; ok {
-			template.GitDetails.BranchName = attr.(string)
-		}
-
-		if attr, ok := config["file_path"]; ok {
-			template.GitDetails.FilePath = attr.(string)
-		}
-
-		if attr, ok := config["commit_message"]; ok {
-			template.GitDetails.CommitMessage = attr.(string)
-		}
-
-		if attr, ok := config["base_branch"]; ok {
-			template.GitDetails.BaseBranch = attr.(string)
-		}
-
-		if attr, ok := config["connector_ref"]; ok {
-			template.GitDetails.ConnectorRef = attr.(string)
-		}
-
-		if attr, ok := config["store_type"]; ok {
-			template.GitDetails.StoreType = attr.(string)
+	if git := helpers.ExpandGitDetails(d); git != nil {
+		template.GitDetails = &nextgen.GitCreateDetails1{
+			BranchName:    git.BranchName,
+			FilePath:      git.FilePath,
+			CommitMessage: git.CommitMessage,
+			BaseBranch:    git.BaseBranch,
+			ConnectorRef:  git.ConnectorRef,
+			StoreType:     git.StoreType,
+			RepoName:      git.RepoName,
 		}
-
-		if attr, ok := config["repo_name"]; ok {
-			template.GitDetails.RepoName = attr.(string)
-		}
-
 	}
 
 	return template
 }
 
-func readTemplate(d *schema.ResourceData, template nextgen.TemplateWithInputsResponse, comments string, store_type optional.String, base_branch optional.String, commit_message optional.String, connector_ref optional.String) {
+func readTemplate(d *schema.ResourceData, template nextgen.TemplateWithInputsResponse, comments string) {
 	if template.Template.Identifier != "" {
 		d.SetId(template.Template.Identifier)
 	} else {
@@ -691,30 +591,17 @@ func readTemplate(d *schema.ResourceData, template nextgen.TemplateWithInputsRes
 	d.Set("is_stable", template.Template.StableTemplate)
 	d.Set("version", template.Template.VersionLabel)
 	d.Set("comments", comments)
+	// Not returned by the API, set explicitly so that imported resources get the default value.
+	d.Set("delete_older_versions", d.Get("delete_older_versions").(bool))
 	if template.Template.GitDetails != nil {
-		d.Set("git_details", []interface{}{readGitDetails(template, store_type, base_branch, commit_message, connector_ref)})
-	}
-}
-
-func readGitDetails(template nextgen.TemplateWithInputsResponse, store_type optional.String, base_branch optional.String, commit_message optional.String, connector_ref optional.String) map[string]interface{} {
-	git_details := map[string]interface{}{
-		"branch_name":    template.Template.GitDetails.BranchName,
-		"file_path":      template.Template.GitDetails.FilePath,
-		"repo_name":      template.Template.GitDetails.RepoName,
-		"last_commit_id": template.Template.GitDetails.CommitId,
-		"last_object_id": template.Template.GitDetails.ObjectId,
-	}
-	if store_type.IsSet() {
-		git_details["store_type"] = store_type.Value()
-	}
-	if base_branch.IsSet() {
-		git_details["base_branch"] = base_branch.Value()
-	}
-	if commit_message.IsSet() {
-		git_details["commit_message"] = commit_message.Value()
-	}
-	if connector_ref.IsSet() {
-		git_details["connector_ref"] = connector_ref.Value()
+		helpers.SetGitDetails(d, &helpers.GitDetails{
+			StoreType:    template.Template.StoreType,
+			ConnectorRef: template.Template.ConnectorRef,
+			BranchName:   template.Template.GitDetails.BranchName,
+			FilePath:     template.Template.GitDetails.FilePath,
+			RepoName:     template.Template.GitDetails.RepoName,
+			LastCommitId: template.Template.GitDetails.CommitId,
+			LastObjectId: template.Template.GitDetails.ObjectId,
+		})
 	}
-	return git_details
 }
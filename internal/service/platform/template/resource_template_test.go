@@ -308,6 +308,47 @@ func TestAccResourceTemplate_OrgScopeInline_UpdateStable(t *testing.T) {
 	})
 }
 
+func TestAccResourceTemplate_OrgScopeVersionLifecycle(t *testing.T) {
+	id := fmt.Sprintf("%s_%s", t.Name(), utils.RandStringBytes(6))
+	resourceName := "harness_platform_template.test"
+	dataSourceName := "data.harness_platform_template_versions.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.TestAccPreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccTemplateDestroy(resourceName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTemplateOrgScopeVersion(id, "v1", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "version", "v1"),
+					resource.TestCheckResourceAttr(resourceName, "is_stable", "true"),
+				),
+			},
+			{
+				Config: testAccResourceTemplateOrgScopeVersion(id, "v2", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", id),
+					resource.TestCheckResourceAttr(resourceName, "version", "v2"),
+					resource.TestCheckResourceAttr(resourceName, "is_stable", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.0.version", "v1"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.0.is_stable", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "stable_version", "v1"),
+				),
+			},
+			{
+				Config: testAccResourceTemplateOrgScopeVersion(id, "v2", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "is_stable", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "stable_version", "v2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceTemplate_AccountScope(t *testing.T) {
 	id := fmt.Sprintf("%s_%s", t.Name(), utils.RandStringBytes(6))
 	name := id
@@ -1468,3 +1509,45 @@ func testAccResourceTemplateAccountScopeImportFromGit(id string, name string) st
 		}
         `, id, name)
 }
+
+func testAccResourceTemplateOrgScopeVersion(id string, version string, isStable bool) string {
+	return fmt.Sprintf(`
+	resource "harness_platform_organization" "test" {
+		identifier = "%[1]s"
+		name = "%[1]s"
+	}
+
+	resource "harness_platform_template" "test" {
+		identifier = "%[1]s"
+		org_id = harness_platform_organization.test.id
+		name = "%[1]s"
+		version = "%[2]s"
+		is_stable = %[3]t
+		delete_older_versions = true
+		template_yaml = <<-EOT
+			template:
+			  name: "%[1]s"
+			  identifier: "%[1]s"
+			  versionLabel: "%[2]s"
+			  type: Step
+			  orgIdentifier: ${harness_platform_organization.test.id}
+			  tags: {}
+			  spec:
+			    type: ShellScript
+			    timeout: 10m
+			    spec:
+			      shell: Bash
+			      onDelegate: true
+			      source:
+			        type: Inline
+			        spec:
+			          script: echo %[2]s
+		EOT
+	}
+
+	data "harness_platform_template_versions" "test" {
+		identifier = harness_platform_template.test.id
+		org_id = harness_platform_template.test.org_id
+	}
+	`, id, version, isStable)
+}
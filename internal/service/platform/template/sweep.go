@@ -0,0 +1,110 @@
+package template
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/antihax/optional"
+	nextgen "github.com/harness/harness-openapi-go-client/nextgen"
+	"github.com/harness/terraform-provider-harness/internal/sweep"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func init() {
+	resource.AddTestSweepers("harness_platform_template", &resource.Sweeper{
+		Name:         "harness_platform_template",
+		F:            testSweepTemplates,
+		Dependencies: []string{"harness_platform_pipeline"},
+	})
+}
+
+func testSweepTemplates(r string) error {
+	c, ctx := sweep.GetClientWithContext()
+
+	scopes, err := sweep.ListScopes()
+	if err != nil {
+		return err
+	}
+
+	for _, scope := range scopes {
+		for page := int32(0); ; page++ {
+			templates, err := listTemplates(ctx, c, scope, page)
+			if err != nil {
+				return err
+			}
+
+			for _, template := range templates {
+				// Only delete templates whose identifier or name starts with the sweep prefix
+				if !sweep.IsSweepable(template.Slug, template.Name) {
+					continue
+				}
+
+				if err := deleteTemplate(ctx, c, scope, template.Slug, template.VersionLabel); err != nil {
+					fmt.Printf("[ERROR] Failed to delete template %s version %s: %s", template.Slug, template.VersionLabel, err)
+				}
+			}
+
+			if len(templates) < sweep.PageSize {
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// listTemplates returns every version of the templates at the given scope.
+func listTemplates(ctx context.Context, c *nextgen.APIClient, scope sweep.Scope, page int32) ([]nextgen.TemplateMetadataSummaryResponse, error) {
+	var resp []nextgen.TemplateMetadataSummaryResponse
+	var err error
+
+	switch {
+	case scope.Project != "":
+		resp, _, err = c.ProjectTemplateApi.GetTemplatesListProject(ctx, scope.Org, scope.Project, &nextgen.ProjectTemplateApiGetTemplatesListProjectOpts{
+			HarnessAccount: optional.NewString(c.AccountId),
+			Type_:          optional.NewString("ALL"),
+			Page:           optional.NewInt32(page),
+			Limit:          optional.NewInt32(sweep.PageSize),
+		})
+	case scope.Org != "":
+		resp, _, err = c.OrgTemplateApi.GetTemplatesListOrg(ctx, scope.Org, &nextgen.OrgTemplateApiGetTemplatesListOrgOpts{
+			HarnessAccount: optional.NewString(c.AccountId),
+			Type_:          optional.NewString("ALL"),
+			Page:           optional.NewInt32(page),
+			Limit:          optional.NewInt32(sweep.PageSize),
+		})
+	default:
+		resp, _, err = c.AccountTemplateApi.GetTemplatesListAcc(ctx, &nextgen.AccountTemplateApiGetTemplatesListAccOpts{
+			HarnessAccount: optional.NewString(c.AccountId),
+			Type_:          optional.NewString("ALL"),
+			Page:           optional.NewInt32(page),
+			Limit:          optional.NewInt32(sweep.PageSize),
+		})
+	}
+
+	return resp, err
+}
+
+func deleteTemplate(ctx context.Context, c *nextgen.APIClient, scope sweep.Scope, id string, version string) error {
+	var err error
+
+	switch {
+	case scope.Project != "":
+		_, err = c.ProjectTemplateApi.DeleteTemplateProject(ctx, scope.Project, id, scope.Org, version, &nextgen.ProjectTemplateApiDeleteTemplateProjectOpts{
+			HarnessAccount: optional.NewString(c.AccountId),
+			ForceDelete:    optional.NewBool(true),
+		})
+	case scope.Org != "":
+		_, err = c.OrgTemplateApi.DeleteTemplateOrg(ctx, id, scope.Org, version, &nextgen.OrgTemplateApiDeleteTemplateOrgOpts{
+			HarnessAccount: optional.NewString(c.AccountId),
+			ForceDelete:    optional.NewBool(true),
+		})
+	default:
+		_, err = c.AccountTemplateApi.DeleteTemplateAcc(ctx, id, version, &nextgen.AccountTemplateApiDeleteTemplateAccOpts{
+			HarnessAccount: optional.NewString(c.AccountId),
+			ForceDelete:    optional.NewBool(true),
+		})
+	}
+
+	return err
+}
@@ -87,11 +87,12 @@ func ResourceToken() *schema.Resource {
 				Optional:    true,
 			},
 			"value": {
-				Description: "Value of the Token",
+				Description: "Value of the Token. Empty when `value_secret` is set.",
 				Type:        schema.TypeString,
 				Computed:    true,
 				Sensitive:   true,
 			},
+			"value_secret": helpers.GetValueSecretSchema("value"),
 		},
 	}
 	helpers.SetMultiLevelResourceSchema(resource.Schema)
@@ -139,7 +140,12 @@ func resourceTokenCreateOrUpdate(ctx context.Context, d *schema.ResourceData, me
 	if id == "" {
 		createResponse, httpResp, err = c.TokenApi.CreateToken(ctx, c.AccountId, &nextgen.TokenApiCreateTokenOpts{Body: optional.NewInterface(token)})
 		if err == nil {
-			d.Set("value", createResponse.Data)
+			if !helpers.HasValueSecret(d) {
+				d.Set("value", createResponse.Data)
+			} else if httpResp, err = helpers.StoreValueInSecret(ctx, c, d, createResponse.Data); err != nil {
+				d.SetId(token.Identifier)
+				return helpers.HandleApiError(err, d, httpResp)
+			}
 			return resourceTokenRead(ctx, d, meta)
 		}
 	} else {
@@ -173,6 +179,10 @@ func resourceTokenDelete(ctx context.Context, d *schema.ResourceData, meta inter
 		return helpers.HandleApiError(err, d, httpResp)
 	}
 
+	if httpResp, err = helpers.DeleteValueSecret(ctx, c, d); err != nil {
+		return helpers.HandleApiError(err, d, httpResp)
+	}
+
 	return nil
 }
 
@@ -19,7 +19,13 @@ func ResourceTriggers() *schema.Resource {
 		UpdateContext: resourceTriggersCreateOrUpdate,
 		CreateContext: resourceTriggersCreateOrUpdate,
 		DeleteContext: resourceTriggersDelete,
-		Importer:      helpers.TriggerResourceImporter,
+		CustomizeDiff: helpers.ValidateYamlDiff("trigger", "yaml", map[string]string{
+			"identifier":         "identifier",
+			"orgIdentifier":      "org_id",
+			"projectIdentifier":  "project_id",
+			"pipelineIdentifier": "target_id",
+		}),
+		Importer: helpers.TriggerResourceImporter,
 
 		Schema: map[string]*schema.Schema{
 			"target_id": {
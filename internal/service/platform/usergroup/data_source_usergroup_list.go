@@ -0,0 +1,144 @@
+package usergroup
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/antihax/optional"
+	"github.com/harness/harness-go-sdk/harness/nextgen"
+	"github.com/harness/terraform-provider-harness/helpers"
+	"github.com/harness/terraform-provider-harness/internal"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceUserGroupList() *schema.Resource {
+	resource := &schema.Resource{
+		Description: "Data source for listing the Harness User Groups of an account, organization or project. Every page of results is fetched.",
+
+		ReadContext: dataSourceUserGroupListRead,
+
+		Schema: map[string]*schema.Schema{
+			"user_groups": {
+				Description: "List of User Groups matching the filters.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identifier": {
+							Description: "Unique identifier of the User Group.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the User Group.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "Description of the User Group.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"org_id": {
+							Description: "Unique identifier of the organization.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"project_id": {
+							Description: "Unique identifier of the project.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"tags": {
+							Description: "Tags associated with the User Group.",
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"users": {
+							Description: "List of users in the UserGroup.",
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"externally_managed": {
+							Description: "Whether the user group is externally managed.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"sso_linked": {
+							Description: "Whether sso is linked or not.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"linked_sso_id": {
+							Description: "The SSO account ID that the user group is linked to.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	helpers.SetOptionalOrgAndProjectLevelDataSourceSchema(resource.Schema)
+	helpers.SetListDataSourceFilterSchema(resource.Schema)
+
+	return resource
+}
+
+func dataSourceUserGroupListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, ctx := meta.(*internal.Session).GetPlatformClientWithContext(ctx)
+
+	opts := &nextgen.UserGroupApiGetUserGroupListOpts{
+		OrgIdentifier:     helpers.BuildField(d, "org_id"),
+		ProjectIdentifier: helpers.BuildField(d, "project_id"),
+		SearchTerm:        helpers.BuildField(d, "search_term"),
+		PageSize:          optional.NewInt32(helpers.ListPageSize),
+	}
+
+	userGroups := []interface{}{}
+	httpResp, err := helpers.ListAllPages(func(page int32) (bool, *http.Response, error) {
+		opts.PageIndex = optional.NewInt32(page)
+
+		resp, httpResp, err := c.UserGroupApi.GetUserGroupList(ctx, c.AccountId, opts)
+		if err != nil {
+			return false, httpResp, err
+		}
+
+		if resp.Data == nil {
+			return false, httpResp, nil
+		}
+
+		for _, ug := range resp.Data.Content {
+			if !helpers.MatchesFilterTags(d, ug.Tags) {
+				continue
+			}
+
+			userGroups = append(userGroups, map[string]interface{}{
+				"identifier":         ug.Identifier,
+				"name":               ug.Name,
+				"description":        ug.Description,
+				"org_id":             ug.OrgIdentifier,
+				"project_id":         ug.ProjectIdentifier,
+				"tags":               helpers.FlattenTags(ug.Tags),
+				"users":              ug.Users,
+				"externally_managed": ug.ExternallyManaged,
+				"sso_linked":         ug.SsoLinked,
+				"linked_sso_id":      ug.LinkedSsoId,
+			})
+		}
+
+		return helpers.HasMorePages(page, resp.Data.TotalPages), httpResp, nil
+	})
+	if err != nil {
+		return helpers.HandleApiError(err, d, httpResp)
+	}
+
+	d.SetId(helpers.ListDataSourceId(c.AccountId, d, "org_id", "project_id", "search_term", "filter_tags"))
+	d.Set("user_groups", userGroups)
+
+	return nil
+}
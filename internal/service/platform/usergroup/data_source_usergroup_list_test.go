@@ -0,0 +1,55 @@
+package usergroup_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/harness/harness-go-sdk/harness/utils"
+	"github.com/harness/terraform-provider-harness/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceUserGroupList(t *testing.T) {
+
+	id := fmt.Sprintf("%s_%s", t.Name(), utils.RandStringBytes(6))
+	name := id
+	resourceName := "data.harness_platform_usergroup_list.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.TestAccPreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceUserGroupList(id, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "user_groups.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "user_groups.0.identifier", id),
+					resource.TestCheckResourceAttr(resourceName, "user_groups.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "user_groups.0.org_id", id),
+					resource.TestCheckResourceAttr(resourceName, "user_groups.0.externally_managed", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceUserGroupList(id string, name string) string {
+	return fmt.Sprintf(`
+		resource "harness_platform_organization" "test" {
+			identifier = "%[1]s"
+			name = "%[2]s"
+		}
+
+		resource "harness_platform_usergroup" "test" {
+			identifier = "%[1]s"
+			name = "%[2]s"
+			org_id = harness_platform_organization.test.id
+			users = []
+		}
+
+		data "harness_platform_usergroup_list" "test" {
+			org_id = harness_platform_usergroup.test.org_id
+			search_term = harness_platform_usergroup.test.identifier
+		}
+`, id, name)
+}
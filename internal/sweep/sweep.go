@@ -1,5 +1,138 @@
 package sweep
 
-import "github.com/harness/harness-go-sdk/harness/cd"
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/antihax/optional"
+	"github.com/harness/harness-go-sdk/harness/cd"
+	"github.com/harness/harness-go-sdk/harness/nextgen"
+	openapi_client_nextgen "github.com/harness/harness-openapi-go-client/nextgen"
+	"github.com/harness/terraform-provider-harness/internal"
+)
 
 var SweeperClient *cd.ApiClient
+
+// SweeperSession is the provider session used by the sweepers of the next gen resources.
+var SweeperSession *internal.Session
+
+// PageSize is the number of entities requested per page by the sweepers.
+const PageSize = 100
+
+// DefaultNamePrefix is the prefix of the names and identifiers of the entities created by the
+// acceptance tests. It can be overridden with the HARNESS_SWEEP_PREFIX environment variable.
+const DefaultNamePrefix = "Test"
+
+// Scope is the org and project an entity belongs to. Org and Project are empty for account level
+// entities and Project is empty for org level entities.
+type Scope struct {
+	Org     string
+	Project string
+}
+
+func GetPlatformClientWithContext() (*nextgen.APIClient, context.Context) {
+	return SweeperSession.GetPlatformClientWithContext(context.Background())
+}
+
+func GetClientWithContext() (*openapi_client_nextgen.APIClient, context.Context) {
+	return SweeperSession.GetClientWithContext(context.Background())
+}
+
+// IsSweepable returns true when one of the given names or identifiers starts with the sweep prefix,
+// HARNESS_SWEEP_PREFIX or DefaultNamePrefix when it is not set.
+func IsSweepable(names ...string) bool {
+	prefix := os.Getenv("HARNESS_SWEEP_PREFIX")
+	if prefix == "" {
+		prefix = DefaultNamePrefix
+	}
+
+	for _, name := range names {
+		if name != "" && strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// ListOrgs returns the identifiers of all organizations of the account.
+func ListOrgs() ([]string, error) {
+	c, ctx := GetClientWithContext()
+
+	var orgs []string
+	for page := int32(0); ; page++ {
+		resp, _, err := c.OrganizationApi.GetOrganizations(ctx, &openapi_client_nextgen.OrganizationApiGetOrganizationsOpts{
+			HarnessAccount: optional.NewString(c.AccountId),
+			Page:           optional.NewInt32(page),
+			Limit:          optional.NewInt32(PageSize),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, org := range resp {
+			if org.Org != nil {
+				orgs = append(orgs, org.Org.Slug)
+			}
+		}
+
+		if len(resp) < PageSize {
+			return orgs, nil
+		}
+	}
+}
+
+// ListScopes returns the account scope followed by every org and project of the account, so that
+// sweepers of entities which can be created at any scope can look for them everywhere.
+func ListScopes() ([]Scope, error) {
+	c, ctx := GetClientWithContext()
+
+	orgs, err := ListOrgs()
+	if err != nil {
+		return nil, err
+	}
+
+	scopes := []Scope{{}}
+	for _, org := range orgs {
+		scopes = append(scopes, Scope{Org: org})
+
+		for page := int32(0); ; page++ {
+			resp, _, err := c.OrgProjectApi.GetOrgScopedProjects(ctx, org, &openapi_client_nextgen.OrgProjectApiGetOrgScopedProjectsOpts{
+				HarnessAccount: optional.NewString(c.AccountId),
+				Page:           optional.NewInt32(page),
+				Limit:          optional.NewInt32(PageSize),
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			for _, project := range resp {
+				if project.Project != nil {
+					scopes = append(scopes, Scope{Org: org, Project: project.Project.Slug})
+				}
+			}
+
+			if len(resp) < PageSize {
+				break
+			}
+		}
+	}
+
+	return scopes, nil
+}
+
+// ListProjectScopes returns the scopes of all projects of the account.
+func ListProjectScopes() ([]Scope, error) {
+	scopes, err := ListScopes()
+	if err != nil {
+		return nil, err
+	}
+
+	var projects []Scope
+	for _, scope := range scopes {
+		if scope.Project != "" {
+			projects = append(projects, scope)
+		}
+	}
+	return projects, nil
+}
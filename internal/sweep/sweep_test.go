@@ -4,9 +4,21 @@ import (
 	"testing"
 
 	"github.com/harness/harness-go-sdk/harness/cd"
+	"github.com/harness/terraform-provider-harness/internal/acctest"
 	_ "github.com/harness/terraform-provider-harness/internal/service/cd/application"
 	_ "github.com/harness/terraform-provider-harness/internal/service/cd/cloudprovider"
 	_ "github.com/harness/terraform-provider-harness/internal/service/cd/secrets"
+	_ "github.com/harness/terraform-provider-harness/internal/service/platform/autostopping/rule"
+	_ "github.com/harness/terraform-provider-harness/internal/service/platform/connector"
+	_ "github.com/harness/terraform-provider-harness/internal/service/platform/environment"
+	_ "github.com/harness/terraform-provider-harness/internal/service/platform/feature_flag"
+	_ "github.com/harness/terraform-provider-harness/internal/service/platform/gitops/agent"
+	_ "github.com/harness/terraform-provider-harness/internal/service/platform/organization"
+	_ "github.com/harness/terraform-provider-harness/internal/service/platform/pipeline"
+	_ "github.com/harness/terraform-provider-harness/internal/service/platform/project"
+	_ "github.com/harness/terraform-provider-harness/internal/service/platform/secret"
+	_ "github.com/harness/terraform-provider-harness/internal/service/platform/service"
+	_ "github.com/harness/terraform-provider-harness/internal/service/platform/template"
 	"github.com/harness/terraform-provider-harness/internal/sweep"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -18,5 +30,10 @@ func TestMain(m *testing.M) {
 	}
 
 	sweep.SweeperClient = client
+
+	// The next gen sweepers use the same clients as the provider.
+	acctest.TestAccConfigureProvider()
+	sweep.SweeperSession = acctest.TestAccGetApiClientFromProvider()
+
 	resource.TestMain(m)
 }